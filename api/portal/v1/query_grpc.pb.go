@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Query_Owner_FullMethodName             = "/noble.dollar.portal.v1.Query/Owner"
+	Query_PendingOwner_FullMethodName      = "/noble.dollar.portal.v1.Query/PendingOwner"
 	Query_Paused_FullMethodName            = "/noble.dollar.portal.v1.Query/Paused"
 	Query_Peers_FullMethodName             = "/noble.dollar.portal.v1.Query/Peers"
 	Query_DestinationTokens_FullMethodName = "/noble.dollar.portal.v1.Query/DestinationTokens"
@@ -31,6 +32,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
+	PendingOwner(ctx context.Context, in *QueryPendingOwner, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error)
 	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
 	Peers(ctx context.Context, in *QueryPeers, opts ...grpc.CallOption) (*QueryPeersResponse, error)
 	DestinationTokens(ctx context.Context, in *QueryDestinationTokens, opts ...grpc.CallOption) (*QueryDestinationTokensResponse, error)
@@ -55,6 +57,16 @@ func (c *queryClient) Owner(ctx context.Context, in *QueryOwner, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryPendingOwner, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_PendingOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPausedResponse)
@@ -100,6 +112,7 @@ func (c *queryClient) Nonce(ctx context.Context, in *QueryNonce, opts ...grpc.Ca
 // for forward compatibility.
 type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
+	PendingOwner(context.Context, *QueryPendingOwner) (*QueryPendingOwnerResponse, error)
 	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
 	Peers(context.Context, *QueryPeers) (*QueryPeersResponse, error)
 	DestinationTokens(context.Context, *QueryDestinationTokens) (*QueryDestinationTokensResponse, error)
@@ -117,6 +130,9 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
 }
+func (UnimplementedQueryServer) PendingOwner(context.Context, *QueryPendingOwner) (*QueryPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
 func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
@@ -168,6 +184,24 @@ func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryPendingOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPaused)
 	if err := dec(in); err != nil {
@@ -251,6 +285,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Owner",
 			Handler:    _Query_Owner_Handler,
 		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
 		{
 			MethodName: "Paused",
 			Handler:    _Query_Paused_Handler,
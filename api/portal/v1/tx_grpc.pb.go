@@ -19,12 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Deliver_FullMethodName           = "/noble.dollar.portal.v1.Msg/Deliver"
-	Msg_Transfer_FullMethodName          = "/noble.dollar.portal.v1.Msg/Transfer"
-	Msg_SetPausedState_FullMethodName    = "/noble.dollar.portal.v1.Msg/SetPausedState"
-	Msg_SetPeer_FullMethodName           = "/noble.dollar.portal.v1.Msg/SetPeer"
-	Msg_SetBridgingPath_FullMethodName   = "/noble.dollar.portal.v1.Msg/SetBridgingPath"
-	Msg_TransferOwnership_FullMethodName = "/noble.dollar.portal.v1.Msg/TransferOwnership"
+	Msg_Deliver_FullMethodName                 = "/noble.dollar.portal.v1.Msg/Deliver"
+	Msg_Transfer_FullMethodName                = "/noble.dollar.portal.v1.Msg/Transfer"
+	Msg_SetPausedState_FullMethodName          = "/noble.dollar.portal.v1.Msg/SetPausedState"
+	Msg_SetPeer_FullMethodName                 = "/noble.dollar.portal.v1.Msg/SetPeer"
+	Msg_SetBridgingPath_FullMethodName         = "/noble.dollar.portal.v1.Msg/SetBridgingPath"
+	Msg_TransferOwnership_FullMethodName       = "/noble.dollar.portal.v1.Msg/TransferOwnership"
+	Msg_AcceptOwnership_FullMethodName         = "/noble.dollar.portal.v1.Msg/AcceptOwnership"
+	Msg_CancelOwnershipTransfer_FullMethodName = "/noble.dollar.portal.v1.Msg/CancelOwnershipTransfer"
+	Msg_SetOwner_FullMethodName                = "/noble.dollar.portal.v1.Msg/SetOwner"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +40,9 @@ type MsgClient interface {
 	SetPeer(ctx context.Context, in *MsgSetPeer, opts ...grpc.CallOption) (*MsgSetPeerResponse, error)
 	SetBridgingPath(ctx context.Context, in *MsgSetBridgingPath, opts ...grpc.CallOption) (*MsgSetBridgingPathResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error)
+	SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error)
 }
 
 type msgClient struct {
@@ -107,6 +113,36 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOwnerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -117,6 +153,9 @@ type MsgServer interface {
 	SetPeer(context.Context, *MsgSetPeer) (*MsgSetPeerResponse, error)
 	SetBridgingPath(context.Context, *MsgSetBridgingPath) (*MsgSetBridgingPathResponse, error)
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error)
+	SetOwner(context.Context, *MsgSetOwner) (*MsgSetOwnerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -145,6 +184,15 @@ func (UnimplementedMsgServer) SetBridgingPath(context.Context, *MsgSetBridgingPa
 func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (UnimplementedMsgServer) AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+}
+func (UnimplementedMsgServer) CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipTransfer not implemented")
+}
+func (UnimplementedMsgServer) SetOwner(context.Context, *MsgSetOwner) (*MsgSetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwner not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -274,6 +322,60 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, req.(*MsgCancelOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOwner(ctx, req.(*MsgSetOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +407,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "AcceptOwnership",
+			Handler:    _Msg_AcceptOwnership_Handler,
+		},
+		{
+			MethodName: "CancelOwnershipTransfer",
+			Handler:    _Msg_CancelOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "SetOwner",
+			Handler:    _Msg_SetOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/dollar/portal/v1/tx.proto",
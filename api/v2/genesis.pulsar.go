@@ -2,14 +2,17 @@
 package dollarv2
 
 import (
+	_ "cosmossdk.io/api/amino"
 	v1 "dollar.noble.xyz/v2/api/portal/v1"
 	v11 "dollar.noble.xyz/v2/api/vaults/v1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sort "sort"
@@ -344,902 +347,4043 @@ func (x *_GenesisState_9_map) IsValid() bool {
 	return x.m != nil
 }
 
-var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_portal               protoreflect.FieldDescriptor
-	fd_GenesisState_vaults               protoreflect.FieldDescriptor
-	fd_GenesisState_paused               protoreflect.FieldDescriptor
-	fd_GenesisState_index                protoreflect.FieldDescriptor
-	fd_GenesisState_principal            protoreflect.FieldDescriptor
-	fd_GenesisState_stats                protoreflect.FieldDescriptor
-	fd_GenesisState_total_external_yield protoreflect.FieldDescriptor
-	fd_GenesisState_yield_recipients     protoreflect.FieldDescriptor
-	fd_GenesisState_retry_amounts        protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
 
-func init() {
-	file_noble_dollar_v2_genesis_proto_init()
-	md_GenesisState = File_noble_dollar_v2_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_portal = md_GenesisState.Fields().ByName("portal")
-	fd_GenesisState_vaults = md_GenesisState.Fields().ByName("vaults")
-	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
-	fd_GenesisState_index = md_GenesisState.Fields().ByName("index")
-	fd_GenesisState_principal = md_GenesisState.Fields().ByName("principal")
-	fd_GenesisState_stats = md_GenesisState.Fields().ByName("stats")
-	fd_GenesisState_total_external_yield = md_GenesisState.Fields().ByName("total_external_yield")
-	fd_GenesisState_yield_recipients = md_GenesisState.Fields().ByName("yield_recipients")
-	fd_GenesisState_retry_amounts = md_GenesisState.Fields().ByName("retry_amounts")
+type _GenesisState_10_list struct {
+	list *[]*IndexRecord
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexRecord)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_dollar_v2_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexRecord)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(IndexRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(IndexRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.Map = (*_GenesisState_12_map)(nil)
+
+type _GenesisState_12_map struct {
+	m *map[string]string
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_12_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_12_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_12_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_12_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Portal != nil {
-		value := protoreflect.ValueOfMessage(x.Portal.ProtoReflect())
-		if !f(fd_GenesisState_portal, value) {
-			return
-		}
+func (x *_GenesisState_12_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
 	}
-	if x.Vaults != nil {
-		value := protoreflect.ValueOfMessage(x.Vaults.ProtoReflect())
-		if !f(fd_GenesisState_vaults, value) {
-			return
-		}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
 	}
-	if x.Paused != false {
-		value := protoreflect.ValueOfBool(x.Paused)
-		if !f(fd_GenesisState_paused, value) {
-			return
-		}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_12_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
 	}
-	if x.Index != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Index)
-		if !f(fd_GenesisState_index, value) {
-			return
-		}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_GenesisState_12_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_12_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_12_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_14_map)(nil)
+
+type _GenesisState_14_map struct {
+	m *map[string]*YieldSplit
+}
+
+func (x *_GenesisState_14_map) Len() int {
+	if x.m == nil {
+		return 0
 	}
-	if len(x.Principal) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_5_map{m: &x.Principal})
-		if !f(fd_GenesisState_principal, value) {
-			return
-		}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_14_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
 	}
-	if x.Stats != nil {
-		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
-		if !f(fd_GenesisState_stats, value) {
-			return
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
 		}
 	}
-	if len(x.TotalExternalYield) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_7_map{m: &x.TotalExternalYield})
-		if !f(fd_GenesisState_total_external_yield, value) {
-			return
-		}
+}
+
+func (x *_GenesisState_14_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
 	}
-	if len(x.YieldRecipients) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_8_map{m: &x.YieldRecipients})
-		if !f(fd_GenesisState_yield_recipients, value) {
-			return
-		}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_14_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
 	}
-	if len(x.RetryAmounts) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_9_map{m: &x.RetryAmounts})
-		if !f(fd_GenesisState_retry_amounts, value) {
-			return
-		}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_14_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
 	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		return x.Portal != nil
-	case "noble.dollar.v2.GenesisState.vaults":
-		return x.Vaults != nil
-	case "noble.dollar.v2.GenesisState.paused":
-		return x.Paused != false
-	case "noble.dollar.v2.GenesisState.index":
-		return x.Index != int64(0)
-	case "noble.dollar.v2.GenesisState.principal":
-		return len(x.Principal) != 0
-	case "noble.dollar.v2.GenesisState.stats":
-		return x.Stats != nil
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		return len(x.TotalExternalYield) != 0
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		return len(x.YieldRecipients) != 0
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		return len(x.RetryAmounts) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_14_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
 	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*YieldSplit)
+	(*x.m)[concreteKey] = concreteValue
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		x.Portal = nil
-	case "noble.dollar.v2.GenesisState.vaults":
-		x.Vaults = nil
-	case "noble.dollar.v2.GenesisState.paused":
-		x.Paused = false
-	case "noble.dollar.v2.GenesisState.index":
-		x.Index = int64(0)
-	case "noble.dollar.v2.GenesisState.principal":
-		x.Principal = nil
-	case "noble.dollar.v2.GenesisState.stats":
-		x.Stats = nil
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		x.TotalExternalYield = nil
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		x.YieldRecipients = nil
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		x.RetryAmounts = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_14_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
 	}
+	newValue := new(YieldSplit)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		value := x.Portal
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.vaults":
-		value := x.Vaults
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.paused":
-		value := x.Paused
-		return protoreflect.ValueOfBool(value)
-	case "noble.dollar.v2.GenesisState.index":
-		value := x.Index
-		return protoreflect.ValueOfInt64(value)
-	case "noble.dollar.v2.GenesisState.principal":
-		if len(x.Principal) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_5_map{})
-		}
-		mapValue := &_GenesisState_5_map{m: &x.Principal}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.dollar.v2.GenesisState.stats":
-		value := x.Stats
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		if len(x.TotalExternalYield) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_7_map{})
-		}
-		mapValue := &_GenesisState_7_map{m: &x.TotalExternalYield}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		if len(x.YieldRecipients) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_8_map{})
-		}
-		mapValue := &_GenesisState_8_map{m: &x.YieldRecipients}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		if len(x.RetryAmounts) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_9_map{})
-		}
-		mapValue := &_GenesisState_9_map{m: &x.RetryAmounts}
-		return protoreflect.ValueOfMap(mapValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_14_map) NewValue() protoreflect.Value {
+	v := new(YieldSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		x.Portal = value.Message().Interface().(*v1.GenesisState)
-	case "noble.dollar.v2.GenesisState.vaults":
-		x.Vaults = value.Message().Interface().(*v11.GenesisState)
-	case "noble.dollar.v2.GenesisState.paused":
-		x.Paused = value.Bool()
-	case "noble.dollar.v2.GenesisState.index":
-		x.Index = value.Int()
-	case "noble.dollar.v2.GenesisState.principal":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_5_map)
-		x.Principal = *cmv.m
-	case "noble.dollar.v2.GenesisState.stats":
-		x.Stats = value.Message().Interface().(*Stats)
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_7_map)
-		x.TotalExternalYield = *cmv.m
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_8_map)
-		x.YieldRecipients = *cmv.m
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_9_map)
-		x.RetryAmounts = *cmv.m
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_14_map) IsValid() bool {
+	return x.m != nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		if x.Portal == nil {
-			x.Portal = new(v1.GenesisState)
-		}
-		return protoreflect.ValueOfMessage(x.Portal.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.vaults":
-		if x.Vaults == nil {
-			x.Vaults = new(v11.GenesisState)
-		}
-		return protoreflect.ValueOfMessage(x.Vaults.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.principal":
-		if x.Principal == nil {
-			x.Principal = make(map[string]string)
-		}
-		value := &_GenesisState_5_map{m: &x.Principal}
-		return protoreflect.ValueOfMap(value)
-	case "noble.dollar.v2.GenesisState.stats":
-		if x.Stats == nil {
-			x.Stats = new(Stats)
-		}
-		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		if x.TotalExternalYield == nil {
-			x.TotalExternalYield = make(map[string]string)
-		}
-		value := &_GenesisState_7_map{m: &x.TotalExternalYield}
-		return protoreflect.ValueOfMap(value)
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		if x.YieldRecipients == nil {
-			x.YieldRecipients = make(map[string]string)
-		}
-		value := &_GenesisState_8_map{m: &x.YieldRecipients}
-		return protoreflect.ValueOfMap(value)
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		if x.RetryAmounts == nil {
-			x.RetryAmounts = make(map[string]string)
-		}
-		value := &_GenesisState_9_map{m: &x.RetryAmounts}
-		return protoreflect.ValueOfMap(value)
-	case "noble.dollar.v2.GenesisState.paused":
-		panic(fmt.Errorf("field paused of message noble.dollar.v2.GenesisState is not mutable"))
-	case "noble.dollar.v2.GenesisState.index":
-		panic(fmt.Errorf("field index of message noble.dollar.v2.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]string
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.dollar.v2.GenesisState.portal":
-		m := new(v1.GenesisState)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.vaults":
-		m := new(v11.GenesisState)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.paused":
-		return protoreflect.ValueOfBool(false)
-	case "noble.dollar.v2.GenesisState.index":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.dollar.v2.GenesisState.principal":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_GenesisState_5_map{m: &m})
-	case "noble.dollar.v2.GenesisState.stats":
-		m := new(Stats)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "noble.dollar.v2.GenesisState.total_external_yield":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_GenesisState_7_map{m: &m})
-	case "noble.dollar.v2.GenesisState.yield_recipients":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_GenesisState_8_map{m: &m})
-	case "noble.dollar.v2.GenesisState.retry_amounts":
-		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_GenesisState_9_map{m: &m})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.dollar.v2.GenesisState", d.FullName()))
-	}
-	panic("unreachable")
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field AutoClaimAccounts as it is not of Message kind"))
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Portal != nil {
-			l = options.Size(x.Portal)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Vaults != nil {
-			l = options.Size(x.Vaults)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Paused {
-			n += 2
-		}
-		if x.Index != 0 {
-			n += 1 + runtime.Sov(uint64(x.Index))
+func (x *_GenesisState_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field NonEarningAccounts as it is not of Message kind"))
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field FrozenAccounts as it is not of Message kind"))
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*PauseInfoEntry
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseInfoEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PauseInfoEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(PauseInfoEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(PauseInfoEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*RoleGrant
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(RoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(RoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_28_list)(nil)
+
+type _GenesisState_28_list struct {
+	list *[]*TimelockedMessage
+}
+
+func (x *_GenesisState_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_28_list) AppendMutable() protoreflect.Value {
+	v := new(TimelockedMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_28_list) NewElement() protoreflect.Value {
+	v := new(TimelockedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_36_list)(nil)
+
+type _GenesisState_36_list struct {
+	list *[]*YieldDistribution
+}
+
+func (x *_GenesisState_36_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_36_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*YieldDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_36_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*YieldDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_36_list) AppendMutable() protoreflect.Value {
+	v := new(YieldDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_36_list) NewElement() protoreflect.Value {
+	v := new(YieldDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_36_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_portal                 protoreflect.FieldDescriptor
+	fd_GenesisState_vaults                 protoreflect.FieldDescriptor
+	fd_GenesisState_paused                 protoreflect.FieldDescriptor
+	fd_GenesisState_index                  protoreflect.FieldDescriptor
+	fd_GenesisState_principal              protoreflect.FieldDescriptor
+	fd_GenesisState_stats                  protoreflect.FieldDescriptor
+	fd_GenesisState_total_external_yield   protoreflect.FieldDescriptor
+	fd_GenesisState_yield_recipients       protoreflect.FieldDescriptor
+	fd_GenesisState_retry_amounts          protoreflect.FieldDescriptor
+	fd_GenesisState_index_history          protoreflect.FieldDescriptor
+	fd_GenesisState_earner_rate            protoreflect.FieldDescriptor
+	fd_GenesisState_yield_claim_recipients protoreflect.FieldDescriptor
+	fd_GenesisState_claim_tip              protoreflect.FieldDescriptor
+	fd_GenesisState_yield_splits           protoreflect.FieldDescriptor
+	fd_GenesisState_auto_claim_accounts    protoreflect.FieldDescriptor
+	fd_GenesisState_auto_claim_in_progress protoreflect.FieldDescriptor
+	fd_GenesisState_auto_claim_cursor      protoreflect.FieldDescriptor
+	fd_GenesisState_non_earning_accounts   protoreflect.FieldDescriptor
+	fd_GenesisState_treasury               protoreflect.FieldDescriptor
+	fd_GenesisState_m_index                protoreflect.FieldDescriptor
+	fd_GenesisState_fee                    protoreflect.FieldDescriptor
+	fd_GenesisState_fee_collector          protoreflect.FieldDescriptor
+	fd_GenesisState_frozen_accounts        protoreflect.FieldDescriptor
+	fd_GenesisState_paused_type            protoreflect.FieldDescriptor
+	fd_GenesisState_pause_infos            protoreflect.FieldDescriptor
+	fd_GenesisState_roles                  protoreflect.FieldDescriptor
+	fd_GenesisState_timelock_delay         protoreflect.FieldDescriptor
+	fd_GenesisState_timelocked_messages    protoreflect.FieldDescriptor
+	fd_GenesisState_timelock_nonce         protoreflect.FieldDescriptor
+	fd_GenesisState_max_yield_rate         protoreflect.FieldDescriptor
+	fd_GenesisState_pending_index          protoreflect.FieldDescriptor
+	fd_GenesisState_index_staleness        protoreflect.FieldDescriptor
+	fd_GenesisState_index_stale            protoreflect.FieldDescriptor
+	fd_GenesisState_haircut_mode           protoreflect.FieldDescriptor
+	fd_GenesisState_haircut_factor         protoreflect.FieldDescriptor
+	fd_GenesisState_yield_distributions    protoreflect.FieldDescriptor
+	fd_GenesisState_attested_m_index       protoreflect.FieldDescriptor
+	fd_GenesisState_index_history_start    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_dollar_v2_genesis_proto_init()
+	md_GenesisState = File_noble_dollar_v2_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_portal = md_GenesisState.Fields().ByName("portal")
+	fd_GenesisState_vaults = md_GenesisState.Fields().ByName("vaults")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_index = md_GenesisState.Fields().ByName("index")
+	fd_GenesisState_principal = md_GenesisState.Fields().ByName("principal")
+	fd_GenesisState_stats = md_GenesisState.Fields().ByName("stats")
+	fd_GenesisState_total_external_yield = md_GenesisState.Fields().ByName("total_external_yield")
+	fd_GenesisState_yield_recipients = md_GenesisState.Fields().ByName("yield_recipients")
+	fd_GenesisState_retry_amounts = md_GenesisState.Fields().ByName("retry_amounts")
+	fd_GenesisState_index_history = md_GenesisState.Fields().ByName("index_history")
+	fd_GenesisState_earner_rate = md_GenesisState.Fields().ByName("earner_rate")
+	fd_GenesisState_yield_claim_recipients = md_GenesisState.Fields().ByName("yield_claim_recipients")
+	fd_GenesisState_claim_tip = md_GenesisState.Fields().ByName("claim_tip")
+	fd_GenesisState_yield_splits = md_GenesisState.Fields().ByName("yield_splits")
+	fd_GenesisState_auto_claim_accounts = md_GenesisState.Fields().ByName("auto_claim_accounts")
+	fd_GenesisState_auto_claim_in_progress = md_GenesisState.Fields().ByName("auto_claim_in_progress")
+	fd_GenesisState_auto_claim_cursor = md_GenesisState.Fields().ByName("auto_claim_cursor")
+	fd_GenesisState_non_earning_accounts = md_GenesisState.Fields().ByName("non_earning_accounts")
+	fd_GenesisState_treasury = md_GenesisState.Fields().ByName("treasury")
+	fd_GenesisState_m_index = md_GenesisState.Fields().ByName("m_index")
+	fd_GenesisState_fee = md_GenesisState.Fields().ByName("fee")
+	fd_GenesisState_fee_collector = md_GenesisState.Fields().ByName("fee_collector")
+	fd_GenesisState_frozen_accounts = md_GenesisState.Fields().ByName("frozen_accounts")
+	fd_GenesisState_paused_type = md_GenesisState.Fields().ByName("paused_type")
+	fd_GenesisState_pause_infos = md_GenesisState.Fields().ByName("pause_infos")
+	fd_GenesisState_roles = md_GenesisState.Fields().ByName("roles")
+	fd_GenesisState_timelock_delay = md_GenesisState.Fields().ByName("timelock_delay")
+	fd_GenesisState_timelocked_messages = md_GenesisState.Fields().ByName("timelocked_messages")
+	fd_GenesisState_timelock_nonce = md_GenesisState.Fields().ByName("timelock_nonce")
+	fd_GenesisState_max_yield_rate = md_GenesisState.Fields().ByName("max_yield_rate")
+	fd_GenesisState_pending_index = md_GenesisState.Fields().ByName("pending_index")
+	fd_GenesisState_index_staleness = md_GenesisState.Fields().ByName("index_staleness")
+	fd_GenesisState_index_stale = md_GenesisState.Fields().ByName("index_stale")
+	fd_GenesisState_haircut_mode = md_GenesisState.Fields().ByName("haircut_mode")
+	fd_GenesisState_haircut_factor = md_GenesisState.Fields().ByName("haircut_factor")
+	fd_GenesisState_yield_distributions = md_GenesisState.Fields().ByName("yield_distributions")
+	fd_GenesisState_attested_m_index = md_GenesisState.Fields().ByName("attested_m_index")
+	fd_GenesisState_index_history_start = md_GenesisState.Fields().ByName("index_history_start")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_dollar_v2_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		if len(x.Principal) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.Principal))
-				for k := range x.Principal {
-					sortme = append(sortme, k)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Portal != nil {
+		value := protoreflect.ValueOfMessage(x.Portal.ProtoReflect())
+		if !f(fd_GenesisState_portal, value) {
+			return
+		}
+	}
+	if x.Vaults != nil {
+		value := protoreflect.ValueOfMessage(x.Vaults.ProtoReflect())
+		if !f(fd_GenesisState_vaults, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.Index != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Index)
+		if !f(fd_GenesisState_index, value) {
+			return
+		}
+	}
+	if len(x.Principal) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_5_map{m: &x.Principal})
+		if !f(fd_GenesisState_principal, value) {
+			return
+		}
+	}
+	if x.Stats != nil {
+		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+		if !f(fd_GenesisState_stats, value) {
+			return
+		}
+	}
+	if len(x.TotalExternalYield) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_7_map{m: &x.TotalExternalYield})
+		if !f(fd_GenesisState_total_external_yield, value) {
+			return
+		}
+	}
+	if len(x.YieldRecipients) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_8_map{m: &x.YieldRecipients})
+		if !f(fd_GenesisState_yield_recipients, value) {
+			return
+		}
+	}
+	if len(x.RetryAmounts) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_9_map{m: &x.RetryAmounts})
+		if !f(fd_GenesisState_retry_amounts, value) {
+			return
+		}
+	}
+	if len(x.IndexHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.IndexHistory})
+		if !f(fd_GenesisState_index_history, value) {
+			return
+		}
+	}
+	if x.EarnerRate != "" {
+		value := protoreflect.ValueOfString(x.EarnerRate)
+		if !f(fd_GenesisState_earner_rate, value) {
+			return
+		}
+	}
+	if len(x.YieldClaimRecipients) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_12_map{m: &x.YieldClaimRecipients})
+		if !f(fd_GenesisState_yield_claim_recipients, value) {
+			return
+		}
+	}
+	if x.ClaimTip != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ClaimTip)
+		if !f(fd_GenesisState_claim_tip, value) {
+			return
+		}
+	}
+	if len(x.YieldSplits) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_14_map{m: &x.YieldSplits})
+		if !f(fd_GenesisState_yield_splits, value) {
+			return
+		}
+	}
+	if len(x.AutoClaimAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.AutoClaimAccounts})
+		if !f(fd_GenesisState_auto_claim_accounts, value) {
+			return
+		}
+	}
+	if x.AutoClaimInProgress != false {
+		value := protoreflect.ValueOfBool(x.AutoClaimInProgress)
+		if !f(fd_GenesisState_auto_claim_in_progress, value) {
+			return
+		}
+	}
+	if x.AutoClaimCursor != "" {
+		value := protoreflect.ValueOfString(x.AutoClaimCursor)
+		if !f(fd_GenesisState_auto_claim_cursor, value) {
+			return
+		}
+	}
+	if len(x.NonEarningAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.NonEarningAccounts})
+		if !f(fd_GenesisState_non_earning_accounts, value) {
+			return
+		}
+	}
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_GenesisState_treasury, value) {
+			return
+		}
+	}
+	if x.MIndex != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MIndex)
+		if !f(fd_GenesisState_m_index, value) {
+			return
+		}
+	}
+	if x.Fee != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Fee)
+		if !f(fd_GenesisState_fee, value) {
+			return
+		}
+	}
+	if x.FeeCollector != "" {
+		value := protoreflect.ValueOfString(x.FeeCollector)
+		if !f(fd_GenesisState_fee_collector, value) {
+			return
+		}
+	}
+	if len(x.FrozenAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.FrozenAccounts})
+		if !f(fd_GenesisState_frozen_accounts, value) {
+			return
+		}
+	}
+	if x.PausedType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PausedType))
+		if !f(fd_GenesisState_paused_type, value) {
+			return
+		}
+	}
+	if len(x.PauseInfos) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.PauseInfos})
+		if !f(fd_GenesisState_pause_infos, value) {
+			return
+		}
+	}
+	if len(x.Roles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.Roles})
+		if !f(fd_GenesisState_roles, value) {
+			return
+		}
+	}
+	if x.TimelockDelay != nil {
+		value := protoreflect.ValueOfMessage(x.TimelockDelay.ProtoReflect())
+		if !f(fd_GenesisState_timelock_delay, value) {
+			return
+		}
+	}
+	if len(x.TimelockedMessages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.TimelockedMessages})
+		if !f(fd_GenesisState_timelocked_messages, value) {
+			return
+		}
+	}
+	if x.TimelockNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimelockNonce)
+		if !f(fd_GenesisState_timelock_nonce, value) {
+			return
+		}
+	}
+	if x.MaxYieldRate != "" {
+		value := protoreflect.ValueOfString(x.MaxYieldRate)
+		if !f(fd_GenesisState_max_yield_rate, value) {
+			return
+		}
+	}
+	if x.PendingIndex != nil {
+		value := protoreflect.ValueOfMessage(x.PendingIndex.ProtoReflect())
+		if !f(fd_GenesisState_pending_index, value) {
+			return
+		}
+	}
+	if x.IndexStaleness != nil {
+		value := protoreflect.ValueOfMessage(x.IndexStaleness.ProtoReflect())
+		if !f(fd_GenesisState_index_staleness, value) {
+			return
+		}
+	}
+	if x.IndexStale != false {
+		value := protoreflect.ValueOfBool(x.IndexStale)
+		if !f(fd_GenesisState_index_stale, value) {
+			return
+		}
+	}
+	if x.HaircutMode != false {
+		value := protoreflect.ValueOfBool(x.HaircutMode)
+		if !f(fd_GenesisState_haircut_mode, value) {
+			return
+		}
+	}
+	if x.HaircutFactor != "" {
+		value := protoreflect.ValueOfString(x.HaircutFactor)
+		if !f(fd_GenesisState_haircut_factor, value) {
+			return
+		}
+	}
+	if len(x.YieldDistributions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_36_list{list: &x.YieldDistributions})
+		if !f(fd_GenesisState_yield_distributions, value) {
+			return
+		}
+	}
+	if x.AttestedMIndex != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AttestedMIndex)
+		if !f(fd_GenesisState_attested_m_index, value) {
+			return
+		}
+	}
+	if x.IndexHistoryStart != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IndexHistoryStart)
+		if !f(fd_GenesisState_index_history_start, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		return x.Portal != nil
+	case "noble.dollar.v2.GenesisState.vaults":
+		return x.Vaults != nil
+	case "noble.dollar.v2.GenesisState.paused":
+		return x.Paused != false
+	case "noble.dollar.v2.GenesisState.index":
+		return x.Index != int64(0)
+	case "noble.dollar.v2.GenesisState.principal":
+		return len(x.Principal) != 0
+	case "noble.dollar.v2.GenesisState.stats":
+		return x.Stats != nil
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		return len(x.TotalExternalYield) != 0
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		return len(x.YieldRecipients) != 0
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		return len(x.RetryAmounts) != 0
+	case "noble.dollar.v2.GenesisState.index_history":
+		return len(x.IndexHistory) != 0
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		return x.EarnerRate != ""
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		return len(x.YieldClaimRecipients) != 0
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		return x.ClaimTip != uint32(0)
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		return len(x.YieldSplits) != 0
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		return len(x.AutoClaimAccounts) != 0
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		return x.AutoClaimInProgress != false
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		return x.AutoClaimCursor != ""
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		return len(x.NonEarningAccounts) != 0
+	case "noble.dollar.v2.GenesisState.treasury":
+		return x.Treasury != ""
+	case "noble.dollar.v2.GenesisState.m_index":
+		return x.MIndex != int64(0)
+	case "noble.dollar.v2.GenesisState.fee":
+		return x.Fee != uint32(0)
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		return x.FeeCollector != ""
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		return len(x.FrozenAccounts) != 0
+	case "noble.dollar.v2.GenesisState.paused_type":
+		return x.PausedType != 0
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		return len(x.PauseInfos) != 0
+	case "noble.dollar.v2.GenesisState.roles":
+		return len(x.Roles) != 0
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		return x.TimelockDelay != nil
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		return len(x.TimelockedMessages) != 0
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		return x.TimelockNonce != uint64(0)
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		return x.MaxYieldRate != ""
+	case "noble.dollar.v2.GenesisState.pending_index":
+		return x.PendingIndex != nil
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		return x.IndexStaleness != nil
+	case "noble.dollar.v2.GenesisState.index_stale":
+		return x.IndexStale != false
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		return x.HaircutMode != false
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		return x.HaircutFactor != ""
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		return len(x.YieldDistributions) != 0
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		return x.AttestedMIndex != int64(0)
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		return x.IndexHistoryStart != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		x.Portal = nil
+	case "noble.dollar.v2.GenesisState.vaults":
+		x.Vaults = nil
+	case "noble.dollar.v2.GenesisState.paused":
+		x.Paused = false
+	case "noble.dollar.v2.GenesisState.index":
+		x.Index = int64(0)
+	case "noble.dollar.v2.GenesisState.principal":
+		x.Principal = nil
+	case "noble.dollar.v2.GenesisState.stats":
+		x.Stats = nil
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		x.TotalExternalYield = nil
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		x.YieldRecipients = nil
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		x.RetryAmounts = nil
+	case "noble.dollar.v2.GenesisState.index_history":
+		x.IndexHistory = nil
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		x.EarnerRate = ""
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		x.YieldClaimRecipients = nil
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		x.ClaimTip = uint32(0)
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		x.YieldSplits = nil
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		x.AutoClaimAccounts = nil
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		x.AutoClaimInProgress = false
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		x.AutoClaimCursor = ""
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		x.NonEarningAccounts = nil
+	case "noble.dollar.v2.GenesisState.treasury":
+		x.Treasury = ""
+	case "noble.dollar.v2.GenesisState.m_index":
+		x.MIndex = int64(0)
+	case "noble.dollar.v2.GenesisState.fee":
+		x.Fee = uint32(0)
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		x.FeeCollector = ""
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		x.FrozenAccounts = nil
+	case "noble.dollar.v2.GenesisState.paused_type":
+		x.PausedType = 0
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		x.PauseInfos = nil
+	case "noble.dollar.v2.GenesisState.roles":
+		x.Roles = nil
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		x.TimelockDelay = nil
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		x.TimelockedMessages = nil
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		x.TimelockNonce = uint64(0)
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		x.MaxYieldRate = ""
+	case "noble.dollar.v2.GenesisState.pending_index":
+		x.PendingIndex = nil
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		x.IndexStaleness = nil
+	case "noble.dollar.v2.GenesisState.index_stale":
+		x.IndexStale = false
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		x.HaircutMode = false
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		x.HaircutFactor = ""
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		x.YieldDistributions = nil
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		x.AttestedMIndex = int64(0)
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		x.IndexHistoryStart = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		value := x.Portal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.vaults":
+		value := x.Vaults
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "noble.dollar.v2.GenesisState.index":
+		value := x.Index
+		return protoreflect.ValueOfInt64(value)
+	case "noble.dollar.v2.GenesisState.principal":
+		if len(x.Principal) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_5_map{})
+		}
+		mapValue := &_GenesisState_5_map{m: &x.Principal}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.stats":
+		value := x.Stats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		if len(x.TotalExternalYield) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_7_map{})
+		}
+		mapValue := &_GenesisState_7_map{m: &x.TotalExternalYield}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		if len(x.YieldRecipients) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_8_map{})
+		}
+		mapValue := &_GenesisState_8_map{m: &x.YieldRecipients}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		if len(x.RetryAmounts) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_9_map{})
+		}
+		mapValue := &_GenesisState_9_map{m: &x.RetryAmounts}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.index_history":
+		if len(x.IndexHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.IndexHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		value := x.EarnerRate
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		if len(x.YieldClaimRecipients) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_12_map{})
+		}
+		mapValue := &_GenesisState_12_map{m: &x.YieldClaimRecipients}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		value := x.ClaimTip
+		return protoreflect.ValueOfUint32(value)
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		if len(x.YieldSplits) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_14_map{})
+		}
+		mapValue := &_GenesisState_14_map{m: &x.YieldSplits}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		if len(x.AutoClaimAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.AutoClaimAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		value := x.AutoClaimInProgress
+		return protoreflect.ValueOfBool(value)
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		value := x.AutoClaimCursor
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		if len(x.NonEarningAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.NonEarningAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.m_index":
+		value := x.MIndex
+		return protoreflect.ValueOfInt64(value)
+	case "noble.dollar.v2.GenesisState.fee":
+		value := x.Fee
+		return protoreflect.ValueOfUint32(value)
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		value := x.FeeCollector
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		if len(x.FrozenAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.FrozenAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.paused_type":
+		value := x.PausedType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		if len(x.PauseInfos) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.PauseInfos}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.roles":
+		if len(x.Roles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.Roles}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		value := x.TimelockDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		if len(x.TimelockedMessages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.TimelockedMessages}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		value := x.TimelockNonce
+		return protoreflect.ValueOfUint64(value)
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		value := x.MaxYieldRate
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.pending_index":
+		value := x.PendingIndex
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		value := x.IndexStaleness
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.index_stale":
+		value := x.IndexStale
+		return protoreflect.ValueOfBool(value)
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		value := x.HaircutMode
+		return protoreflect.ValueOfBool(value)
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		value := x.HaircutFactor
+		return protoreflect.ValueOfString(value)
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		if len(x.YieldDistributions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_36_list{})
+		}
+		listValue := &_GenesisState_36_list{list: &x.YieldDistributions}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		value := x.AttestedMIndex
+		return protoreflect.ValueOfInt64(value)
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		value := x.IndexHistoryStart
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		x.Portal = value.Message().Interface().(*v1.GenesisState)
+	case "noble.dollar.v2.GenesisState.vaults":
+		x.Vaults = value.Message().Interface().(*v11.GenesisState)
+	case "noble.dollar.v2.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "noble.dollar.v2.GenesisState.index":
+		x.Index = value.Int()
+	case "noble.dollar.v2.GenesisState.principal":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_5_map)
+		x.Principal = *cmv.m
+	case "noble.dollar.v2.GenesisState.stats":
+		x.Stats = value.Message().Interface().(*Stats)
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_7_map)
+		x.TotalExternalYield = *cmv.m
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_8_map)
+		x.YieldRecipients = *cmv.m
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_9_map)
+		x.RetryAmounts = *cmv.m
+	case "noble.dollar.v2.GenesisState.index_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.IndexHistory = *clv.list
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		x.EarnerRate = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_12_map)
+		x.YieldClaimRecipients = *cmv.m
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		x.ClaimTip = uint32(value.Uint())
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_14_map)
+		x.YieldSplits = *cmv.m
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.AutoClaimAccounts = *clv.list
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		x.AutoClaimInProgress = value.Bool()
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		x.AutoClaimCursor = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.NonEarningAccounts = *clv.list
+	case "noble.dollar.v2.GenesisState.treasury":
+		x.Treasury = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.m_index":
+		x.MIndex = value.Int()
+	case "noble.dollar.v2.GenesisState.fee":
+		x.Fee = uint32(value.Uint())
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		x.FeeCollector = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.FrozenAccounts = *clv.list
+	case "noble.dollar.v2.GenesisState.paused_type":
+		x.PausedType = (PausedType)(value.Enum())
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.PauseInfos = *clv.list
+	case "noble.dollar.v2.GenesisState.roles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.Roles = *clv.list
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		x.TimelockDelay = value.Message().Interface().(*durationpb.Duration)
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.TimelockedMessages = *clv.list
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		x.TimelockNonce = value.Uint()
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		x.MaxYieldRate = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.pending_index":
+		x.PendingIndex = value.Message().Interface().(*PendingIndex)
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		x.IndexStaleness = value.Message().Interface().(*IndexStaleness)
+	case "noble.dollar.v2.GenesisState.index_stale":
+		x.IndexStale = value.Bool()
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		x.HaircutMode = value.Bool()
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		x.HaircutFactor = value.Interface().(string)
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_36_list)
+		x.YieldDistributions = *clv.list
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		x.AttestedMIndex = value.Int()
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		x.IndexHistoryStart = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		if x.Portal == nil {
+			x.Portal = new(v1.GenesisState)
+		}
+		return protoreflect.ValueOfMessage(x.Portal.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.vaults":
+		if x.Vaults == nil {
+			x.Vaults = new(v11.GenesisState)
+		}
+		return protoreflect.ValueOfMessage(x.Vaults.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.principal":
+		if x.Principal == nil {
+			x.Principal = make(map[string]string)
+		}
+		value := &_GenesisState_5_map{m: &x.Principal}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.stats":
+		if x.Stats == nil {
+			x.Stats = new(Stats)
+		}
+		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		if x.TotalExternalYield == nil {
+			x.TotalExternalYield = make(map[string]string)
+		}
+		value := &_GenesisState_7_map{m: &x.TotalExternalYield}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		if x.YieldRecipients == nil {
+			x.YieldRecipients = make(map[string]string)
+		}
+		value := &_GenesisState_8_map{m: &x.YieldRecipients}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		if x.RetryAmounts == nil {
+			x.RetryAmounts = make(map[string]string)
+		}
+		value := &_GenesisState_9_map{m: &x.RetryAmounts}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.index_history":
+		if x.IndexHistory == nil {
+			x.IndexHistory = []*IndexRecord{}
+		}
+		value := &_GenesisState_10_list{list: &x.IndexHistory}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		if x.YieldClaimRecipients == nil {
+			x.YieldClaimRecipients = make(map[string]string)
+		}
+		value := &_GenesisState_12_map{m: &x.YieldClaimRecipients}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		if x.YieldSplits == nil {
+			x.YieldSplits = make(map[string]*YieldSplit)
+		}
+		value := &_GenesisState_14_map{m: &x.YieldSplits}
+		return protoreflect.ValueOfMap(value)
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		if x.AutoClaimAccounts == nil {
+			x.AutoClaimAccounts = []string{}
+		}
+		value := &_GenesisState_15_list{list: &x.AutoClaimAccounts}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		if x.NonEarningAccounts == nil {
+			x.NonEarningAccounts = []string{}
+		}
+		value := &_GenesisState_18_list{list: &x.NonEarningAccounts}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		if x.FrozenAccounts == nil {
+			x.FrozenAccounts = []string{}
+		}
+		value := &_GenesisState_23_list{list: &x.FrozenAccounts}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		if x.PauseInfos == nil {
+			x.PauseInfos = []*PauseInfoEntry{}
+		}
+		value := &_GenesisState_25_list{list: &x.PauseInfos}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.roles":
+		if x.Roles == nil {
+			x.Roles = []*RoleGrant{}
+		}
+		value := &_GenesisState_26_list{list: &x.Roles}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		if x.TimelockDelay == nil {
+			x.TimelockDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TimelockDelay.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		if x.TimelockedMessages == nil {
+			x.TimelockedMessages = []*TimelockedMessage{}
+		}
+		value := &_GenesisState_28_list{list: &x.TimelockedMessages}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.pending_index":
+		if x.PendingIndex == nil {
+			x.PendingIndex = new(PendingIndex)
+		}
+		return protoreflect.ValueOfMessage(x.PendingIndex.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		if x.IndexStaleness == nil {
+			x.IndexStaleness = new(IndexStaleness)
+		}
+		return protoreflect.ValueOfMessage(x.IndexStaleness.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		if x.YieldDistributions == nil {
+			x.YieldDistributions = []*YieldDistribution{}
+		}
+		value := &_GenesisState_36_list{list: &x.YieldDistributions}
+		return protoreflect.ValueOfList(value)
+	case "noble.dollar.v2.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.index":
+		panic(fmt.Errorf("field index of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		panic(fmt.Errorf("field earner_rate of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		panic(fmt.Errorf("field claim_tip of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		panic(fmt.Errorf("field auto_claim_in_progress of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		panic(fmt.Errorf("field auto_claim_cursor of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.treasury":
+		panic(fmt.Errorf("field treasury of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.m_index":
+		panic(fmt.Errorf("field m_index of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.fee":
+		panic(fmt.Errorf("field fee of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		panic(fmt.Errorf("field fee_collector of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.paused_type":
+		panic(fmt.Errorf("field paused_type of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		panic(fmt.Errorf("field timelock_nonce of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		panic(fmt.Errorf("field max_yield_rate of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.index_stale":
+		panic(fmt.Errorf("field index_stale of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		panic(fmt.Errorf("field haircut_mode of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		panic(fmt.Errorf("field haircut_factor of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		panic(fmt.Errorf("field attested_m_index of message noble.dollar.v2.GenesisState is not mutable"))
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		panic(fmt.Errorf("field index_history_start of message noble.dollar.v2.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.dollar.v2.GenesisState.portal":
+		m := new(v1.GenesisState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.vaults":
+		m := new(v11.GenesisState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "noble.dollar.v2.GenesisState.index":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.dollar.v2.GenesisState.principal":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_5_map{m: &m})
+	case "noble.dollar.v2.GenesisState.stats":
+		m := new(Stats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.total_external_yield":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_7_map{m: &m})
+	case "noble.dollar.v2.GenesisState.yield_recipients":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_8_map{m: &m})
+	case "noble.dollar.v2.GenesisState.retry_amounts":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_9_map{m: &m})
+	case "noble.dollar.v2.GenesisState.index_history":
+		list := []*IndexRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "noble.dollar.v2.GenesisState.earner_rate":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.yield_claim_recipients":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_12_map{m: &m})
+	case "noble.dollar.v2.GenesisState.claim_tip":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.dollar.v2.GenesisState.yield_splits":
+		m := make(map[string]*YieldSplit)
+		return protoreflect.ValueOfMap(&_GenesisState_14_map{m: &m})
+	case "noble.dollar.v2.GenesisState.auto_claim_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "noble.dollar.v2.GenesisState.auto_claim_in_progress":
+		return protoreflect.ValueOfBool(false)
+	case "noble.dollar.v2.GenesisState.auto_claim_cursor":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.non_earning_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "noble.dollar.v2.GenesisState.treasury":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.m_index":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.dollar.v2.GenesisState.fee":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.dollar.v2.GenesisState.fee_collector":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.frozen_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "noble.dollar.v2.GenesisState.paused_type":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.dollar.v2.GenesisState.pause_infos":
+		list := []*PauseInfoEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "noble.dollar.v2.GenesisState.roles":
+		list := []*RoleGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "noble.dollar.v2.GenesisState.timelock_delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.timelocked_messages":
+		list := []*TimelockedMessage{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	case "noble.dollar.v2.GenesisState.timelock_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.dollar.v2.GenesisState.max_yield_rate":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.pending_index":
+		m := new(PendingIndex)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.index_staleness":
+		m := new(IndexStaleness)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.dollar.v2.GenesisState.index_stale":
+		return protoreflect.ValueOfBool(false)
+	case "noble.dollar.v2.GenesisState.haircut_mode":
+		return protoreflect.ValueOfBool(false)
+	case "noble.dollar.v2.GenesisState.haircut_factor":
+		return protoreflect.ValueOfString("")
+	case "noble.dollar.v2.GenesisState.yield_distributions":
+		list := []*YieldDistribution{}
+		return protoreflect.ValueOfList(&_GenesisState_36_list{list: &list})
+	case "noble.dollar.v2.GenesisState.attested_m_index":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.dollar.v2.GenesisState.index_history_start":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.dollar.v2.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.dollar.v2.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.dollar.v2.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Portal != nil {
+			l = options.Size(x.Portal)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Vaults != nil {
+			l = options.Size(x.Vaults)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if len(x.Principal) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.Principal))
+				for k := range x.Principal {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.Principal[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Principal {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.Stats != nil {
+			l = options.Size(x.Stats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalExternalYield) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.TotalExternalYield))
+				for k := range x.TotalExternalYield {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.TotalExternalYield[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.TotalExternalYield {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.YieldRecipients) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.YieldRecipients))
+				for k := range x.YieldRecipients {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.YieldRecipients[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.YieldRecipients {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.RetryAmounts) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.RetryAmounts))
+				for k := range x.RetryAmounts {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.RetryAmounts[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.RetryAmounts {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.IndexHistory) > 0 {
+			for _, e := range x.IndexHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EarnerRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.YieldClaimRecipients) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.YieldClaimRecipients))
+				for k := range x.YieldClaimRecipients {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.YieldClaimRecipients[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.YieldClaimRecipients {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.ClaimTip != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClaimTip))
+		}
+		if len(x.YieldSplits) > 0 {
+			SiZeMaP := func(k string, v *YieldSplit) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.YieldSplits))
+				for k := range x.YieldSplits {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.YieldSplits[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.YieldSplits {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.AutoClaimAccounts) > 0 {
+			for _, s := range x.AutoClaimAccounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AutoClaimInProgress {
+			n += 3
+		}
+		l = len(x.AutoClaimCursor)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.NonEarningAccounts) > 0 {
+			for _, s := range x.NonEarningAccounts {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MIndex != 0 {
+			n += 2 + runtime.Sov(uint64(x.MIndex))
+		}
+		if x.Fee != 0 {
+			n += 2 + runtime.Sov(uint64(x.Fee))
+		}
+		l = len(x.FeeCollector)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FrozenAccounts) > 0 {
+			for _, s := range x.FrozenAccounts {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PausedType != 0 {
+			n += 2 + runtime.Sov(uint64(x.PausedType))
+		}
+		if len(x.PauseInfos) > 0 {
+			for _, e := range x.PauseInfos {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Roles) > 0 {
+			for _, e := range x.Roles {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TimelockDelay != nil {
+			l = options.Size(x.TimelockDelay)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TimelockedMessages) > 0 {
+			for _, e := range x.TimelockedMessages {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TimelockNonce != 0 {
+			n += 2 + runtime.Sov(uint64(x.TimelockNonce))
+		}
+		l = len(x.MaxYieldRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingIndex != nil {
+			l = options.Size(x.PendingIndex)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.IndexStaleness != nil {
+			l = options.Size(x.IndexStaleness)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.IndexStale {
+			n += 3
+		}
+		if x.HaircutMode {
+			n += 3
+		}
+		l = len(x.HaircutFactor)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.YieldDistributions) > 0 {
+			for _, e := range x.YieldDistributions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AttestedMIndex != 0 {
+			n += 2 + runtime.Sov(uint64(x.AttestedMIndex))
+		}
+		if x.IndexHistoryStart != 0 {
+			n += 2 + runtime.Sov(uint64(x.IndexHistoryStart))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IndexHistoryStart != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexHistoryStart))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.AttestedMIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestedMIndex))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.YieldDistributions) > 0 {
+			for iNdEx := len(x.YieldDistributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.YieldDistributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.HaircutFactor) > 0 {
+			i -= len(x.HaircutFactor)
+			copy(dAtA[i:], x.HaircutFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HaircutFactor)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.HaircutMode {
+			i--
+			if x.HaircutMode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.IndexStale {
+			i--
+			if x.IndexStale {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.IndexStaleness != nil {
+			encoded, err := options.Marshal(x.IndexStaleness)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.PendingIndex != nil {
+			encoded, err := options.Marshal(x.PendingIndex)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.MaxYieldRate) > 0 {
+			i -= len(x.MaxYieldRate)
+			copy(dAtA[i:], x.MaxYieldRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxYieldRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if x.TimelockNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimelockNonce))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe8
+		}
+		if len(x.TimelockedMessages) > 0 {
+			for iNdEx := len(x.TimelockedMessages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TimelockedMessages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if x.TimelockDelay != nil {
+			encoded, err := options.Marshal(x.TimelockDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.Roles) > 0 {
+			for iNdEx := len(x.Roles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Roles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.PauseInfos) > 0 {
+			for iNdEx := len(x.PauseInfos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PauseInfos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if x.PausedType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PausedType))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.FrozenAccounts) > 0 {
+			for iNdEx := len(x.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FrozenAccounts[iNdEx])
+				copy(dAtA[i:], x.FrozenAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FrozenAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.FeeCollector) > 0 {
+			i -= len(x.FeeCollector)
+			copy(dAtA[i:], x.FeeCollector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeCollector)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.Fee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Fee))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.MIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MIndex))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.NonEarningAccounts) > 0 {
+			for iNdEx := len(x.NonEarningAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NonEarningAccounts[iNdEx])
+				copy(dAtA[i:], x.NonEarningAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NonEarningAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.AutoClaimCursor) > 0 {
+			i -= len(x.AutoClaimCursor)
+			copy(dAtA[i:], x.AutoClaimCursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AutoClaimCursor)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.AutoClaimInProgress {
+			i--
+			if x.AutoClaimInProgress {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.AutoClaimAccounts) > 0 {
+			for iNdEx := len(x.AutoClaimAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AutoClaimAccounts[iNdEx])
+				copy(dAtA[i:], x.AutoClaimAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AutoClaimAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.YieldSplits) > 0 {
+			MaRsHaLmAp := func(k string, v *YieldSplit) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x72
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForYieldSplits := make([]string, 0, len(x.YieldSplits))
+				for k := range x.YieldSplits {
+					keysForYieldSplits = append(keysForYieldSplits, string(k))
+				}
+				sort.Slice(keysForYieldSplits, func(i, j int) bool {
+					return keysForYieldSplits[i] < keysForYieldSplits[j]
+				})
+				for iNdEx := len(keysForYieldSplits) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.YieldSplits[string(keysForYieldSplits[iNdEx])]
+					out, err := MaRsHaLmAp(keysForYieldSplits[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.YieldSplits {
+					v := x.YieldSplits[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.ClaimTip != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClaimTip))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.YieldClaimRecipients) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x62
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForYieldClaimRecipients := make([]string, 0, len(x.YieldClaimRecipients))
+				for k := range x.YieldClaimRecipients {
+					keysForYieldClaimRecipients = append(keysForYieldClaimRecipients, string(k))
+				}
+				sort.Slice(keysForYieldClaimRecipients, func(i, j int) bool {
+					return keysForYieldClaimRecipients[i] < keysForYieldClaimRecipients[j]
+				})
+				for iNdEx := len(keysForYieldClaimRecipients) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.YieldClaimRecipients[string(keysForYieldClaimRecipients[iNdEx])]
+					out, err := MaRsHaLmAp(keysForYieldClaimRecipients[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.YieldClaimRecipients {
+					v := x.YieldClaimRecipients[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.EarnerRate) > 0 {
+			i -= len(x.EarnerRate)
+			copy(dAtA[i:], x.EarnerRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EarnerRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.IndexHistory) > 0 {
+			for iNdEx := len(x.IndexHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IndexHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.RetryAmounts) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x4a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForRetryAmounts := make([]string, 0, len(x.RetryAmounts))
+				for k := range x.RetryAmounts {
+					keysForRetryAmounts = append(keysForRetryAmounts, string(k))
+				}
+				sort.Slice(keysForRetryAmounts, func(i, j int) bool {
+					return keysForRetryAmounts[i] < keysForRetryAmounts[j]
+				})
+				for iNdEx := len(keysForRetryAmounts) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.RetryAmounts[string(keysForRetryAmounts[iNdEx])]
+					out, err := MaRsHaLmAp(keysForRetryAmounts[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.RetryAmounts {
+					v := x.RetryAmounts[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.YieldRecipients) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x42
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForYieldRecipients := make([]string, 0, len(x.YieldRecipients))
+				for k := range x.YieldRecipients {
+					keysForYieldRecipients = append(keysForYieldRecipients, string(k))
+				}
+				sort.Slice(keysForYieldRecipients, func(i, j int) bool {
+					return keysForYieldRecipients[i] < keysForYieldRecipients[j]
+				})
+				for iNdEx := len(keysForYieldRecipients) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.YieldRecipients[string(keysForYieldRecipients[iNdEx])]
+					out, err := MaRsHaLmAp(keysForYieldRecipients[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.YieldRecipients {
+					v := x.YieldRecipients[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.TotalExternalYield) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x3a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForTotalExternalYield := make([]string, 0, len(x.TotalExternalYield))
+				for k := range x.TotalExternalYield {
+					keysForTotalExternalYield = append(keysForTotalExternalYield, string(k))
+				}
+				sort.Slice(keysForTotalExternalYield, func(i, j int) bool {
+					return keysForTotalExternalYield[i] < keysForTotalExternalYield[j]
+				})
+				for iNdEx := len(keysForTotalExternalYield) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.TotalExternalYield[string(keysForTotalExternalYield[iNdEx])]
+					out, err := MaRsHaLmAp(keysForTotalExternalYield[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.TotalExternalYield {
+					v := x.TotalExternalYield[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.Stats != nil {
+			encoded, err := options.Marshal(x.Stats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Principal) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x2a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForPrincipal := make([]string, 0, len(x.Principal))
+				for k := range x.Principal {
+					keysForPrincipal = append(keysForPrincipal, string(k))
+				}
+				sort.Slice(keysForPrincipal, func(i, j int) bool {
+					return keysForPrincipal[i] < keysForPrincipal[j]
+				})
+				for iNdEx := len(keysForPrincipal) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Principal[string(keysForPrincipal[iNdEx])]
+					out, err := MaRsHaLmAp(keysForPrincipal[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Principal {
+					v := x.Principal[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Vaults != nil {
+			encoded, err := options.Marshal(x.Vaults)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Portal != nil {
+			encoded, err := options.Marshal(x.Portal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Portal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Portal == nil {
+					x.Portal = &v1.GenesisState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Portal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Vaults == nil {
+					x.Vaults = &v11.GenesisState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vaults); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Principal == nil {
+					x.Principal = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Principal[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stats == nil {
+					x.Stats = &Stats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalExternalYield == nil {
+					x.TotalExternalYield = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.TotalExternalYield[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.YieldRecipients == nil {
+					x.YieldRecipients = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.YieldRecipients[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RetryAmounts == nil {
+					x.RetryAmounts = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.RetryAmounts[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IndexHistory = append(x.IndexHistory, &IndexRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndexHistory[len(x.IndexHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarnerRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EarnerRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldClaimRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.YieldClaimRecipients == nil {
+					x.YieldClaimRecipients = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.YieldClaimRecipients[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+				}
+				x.ClaimTip = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClaimTip |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.YieldSplits == nil {
+					x.YieldSplits = make(map[string]*YieldSplit)
+				}
+				var mapkey string
+				var mapvalue *YieldSplit
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &YieldSplit{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.Principal[k]
-					SiZeMaP(k, v)
+				x.YieldSplits[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoClaimAccounts", wireType)
 				}
-			} else {
-				for k, v := range x.Principal {
-					SiZeMaP(k, v)
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-		}
-		if x.Stats != nil {
-			l = options.Size(x.Stats)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.TotalExternalYield) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.TotalExternalYield))
-				for k := range x.TotalExternalYield {
-					sortme = append(sortme, k)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.TotalExternalYield[k]
-					SiZeMaP(k, v)
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			} else {
-				for k, v := range x.TotalExternalYield {
-					SiZeMaP(k, v)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			}
-		}
-		if len(x.YieldRecipients) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.YieldRecipients))
-				for k := range x.YieldRecipients {
-					sortme = append(sortme, k)
+				x.AutoClaimAccounts = append(x.AutoClaimAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoClaimInProgress", wireType)
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.YieldRecipients[k]
-					SiZeMaP(k, v)
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			} else {
-				for k, v := range x.YieldRecipients {
-					SiZeMaP(k, v)
+				x.AutoClaimInProgress = bool(v != 0)
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoClaimCursor", wireType)
 				}
-			}
-		}
-		if len(x.RetryAmounts) > 0 {
-			SiZeMaP := func(k string, v string) {
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.RetryAmounts))
-				for k := range x.RetryAmounts {
-					sortme = append(sortme, k)
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.RetryAmounts[k]
-					SiZeMaP(k, v)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			} else {
-				for k, v := range x.RetryAmounts {
-					SiZeMaP(k, v)
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.RetryAmounts) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x4a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForRetryAmounts := make([]string, 0, len(x.RetryAmounts))
-				for k := range x.RetryAmounts {
-					keysForRetryAmounts = append(keysForRetryAmounts, string(k))
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AutoClaimCursor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonEarningAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				sort.Slice(keysForRetryAmounts, func(i, j int) bool {
-					return keysForRetryAmounts[i] < keysForRetryAmounts[j]
-				})
-				for iNdEx := len(keysForRetryAmounts) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.RetryAmounts[string(keysForRetryAmounts[iNdEx])]
-					out, err := MaRsHaLmAp(keysForRetryAmounts[iNdEx], v)
-					if err != nil {
-						return out, err
+				x.NonEarningAccounts = append(x.NonEarningAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			} else {
-				for k := range x.RetryAmounts {
-					v := x.RetryAmounts[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MIndex", wireType)
+				}
+				x.MIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MIndex |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if len(x.YieldRecipients) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x42
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForYieldRecipients := make([]string, 0, len(x.YieldRecipients))
-				for k := range x.YieldRecipients {
-					keysForYieldRecipients = append(keysForYieldRecipients, string(k))
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 				}
-				sort.Slice(keysForYieldRecipients, func(i, j int) bool {
-					return keysForYieldRecipients[i] < keysForYieldRecipients[j]
-				})
-				for iNdEx := len(keysForYieldRecipients) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.YieldRecipients[string(keysForYieldRecipients[iNdEx])]
-					out, err := MaRsHaLmAp(keysForYieldRecipients[iNdEx], v)
-					if err != nil {
-						return out, err
+				x.Fee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Fee |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			} else {
-				for k := range x.YieldRecipients {
-					v := x.YieldRecipients[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if len(x.TotalExternalYield) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x3a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForTotalExternalYield := make([]string, 0, len(x.TotalExternalYield))
-				for k := range x.TotalExternalYield {
-					keysForTotalExternalYield = append(keysForTotalExternalYield, string(k))
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				sort.Slice(keysForTotalExternalYield, func(i, j int) bool {
-					return keysForTotalExternalYield[i] < keysForTotalExternalYield[j]
-				})
-				for iNdEx := len(keysForTotalExternalYield) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.TotalExternalYield[string(keysForTotalExternalYield[iNdEx])]
-					out, err := MaRsHaLmAp(keysForTotalExternalYield[iNdEx], v)
-					if err != nil {
-						return out, err
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeCollector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			} else {
-				for k := range x.TotalExternalYield {
-					v := x.TotalExternalYield[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrozenAccounts = append(x.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedType", wireType)
+				}
+				x.PausedType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PausedType |= PausedType(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if x.Stats != nil {
-			encoded, err := options.Marshal(x.Stats)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Principal) > 0 {
-			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x2a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForPrincipal := make([]string, 0, len(x.Principal))
-				for k := range x.Principal {
-					keysForPrincipal = append(keysForPrincipal, string(k))
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseInfos", wireType)
 				}
-				sort.Slice(keysForPrincipal, func(i, j int) bool {
-					return keysForPrincipal[i] < keysForPrincipal[j]
-				})
-				for iNdEx := len(keysForPrincipal) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.Principal[string(keysForPrincipal[iNdEx])]
-					out, err := MaRsHaLmAp(keysForPrincipal[iNdEx], v)
-					if err != nil {
-						return out, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			} else {
-				for k := range x.Principal {
-					v := x.Principal[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			}
-		}
-		if x.Index != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Paused {
-			i--
-			if x.Paused {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Vaults != nil {
-			encoded, err := options.Marshal(x.Vaults)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Portal != nil {
-			encoded, err := options.Marshal(x.Portal)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.PauseInfos = append(x.PauseInfos, &PauseInfoEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauseInfos[len(x.PauseInfos)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 26:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Portal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1266,16 +4410,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Portal == nil {
-					x.Portal = &v1.GenesisState{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Portal); err != nil {
+				x.Roles = append(x.Roles, &RoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Roles[len(x.Roles)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 27:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockDelay", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1302,18 +4444,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Vaults == nil {
-					x.Vaults = &v11.GenesisState{}
+				if x.TimelockDelay == nil {
+					x.TimelockDelay = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vaults); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimelockDelay); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockedMessages", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1323,17 +4465,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Paused = bool(v != 0)
-			case 4:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TimelockedMessages = append(x.TimelockedMessages, &TimelockedMessage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimelockedMessages[len(x.TimelockedMessages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 29:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockNonce", wireType)
 				}
-				x.Index = 0
+				x.TimelockNonce = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1343,16 +4499,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Index |= int64(b&0x7F) << shift
+					x.TimelockNonce |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 30:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxYieldRate", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1362,122 +4518,63 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Principal == nil {
-					x.Principal = make(map[string]string)
+				x.MaxYieldRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingIndex", wireType)
 				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingIndex == nil {
+					x.PendingIndex = &PendingIndex{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingIndex); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Principal[mapkey] = mapvalue
 				iNdEx = postIndex
-			case 6:
+			case 32:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexStaleness", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1504,18 +4601,58 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Stats == nil {
-					x.Stats = &Stats{}
+				if x.IndexStaleness == nil {
+					x.IndexStaleness = &IndexStaleness{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndexStaleness); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 33:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexStale", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IndexStale = bool(v != 0)
+			case 34:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaircutMode", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.HaircutMode = bool(v != 0)
+			case 35:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaircutFactor", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1525,122 +4662,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalExternalYield == nil {
-					x.TotalExternalYield = make(map[string]string)
-				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
-				}
-				x.TotalExternalYield[mapkey] = mapvalue
+				x.HaircutFactor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 36:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldDistributions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1667,109 +4709,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.YieldRecipients == nil {
-					x.YieldRecipients = make(map[string]string)
-				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
+				x.YieldDistributions = append(x.YieldDistributions, &YieldDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.YieldDistributions[len(x.YieldDistributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.YieldRecipients[mapkey] = mapvalue
 				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+			case 37:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestedMIndex", wireType)
 				}
-				var msglen int
+				x.AttestedMIndex = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1779,119 +4728,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.AttestedMIndex |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.RetryAmounts == nil {
-					x.RetryAmounts = make(map[string]string)
+			case 38:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexHistoryStart", wireType)
 				}
-				var mapkey string
-				var mapvalue string
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				x.IndexHistoryStart = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexHistoryStart |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-				x.RetryAmounts[mapkey] = mapvalue
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1951,6 +4811,7 @@ type GenesisState struct {
 	// vaults contains the genesis state of the Noble Dollar Vaults submodule.
 	Vaults *v11.GenesisState `protobuf:"bytes,2,opt,name=vaults,proto3" json:"vaults,omitempty"`
 	// paused contains the genesis paused state of the Noble Dollar.
+	// Deprecated: Use paused_type instead. If true, yield claims are paused.
 	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 	// index contains the genesis index of the Noble Dollar, used for rebasing.
 	Index int64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
@@ -1964,6 +4825,64 @@ type GenesisState struct {
 	YieldRecipients map[string]string `protobuf:"bytes,8,rep,name=yield_recipients,json=yieldRecipients,proto3" json:"yield_recipients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// retry_amounts contains the genesis retry amounts of yield for external chains.
 	RetryAmounts map[string]string `protobuf:"bytes,9,rep,name=retry_amounts,json=retryAmounts,proto3" json:"retry_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// index_history contains the genesis historical records of the index.
+	IndexHistory []*IndexRecord `protobuf:"bytes,10,rep,name=index_history,json=indexHistory,proto3" json:"index_history,omitempty"`
+	// earner_rate contains the genesis earner rate, used for extrapolating the index.
+	EarnerRate string `protobuf:"bytes,11,opt,name=earner_rate,json=earnerRate,proto3" json:"earner_rate,omitempty"`
+	// yield_claim_recipients contains the genesis recipients of claimed yield for holders.
+	YieldClaimRecipients map[string]string `protobuf:"bytes,12,rep,name=yield_claim_recipients,json=yieldClaimRecipients,proto3" json:"yield_claim_recipients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// claim_tip contains the genesis tip paid to signers claiming yield on behalf of holders.
+	ClaimTip uint32 `protobuf:"varint,13,opt,name=claim_tip,json=claimTip,proto3" json:"claim_tip,omitempty"`
+	// yield_splits contains the genesis splits of holders' claimed yield.
+	YieldSplits map[string]*YieldSplit `protobuf:"bytes,14,rep,name=yield_splits,json=yieldSplits,proto3" json:"yield_splits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// auto_claim_accounts contains the genesis accounts whose yield is claimed automatically.
+	AutoClaimAccounts []string `protobuf:"bytes,15,rep,name=auto_claim_accounts,json=autoClaimAccounts,proto3" json:"auto_claim_accounts,omitempty"`
+	// auto_claim_in_progress indicates whether a pass over the auto claim accounts is in progress.
+	AutoClaimInProgress bool `protobuf:"varint,16,opt,name=auto_claim_in_progress,json=autoClaimInProgress,proto3" json:"auto_claim_in_progress,omitempty"`
+	// auto_claim_cursor contains the last account processed in the current auto claim pass.
+	AutoClaimCursor string `protobuf:"bytes,17,opt,name=auto_claim_cursor,json=autoClaimCursor,proto3" json:"auto_claim_cursor,omitempty"`
+	// non_earning_accounts contains the genesis accounts whose yield is swept to the treasury.
+	NonEarningAccounts []string `protobuf:"bytes,18,rep,name=non_earning_accounts,json=nonEarningAccounts,proto3" json:"non_earning_accounts,omitempty"`
+	// treasury contains the genesis treasury that the yield of non-earning accounts is swept to.
+	Treasury string `protobuf:"bytes,19,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// m_index contains the genesis index of the underlying $M, before fees.
+	MIndex int64 `protobuf:"varint,20,opt,name=m_index,json=mIndex,proto3" json:"m_index,omitempty"`
+	// fee contains the genesis fee rate in basis points, taken from newly accrued yield.
+	Fee uint32 `protobuf:"varint,21,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_collector contains the genesis collector of fees.
+	FeeCollector string `protobuf:"bytes,22,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// frozen_accounts contains the genesis accounts that are frozen.
+	FrozenAccounts []string `protobuf:"bytes,23,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	// paused_type contains the genesis bitmask of paused actions of the Noble Dollar.
+	PausedType PausedType `protobuf:"varint,24,opt,name=paused_type,json=pausedType,proto3,enum=noble.dollar.v2.PausedType" json:"paused_type,omitempty"`
+	// pause_infos contains the genesis expiry and reason of paused actions.
+	PauseInfos []*PauseInfoEntry `protobuf:"bytes,25,rep,name=pause_infos,json=pauseInfos,proto3" json:"pause_infos,omitempty"`
+	// roles contains the genesis roles granted to accounts.
+	Roles []*RoleGrant `protobuf:"bytes,26,rep,name=roles,proto3" json:"roles,omitempty"`
+	// timelock_delay contains the genesis delay after which timelocked admin messages are executed.
+	TimelockDelay *durationpb.Duration `protobuf:"bytes,27,opt,name=timelock_delay,json=timelockDelay,proto3" json:"timelock_delay,omitempty"`
+	// timelocked_messages contains the genesis pending timelocked admin messages.
+	TimelockedMessages []*TimelockedMessage `protobuf:"bytes,28,rep,name=timelocked_messages,json=timelockedMessages,proto3" json:"timelocked_messages,omitempty"`
+	// timelock_nonce contains the genesis identifier of the next timelocked admin message.
+	TimelockNonce uint64 `protobuf:"varint,29,opt,name=timelock_nonce,json=timelockNonce,proto3" json:"timelock_nonce,omitempty"`
+	// max_yield_rate contains the genesis maximum annual yield rate implied by index updates.
+	MaxYieldRate string `protobuf:"bytes,30,opt,name=max_yield_rate,json=maxYieldRate,proto3" json:"max_yield_rate,omitempty"`
+	// pending_index contains the genesis quarantined index of $M, if any.
+	PendingIndex *PendingIndex `protobuf:"bytes,31,opt,name=pending_index,json=pendingIndex,proto3" json:"pending_index,omitempty"`
+	// index_staleness contains the genesis configuration of when the index is considered stale.
+	IndexStaleness *IndexStaleness `protobuf:"bytes,32,opt,name=index_staleness,json=indexStaleness,proto3" json:"index_staleness,omitempty"`
+	// index_stale contains the genesis flag of whether the index has been reported as stale.
+	IndexStale bool `protobuf:"varint,33,opt,name=index_stale,json=indexStale,proto3" json:"index_stale,omitempty"`
+	// haircut_mode contains the genesis flag of whether decreasing index updates are accepted.
+	HaircutMode bool `protobuf:"varint,34,opt,name=haircut_mode,json=haircutMode,proto3" json:"haircut_mode,omitempty"`
+	// haircut_factor contains the genesis fraction of $USDN balances that is backed by $M.
+	HaircutFactor string `protobuf:"bytes,35,opt,name=haircut_factor,json=haircutFactor,proto3" json:"haircut_factor,omitempty"`
+	// yield_distributions contains the genesis records of how yield was distributed by index updates.
+	YieldDistributions []*YieldDistribution `protobuf:"bytes,36,rep,name=yield_distributions,json=yieldDistributions,proto3" json:"yield_distributions,omitempty"`
+	// attested_m_index contains the genesis last index of $M attested by the portal.
+	AttestedMIndex int64 `protobuf:"varint,37,opt,name=attested_m_index,json=attestedMIndex,proto3" json:"attested_m_index,omitempty"`
+	// index_history_start contains the genesis time (unix seconds) the index history started being recorded, if it was started by an upgrade.
+	IndexHistoryStart int64 `protobuf:"varint,38,opt,name=index_history_start,json=indexHistoryStart,proto3" json:"index_history_start,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2049,87 +4968,413 @@ func (x *GenesisState) GetRetryAmounts() map[string]string {
 	return nil
 }
 
+func (x *GenesisState) GetIndexHistory() []*IndexRecord {
+	if x != nil {
+		return x.IndexHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEarnerRate() string {
+	if x != nil {
+		return x.EarnerRate
+	}
+	return ""
+}
+
+func (x *GenesisState) GetYieldClaimRecipients() map[string]string {
+	if x != nil {
+		return x.YieldClaimRecipients
+	}
+	return nil
+}
+
+func (x *GenesisState) GetClaimTip() uint32 {
+	if x != nil {
+		return x.ClaimTip
+	}
+	return 0
+}
+
+func (x *GenesisState) GetYieldSplits() map[string]*YieldSplit {
+	if x != nil {
+		return x.YieldSplits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoClaimAccounts() []string {
+	if x != nil {
+		return x.AutoClaimAccounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoClaimInProgress() bool {
+	if x != nil {
+		return x.AutoClaimInProgress
+	}
+	return false
+}
+
+func (x *GenesisState) GetAutoClaimCursor() string {
+	if x != nil {
+		return x.AutoClaimCursor
+	}
+	return ""
+}
+
+func (x *GenesisState) GetNonEarningAccounts() []string {
+	if x != nil {
+		return x.NonEarningAccounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *GenesisState) GetMIndex() int64 {
+	if x != nil {
+		return x.MIndex
+	}
+	return 0
+}
+
+func (x *GenesisState) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *GenesisState) GetFeeCollector() string {
+	if x != nil {
+		return x.FeeCollector
+	}
+	return ""
+}
+
+func (x *GenesisState) GetFrozenAccounts() []string {
+	if x != nil {
+		return x.FrozenAccounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPausedType() PausedType {
+	if x != nil {
+		return x.PausedType
+	}
+	return PausedType_NONE
+}
+
+func (x *GenesisState) GetPauseInfos() []*PauseInfoEntry {
+	if x != nil {
+		return x.PauseInfos
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRoles() []*RoleGrant {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelockDelay() *durationpb.Duration {
+	if x != nil {
+		return x.TimelockDelay
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelockedMessages() []*TimelockedMessage {
+	if x != nil {
+		return x.TimelockedMessages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelockNonce() uint64 {
+	if x != nil {
+		return x.TimelockNonce
+	}
+	return 0
+}
+
+func (x *GenesisState) GetMaxYieldRate() string {
+	if x != nil {
+		return x.MaxYieldRate
+	}
+	return ""
+}
+
+func (x *GenesisState) GetPendingIndex() *PendingIndex {
+	if x != nil {
+		return x.PendingIndex
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIndexStaleness() *IndexStaleness {
+	if x != nil {
+		return x.IndexStaleness
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIndexStale() bool {
+	if x != nil {
+		return x.IndexStale
+	}
+	return false
+}
+
+func (x *GenesisState) GetHaircutMode() bool {
+	if x != nil {
+		return x.HaircutMode
+	}
+	return false
+}
+
+func (x *GenesisState) GetHaircutFactor() string {
+	if x != nil {
+		return x.HaircutFactor
+	}
+	return ""
+}
+
+func (x *GenesisState) GetYieldDistributions() []*YieldDistribution {
+	if x != nil {
+		return x.YieldDistributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAttestedMIndex() int64 {
+	if x != nil {
+		return x.AttestedMIndex
+	}
+	return 0
+}
+
+func (x *GenesisState) GetIndexHistoryStart() int64 {
+	if x != nil {
+		return x.IndexHistoryStart
+	}
+	return 0
+}
+
 var File_noble_dollar_v2_genesis_proto protoreflect.FileDescriptor
 
 var file_noble_dollar_v2_genesis_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76,
 	0x32, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32,
-	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x64, 0x6f,
-	0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x67,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x6f,
-	0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xec, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
-	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f,
-	0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
-	0x69, 0x70, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69,
-	0x70, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
-	0x70, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61,
-	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f,
+	0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7,
+	0x15, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x42, 0x0a, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x59, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5d,
+	0x0a, 0x10, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a,
+	0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x57, 0x0a, 0x0b,
+	0x65, 0x61, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x65, 0x61, 0x72, 0x6e, 0x65,
+	0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f,
 	0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x59, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x5d, 0x0a, 0x10, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f,
-	0x79, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x54, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64,
-	0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
-	0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x59, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f,
-	0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
-	0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f,
-	0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x32,
-	0x3b, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x4e, 0x44, 0x58, 0xaa,
-	0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x56,
-	0x32, 0xca, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72,
-	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x44, 0x6f, 0x6c, 0x6c,
-	0x61, 0x72, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x44, 0x6f, 0x6c, 0x6c, 0x61,
-	0x72, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x79, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x69,
+	0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69,
+	0x70, 0x12, 0x57, 0x0a, 0x0c, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x79,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x6f, 0x6e, 0x45, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x46, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x4a, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x13,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x5c,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0d,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x4e, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x13, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x79, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x4d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a,
+	0x14, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x10, 0x59,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x76, 0x32,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x30, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78,
+	0x79, 0x7a, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f,
+	0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72,
+	0x76, 0x32, 0xa2, 0x02, 0x03, 0x4e, 0x44, 0x58, 0xaa, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x5c, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x5c, 0x56, 0x32, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x3a, 0x3a, 0x44, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2144,30 +5389,54 @@ func file_noble_dollar_v2_genesis_proto_rawDescGZIP() []byte {
 	return file_noble_dollar_v2_genesis_proto_rawDescData
 }
 
-var file_noble_dollar_v2_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_noble_dollar_v2_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_noble_dollar_v2_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),     // 0: noble.dollar.v2.GenesisState
-	nil,                      // 1: noble.dollar.v2.GenesisState.PrincipalEntry
-	nil,                      // 2: noble.dollar.v2.GenesisState.TotalExternalYieldEntry
-	nil,                      // 3: noble.dollar.v2.GenesisState.YieldRecipientsEntry
-	nil,                      // 4: noble.dollar.v2.GenesisState.RetryAmountsEntry
-	(*v1.GenesisState)(nil),  // 5: noble.dollar.portal.v1.GenesisState
-	(*v11.GenesisState)(nil), // 6: noble.dollar.vaults.v1.GenesisState
-	(*Stats)(nil),            // 7: noble.dollar.v2.Stats
+	(*GenesisState)(nil),        // 0: noble.dollar.v2.GenesisState
+	nil,                         // 1: noble.dollar.v2.GenesisState.PrincipalEntry
+	nil,                         // 2: noble.dollar.v2.GenesisState.TotalExternalYieldEntry
+	nil,                         // 3: noble.dollar.v2.GenesisState.YieldRecipientsEntry
+	nil,                         // 4: noble.dollar.v2.GenesisState.RetryAmountsEntry
+	nil,                         // 5: noble.dollar.v2.GenesisState.YieldClaimRecipientsEntry
+	nil,                         // 6: noble.dollar.v2.GenesisState.YieldSplitsEntry
+	(*v1.GenesisState)(nil),     // 7: noble.dollar.portal.v1.GenesisState
+	(*v11.GenesisState)(nil),    // 8: noble.dollar.vaults.v1.GenesisState
+	(*Stats)(nil),               // 9: noble.dollar.v2.Stats
+	(*IndexRecord)(nil),         // 10: noble.dollar.v2.IndexRecord
+	(PausedType)(0),             // 11: noble.dollar.v2.PausedType
+	(*PauseInfoEntry)(nil),      // 12: noble.dollar.v2.PauseInfoEntry
+	(*RoleGrant)(nil),           // 13: noble.dollar.v2.RoleGrant
+	(*durationpb.Duration)(nil), // 14: google.protobuf.Duration
+	(*TimelockedMessage)(nil),   // 15: noble.dollar.v2.TimelockedMessage
+	(*PendingIndex)(nil),        // 16: noble.dollar.v2.PendingIndex
+	(*IndexStaleness)(nil),      // 17: noble.dollar.v2.IndexStaleness
+	(*YieldDistribution)(nil),   // 18: noble.dollar.v2.YieldDistribution
+	(*YieldSplit)(nil),          // 19: noble.dollar.v2.YieldSplit
 }
 var file_noble_dollar_v2_genesis_proto_depIdxs = []int32{
-	5, // 0: noble.dollar.v2.GenesisState.portal:type_name -> noble.dollar.portal.v1.GenesisState
-	6, // 1: noble.dollar.v2.GenesisState.vaults:type_name -> noble.dollar.vaults.v1.GenesisState
-	1, // 2: noble.dollar.v2.GenesisState.principal:type_name -> noble.dollar.v2.GenesisState.PrincipalEntry
-	7, // 3: noble.dollar.v2.GenesisState.stats:type_name -> noble.dollar.v2.Stats
-	2, // 4: noble.dollar.v2.GenesisState.total_external_yield:type_name -> noble.dollar.v2.GenesisState.TotalExternalYieldEntry
-	3, // 5: noble.dollar.v2.GenesisState.yield_recipients:type_name -> noble.dollar.v2.GenesisState.YieldRecipientsEntry
-	4, // 6: noble.dollar.v2.GenesisState.retry_amounts:type_name -> noble.dollar.v2.GenesisState.RetryAmountsEntry
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7,  // 0: noble.dollar.v2.GenesisState.portal:type_name -> noble.dollar.portal.v1.GenesisState
+	8,  // 1: noble.dollar.v2.GenesisState.vaults:type_name -> noble.dollar.vaults.v1.GenesisState
+	1,  // 2: noble.dollar.v2.GenesisState.principal:type_name -> noble.dollar.v2.GenesisState.PrincipalEntry
+	9,  // 3: noble.dollar.v2.GenesisState.stats:type_name -> noble.dollar.v2.Stats
+	2,  // 4: noble.dollar.v2.GenesisState.total_external_yield:type_name -> noble.dollar.v2.GenesisState.TotalExternalYieldEntry
+	3,  // 5: noble.dollar.v2.GenesisState.yield_recipients:type_name -> noble.dollar.v2.GenesisState.YieldRecipientsEntry
+	4,  // 6: noble.dollar.v2.GenesisState.retry_amounts:type_name -> noble.dollar.v2.GenesisState.RetryAmountsEntry
+	10, // 7: noble.dollar.v2.GenesisState.index_history:type_name -> noble.dollar.v2.IndexRecord
+	5,  // 8: noble.dollar.v2.GenesisState.yield_claim_recipients:type_name -> noble.dollar.v2.GenesisState.YieldClaimRecipientsEntry
+	6,  // 9: noble.dollar.v2.GenesisState.yield_splits:type_name -> noble.dollar.v2.GenesisState.YieldSplitsEntry
+	11, // 10: noble.dollar.v2.GenesisState.paused_type:type_name -> noble.dollar.v2.PausedType
+	12, // 11: noble.dollar.v2.GenesisState.pause_infos:type_name -> noble.dollar.v2.PauseInfoEntry
+	13, // 12: noble.dollar.v2.GenesisState.roles:type_name -> noble.dollar.v2.RoleGrant
+	14, // 13: noble.dollar.v2.GenesisState.timelock_delay:type_name -> google.protobuf.Duration
+	15, // 14: noble.dollar.v2.GenesisState.timelocked_messages:type_name -> noble.dollar.v2.TimelockedMessage
+	16, // 15: noble.dollar.v2.GenesisState.pending_index:type_name -> noble.dollar.v2.PendingIndex
+	17, // 16: noble.dollar.v2.GenesisState.index_staleness:type_name -> noble.dollar.v2.IndexStaleness
+	18, // 17: noble.dollar.v2.GenesisState.yield_distributions:type_name -> noble.dollar.v2.YieldDistribution
+	19, // 18: noble.dollar.v2.GenesisState.YieldSplitsEntry.value:type_name -> noble.dollar.v2.YieldSplit
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_noble_dollar_v2_genesis_proto_init() }
@@ -2196,7 +5465,7 @@ func file_noble_dollar_v2_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_dollar_v2_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,22 +19,72 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Stats_FullMethodName           = "/noble.dollar.v2.Query/Stats"
-	Query_YieldRecipients_FullMethodName = "/noble.dollar.v2.Query/YieldRecipients"
-	Query_YieldRecipient_FullMethodName  = "/noble.dollar.v2.Query/YieldRecipient"
-	Query_RetryAmounts_FullMethodName    = "/noble.dollar.v2.Query/RetryAmounts"
-	Query_RetryAmount_FullMethodName     = "/noble.dollar.v2.Query/RetryAmount"
+	Query_Paused_FullMethodName              = "/noble.dollar.v2.Query/Paused"
+	Query_Pauses_FullMethodName              = "/noble.dollar.v2.Query/Pauses"
+	Query_Stats_FullMethodName               = "/noble.dollar.v2.Query/Stats"
+	Query_YieldRecipients_FullMethodName     = "/noble.dollar.v2.Query/YieldRecipients"
+	Query_YieldRecipient_FullMethodName      = "/noble.dollar.v2.Query/YieldRecipient"
+	Query_RetryAmounts_FullMethodName        = "/noble.dollar.v2.Query/RetryAmounts"
+	Query_RetryAmount_FullMethodName         = "/noble.dollar.v2.Query/RetryAmount"
+	Query_YieldClaimRecipient_FullMethodName = "/noble.dollar.v2.Query/YieldClaimRecipient"
+	Query_YieldSplit_FullMethodName          = "/noble.dollar.v2.Query/YieldSplit"
+	Query_AutoClaim_FullMethodName           = "/noble.dollar.v2.Query/AutoClaim"
+	Query_NonEarningAccounts_FullMethodName  = "/noble.dollar.v2.Query/NonEarningAccounts"
+	Query_FrozenAccounts_FullMethodName      = "/noble.dollar.v2.Query/FrozenAccounts"
+	Query_Roles_FullMethodName               = "/noble.dollar.v2.Query/Roles"
+	Query_AccountRoles_FullMethodName        = "/noble.dollar.v2.Query/AccountRoles"
+	Query_TimelockDelay_FullMethodName       = "/noble.dollar.v2.Query/TimelockDelay"
+	Query_TimelockedMessages_FullMethodName  = "/noble.dollar.v2.Query/TimelockedMessages"
+	Query_IndexCircuitBreaker_FullMethodName = "/noble.dollar.v2.Query/IndexCircuitBreaker"
+	Query_IndexStatus_FullMethodName         = "/noble.dollar.v2.Query/IndexStatus"
+	Query_Haircut_FullMethodName             = "/noble.dollar.v2.Query/Haircut"
+	Query_EffectiveBalance_FullMethodName    = "/noble.dollar.v2.Query/EffectiveBalance"
+	Query_Treasury_FullMethodName            = "/noble.dollar.v2.Query/Treasury"
+	Query_ClaimTip_FullMethodName            = "/noble.dollar.v2.Query/ClaimTip"
+	Query_Fee_FullMethodName                 = "/noble.dollar.v2.Query/Fee"
+	Query_Invariants_FullMethodName          = "/noble.dollar.v2.Query/Invariants"
+	Query_YieldLiability_FullMethodName      = "/noble.dollar.v2.Query/YieldLiability"
+	Query_IndexAt_FullMethodName             = "/noble.dollar.v2.Query/IndexAt"
+	Query_IndexHistory_FullMethodName        = "/noble.dollar.v2.Query/IndexHistory"
+	Query_YieldDistributions_FullMethodName  = "/noble.dollar.v2.Query/YieldDistributions"
+	Query_CurrentIndex_FullMethodName        = "/noble.dollar.v2.Query/CurrentIndex"
+	Query_YieldRate_FullMethodName           = "/noble.dollar.v2.Query/YieldRate"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Pauses(ctx context.Context, in *QueryPauses, opts ...grpc.CallOption) (*QueryPausesResponse, error)
 	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
 	YieldRecipients(ctx context.Context, in *QueryYieldRecipients, opts ...grpc.CallOption) (*QueryYieldRecipientsResponse, error)
 	YieldRecipient(ctx context.Context, in *QueryYieldRecipient, opts ...grpc.CallOption) (*QueryYieldRecipientResponse, error)
 	RetryAmounts(ctx context.Context, in *QueryRetryAmounts, opts ...grpc.CallOption) (*QueryRetryAmountsResponse, error)
 	RetryAmount(ctx context.Context, in *QueryRetryAmount, opts ...grpc.CallOption) (*QueryRetryAmountResponse, error)
+	YieldClaimRecipient(ctx context.Context, in *QueryYieldClaimRecipient, opts ...grpc.CallOption) (*QueryYieldClaimRecipientResponse, error)
+	YieldSplit(ctx context.Context, in *QueryYieldSplit, opts ...grpc.CallOption) (*QueryYieldSplitResponse, error)
+	AutoClaim(ctx context.Context, in *QueryAutoClaim, opts ...grpc.CallOption) (*QueryAutoClaimResponse, error)
+	NonEarningAccounts(ctx context.Context, in *QueryNonEarningAccounts, opts ...grpc.CallOption) (*QueryNonEarningAccountsResponse, error)
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccounts, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	AccountRoles(ctx context.Context, in *QueryAccountRoles, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error)
+	TimelockDelay(ctx context.Context, in *QueryTimelockDelay, opts ...grpc.CallOption) (*QueryTimelockDelayResponse, error)
+	TimelockedMessages(ctx context.Context, in *QueryTimelockedMessages, opts ...grpc.CallOption) (*QueryTimelockedMessagesResponse, error)
+	IndexCircuitBreaker(ctx context.Context, in *QueryIndexCircuitBreaker, opts ...grpc.CallOption) (*QueryIndexCircuitBreakerResponse, error)
+	IndexStatus(ctx context.Context, in *QueryIndexStatus, opts ...grpc.CallOption) (*QueryIndexStatusResponse, error)
+	Haircut(ctx context.Context, in *QueryHaircut, opts ...grpc.CallOption) (*QueryHaircutResponse, error)
+	EffectiveBalance(ctx context.Context, in *QueryEffectiveBalance, opts ...grpc.CallOption) (*QueryEffectiveBalanceResponse, error)
+	Treasury(ctx context.Context, in *QueryTreasury, opts ...grpc.CallOption) (*QueryTreasuryResponse, error)
+	ClaimTip(ctx context.Context, in *QueryClaimTip, opts ...grpc.CallOption) (*QueryClaimTipResponse, error)
+	Fee(ctx context.Context, in *QueryFee, opts ...grpc.CallOption) (*QueryFeeResponse, error)
+	Invariants(ctx context.Context, in *QueryInvariants, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	YieldLiability(ctx context.Context, in *QueryYieldLiability, opts ...grpc.CallOption) (*QueryYieldLiabilityResponse, error)
+	IndexAt(ctx context.Context, in *QueryIndexAt, opts ...grpc.CallOption) (*QueryIndexAtResponse, error)
+	IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error)
+	YieldDistributions(ctx context.Context, in *QueryYieldDistributions, opts ...grpc.CallOption) (*QueryYieldDistributionsResponse, error)
+	CurrentIndex(ctx context.Context, in *QueryCurrentIndex, opts ...grpc.CallOption) (*QueryCurrentIndexResponse, error)
+	YieldRate(ctx context.Context, in *QueryYieldRate, opts ...grpc.CallOption) (*QueryYieldRateResponse, error)
 }
 
 type queryClient struct {
@@ -45,6 +95,26 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, Query_Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauses(ctx context.Context, in *QueryPauses, opts ...grpc.CallOption) (*QueryPausesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPausesResponse)
+	err := c.cc.Invoke(ctx, Query_Pauses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryStatsResponse)
@@ -95,15 +165,270 @@ func (c *queryClient) RetryAmount(ctx context.Context, in *QueryRetryAmount, opt
 	return out, nil
 }
 
+func (c *queryClient) YieldClaimRecipient(ctx context.Context, in *QueryYieldClaimRecipient, opts ...grpc.CallOption) (*QueryYieldClaimRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryYieldClaimRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_YieldClaimRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldSplit(ctx context.Context, in *QueryYieldSplit, opts ...grpc.CallOption) (*QueryYieldSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryYieldSplitResponse)
+	err := c.cc.Invoke(ctx, Query_YieldSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoClaim(ctx context.Context, in *QueryAutoClaim, opts ...grpc.CallOption) (*QueryAutoClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoClaimResponse)
+	err := c.cc.Invoke(ctx, Query_AutoClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NonEarningAccounts(ctx context.Context, in *QueryNonEarningAccounts, opts ...grpc.CallOption) (*QueryNonEarningAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNonEarningAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_NonEarningAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccounts, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, Query_Roles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRoles(ctx context.Context, in *QueryAccountRoles, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountRolesResponse)
+	err := c.cc.Invoke(ctx, Query_AccountRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockDelay(ctx context.Context, in *QueryTimelockDelay, opts ...grpc.CallOption) (*QueryTimelockDelayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTimelockDelayResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockDelay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedMessages(ctx context.Context, in *QueryTimelockedMessages, opts ...grpc.CallOption) (*QueryTimelockedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTimelockedMessagesResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexCircuitBreaker(ctx context.Context, in *QueryIndexCircuitBreaker, opts ...grpc.CallOption) (*QueryIndexCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIndexCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_IndexCircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexStatus(ctx context.Context, in *QueryIndexStatus, opts ...grpc.CallOption) (*QueryIndexStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIndexStatusResponse)
+	err := c.cc.Invoke(ctx, Query_IndexStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Haircut(ctx context.Context, in *QueryHaircut, opts ...grpc.CallOption) (*QueryHaircutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHaircutResponse)
+	err := c.cc.Invoke(ctx, Query_Haircut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveBalance(ctx context.Context, in *QueryEffectiveBalance, opts ...grpc.CallOption) (*QueryEffectiveBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEffectiveBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_EffectiveBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Treasury(ctx context.Context, in *QueryTreasury, opts ...grpc.CallOption) (*QueryTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTreasuryResponse)
+	err := c.cc.Invoke(ctx, Query_Treasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimTip(ctx context.Context, in *QueryClaimTip, opts ...grpc.CallOption) (*QueryClaimTipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryClaimTipResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimTip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Fee(ctx context.Context, in *QueryFee, opts ...grpc.CallOption) (*QueryFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeResponse)
+	err := c.cc.Invoke(ctx, Query_Fee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariants, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldLiability(ctx context.Context, in *QueryYieldLiability, opts ...grpc.CallOption) (*QueryYieldLiabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryYieldLiabilityResponse)
+	err := c.cc.Invoke(ctx, Query_YieldLiability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexAt(ctx context.Context, in *QueryIndexAt, opts ...grpc.CallOption) (*QueryIndexAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIndexAtResponse)
+	err := c.cc.Invoke(ctx, Query_IndexAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIndexHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_IndexHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldDistributions(ctx context.Context, in *QueryYieldDistributions, opts ...grpc.CallOption) (*QueryYieldDistributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryYieldDistributionsResponse)
+	err := c.cc.Invoke(ctx, Query_YieldDistributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentIndex(ctx context.Context, in *QueryCurrentIndex, opts ...grpc.CallOption) (*QueryCurrentIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCurrentIndexResponse)
+	err := c.cc.Invoke(ctx, Query_CurrentIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldRate(ctx context.Context, in *QueryYieldRate, opts ...grpc.CallOption) (*QueryYieldRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryYieldRateResponse)
+	err := c.cc.Invoke(ctx, Query_YieldRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 type QueryServer interface {
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Pauses(context.Context, *QueryPauses) (*QueryPausesResponse, error)
 	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
 	YieldRecipients(context.Context, *QueryYieldRecipients) (*QueryYieldRecipientsResponse, error)
 	YieldRecipient(context.Context, *QueryYieldRecipient) (*QueryYieldRecipientResponse, error)
 	RetryAmounts(context.Context, *QueryRetryAmounts) (*QueryRetryAmountsResponse, error)
 	RetryAmount(context.Context, *QueryRetryAmount) (*QueryRetryAmountResponse, error)
+	YieldClaimRecipient(context.Context, *QueryYieldClaimRecipient) (*QueryYieldClaimRecipientResponse, error)
+	YieldSplit(context.Context, *QueryYieldSplit) (*QueryYieldSplitResponse, error)
+	AutoClaim(context.Context, *QueryAutoClaim) (*QueryAutoClaimResponse, error)
+	NonEarningAccounts(context.Context, *QueryNonEarningAccounts) (*QueryNonEarningAccountsResponse, error)
+	FrozenAccounts(context.Context, *QueryFrozenAccounts) (*QueryFrozenAccountsResponse, error)
+	Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error)
+	AccountRoles(context.Context, *QueryAccountRoles) (*QueryAccountRolesResponse, error)
+	TimelockDelay(context.Context, *QueryTimelockDelay) (*QueryTimelockDelayResponse, error)
+	TimelockedMessages(context.Context, *QueryTimelockedMessages) (*QueryTimelockedMessagesResponse, error)
+	IndexCircuitBreaker(context.Context, *QueryIndexCircuitBreaker) (*QueryIndexCircuitBreakerResponse, error)
+	IndexStatus(context.Context, *QueryIndexStatus) (*QueryIndexStatusResponse, error)
+	Haircut(context.Context, *QueryHaircut) (*QueryHaircutResponse, error)
+	EffectiveBalance(context.Context, *QueryEffectiveBalance) (*QueryEffectiveBalanceResponse, error)
+	Treasury(context.Context, *QueryTreasury) (*QueryTreasuryResponse, error)
+	ClaimTip(context.Context, *QueryClaimTip) (*QueryClaimTipResponse, error)
+	Fee(context.Context, *QueryFee) (*QueryFeeResponse, error)
+	Invariants(context.Context, *QueryInvariants) (*QueryInvariantsResponse, error)
+	YieldLiability(context.Context, *QueryYieldLiability) (*QueryYieldLiabilityResponse, error)
+	IndexAt(context.Context, *QueryIndexAt) (*QueryIndexAtResponse, error)
+	IndexHistory(context.Context, *QueryIndexHistory) (*QueryIndexHistoryResponse, error)
+	YieldDistributions(context.Context, *QueryYieldDistributions) (*QueryYieldDistributionsResponse, error)
+	CurrentIndex(context.Context, *QueryCurrentIndex) (*QueryCurrentIndexResponse, error)
+	YieldRate(context.Context, *QueryYieldRate) (*QueryYieldRateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +439,12 @@ type QueryServer interface {
 // pointer dereference when methods are called.
 type UnimplementedQueryServer struct{}
 
+func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (UnimplementedQueryServer) Pauses(context.Context, *QueryPauses) (*QueryPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauses not implemented")
+}
 func (UnimplementedQueryServer) Stats(context.Context, *QueryStats) (*QueryStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
 }
@@ -129,6 +460,75 @@ func (UnimplementedQueryServer) RetryAmounts(context.Context, *QueryRetryAmounts
 func (UnimplementedQueryServer) RetryAmount(context.Context, *QueryRetryAmount) (*QueryRetryAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryAmount not implemented")
 }
+func (UnimplementedQueryServer) YieldClaimRecipient(context.Context, *QueryYieldClaimRecipient) (*QueryYieldClaimRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldClaimRecipient not implemented")
+}
+func (UnimplementedQueryServer) YieldSplit(context.Context, *QueryYieldSplit) (*QueryYieldSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldSplit not implemented")
+}
+func (UnimplementedQueryServer) AutoClaim(context.Context, *QueryAutoClaim) (*QueryAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoClaim not implemented")
+}
+func (UnimplementedQueryServer) NonEarningAccounts(context.Context, *QueryNonEarningAccounts) (*QueryNonEarningAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NonEarningAccounts not implemented")
+}
+func (UnimplementedQueryServer) FrozenAccounts(context.Context, *QueryFrozenAccounts) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (UnimplementedQueryServer) Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (UnimplementedQueryServer) AccountRoles(context.Context, *QueryAccountRoles) (*QueryAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRoles not implemented")
+}
+func (UnimplementedQueryServer) TimelockDelay(context.Context, *QueryTimelockDelay) (*QueryTimelockDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockDelay not implemented")
+}
+func (UnimplementedQueryServer) TimelockedMessages(context.Context, *QueryTimelockedMessages) (*QueryTimelockedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedMessages not implemented")
+}
+func (UnimplementedQueryServer) IndexCircuitBreaker(context.Context, *QueryIndexCircuitBreaker) (*QueryIndexCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexCircuitBreaker not implemented")
+}
+func (UnimplementedQueryServer) IndexStatus(context.Context, *QueryIndexStatus) (*QueryIndexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexStatus not implemented")
+}
+func (UnimplementedQueryServer) Haircut(context.Context, *QueryHaircut) (*QueryHaircutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Haircut not implemented")
+}
+func (UnimplementedQueryServer) EffectiveBalance(context.Context, *QueryEffectiveBalance) (*QueryEffectiveBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveBalance not implemented")
+}
+func (UnimplementedQueryServer) Treasury(context.Context, *QueryTreasury) (*QueryTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Treasury not implemented")
+}
+func (UnimplementedQueryServer) ClaimTip(context.Context, *QueryClaimTip) (*QueryClaimTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTip not implemented")
+}
+func (UnimplementedQueryServer) Fee(context.Context, *QueryFee) (*QueryFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fee not implemented")
+}
+func (UnimplementedQueryServer) Invariants(context.Context, *QueryInvariants) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (UnimplementedQueryServer) YieldLiability(context.Context, *QueryYieldLiability) (*QueryYieldLiabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldLiability not implemented")
+}
+func (UnimplementedQueryServer) IndexAt(context.Context, *QueryIndexAt) (*QueryIndexAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexAt not implemented")
+}
+func (UnimplementedQueryServer) IndexHistory(context.Context, *QueryIndexHistory) (*QueryIndexHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexHistory not implemented")
+}
+func (UnimplementedQueryServer) YieldDistributions(context.Context, *QueryYieldDistributions) (*QueryYieldDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldDistributions not implemented")
+}
+func (UnimplementedQueryServer) CurrentIndex(context.Context, *QueryCurrentIndex) (*QueryCurrentIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentIndex not implemented")
+}
+func (UnimplementedQueryServer) YieldRate(context.Context, *QueryYieldRate) (*QueryYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldRate not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -150,6 +550,42 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Pauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauses(ctx, req.(*QueryPauses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStats)
 	if err := dec(in); err != nil {
@@ -240,32 +676,546 @@ func _Query_RetryAmount_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.dollar.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Stats",
-			Handler:    _Query_Stats_Handler,
-		},
-		{
-			MethodName: "YieldRecipients",
-			Handler:    _Query_YieldRecipients_Handler,
-		},
-		{
-			MethodName: "YieldRecipient",
-			Handler:    _Query_YieldRecipient_Handler,
-		},
-		{
-			MethodName: "RetryAmounts",
-			Handler:    _Query_RetryAmounts_Handler,
-		},
-		{
-			MethodName: "RetryAmount",
-			Handler:    _Query_RetryAmount_Handler,
+func _Query_YieldClaimRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldClaimRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldClaimRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldClaimRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldClaimRecipient(ctx, req.(*QueryYieldClaimRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldSplit(ctx, req.(*QueryYieldSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoClaim(ctx, req.(*QueryAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NonEarningAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNonEarningAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NonEarningAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NonEarningAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NonEarningAccounts(ctx, req.(*QueryNonEarningAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Roles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRoles(ctx, req.(*QueryAccountRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockDelay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockDelay(ctx, req.(*QueryTimelockDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedMessages(ctx, req.(*QueryTimelockedMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IndexCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexCircuitBreaker(ctx, req.(*QueryIndexCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IndexStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexStatus(ctx, req.(*QueryIndexStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Haircut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaircut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Haircut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Haircut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Haircut(ctx, req.(*QueryHaircut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EffectiveBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveBalance(ctx, req.(*QueryEffectiveBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Treasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Treasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Treasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Treasury(ctx, req.(*QueryTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimTip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimTip(ctx, req.(*QueryClaimTip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Fee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Fee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Fee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Fee(ctx, req.(*QueryFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldLiability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldLiability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldLiability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldLiability(ctx, req.(*QueryYieldLiability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IndexAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexAt(ctx, req.(*QueryIndexAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IndexHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexHistory(ctx, req.(*QueryIndexHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldDistributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldDistributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldDistributions(ctx, req.(*QueryYieldDistributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CurrentIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentIndex(ctx, req.(*QueryCurrentIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_YieldRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldRate(ctx, req.(*QueryYieldRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.dollar.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Pauses",
+			Handler:    _Query_Pauses_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "YieldRecipients",
+			Handler:    _Query_YieldRecipients_Handler,
+		},
+		{
+			MethodName: "YieldRecipient",
+			Handler:    _Query_YieldRecipient_Handler,
+		},
+		{
+			MethodName: "RetryAmounts",
+			Handler:    _Query_RetryAmounts_Handler,
+		},
+		{
+			MethodName: "RetryAmount",
+			Handler:    _Query_RetryAmount_Handler,
+		},
+		{
+			MethodName: "YieldClaimRecipient",
+			Handler:    _Query_YieldClaimRecipient_Handler,
+		},
+		{
+			MethodName: "YieldSplit",
+			Handler:    _Query_YieldSplit_Handler,
+		},
+		{
+			MethodName: "AutoClaim",
+			Handler:    _Query_AutoClaim_Handler,
+		},
+		{
+			MethodName: "NonEarningAccounts",
+			Handler:    _Query_NonEarningAccounts_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "AccountRoles",
+			Handler:    _Query_AccountRoles_Handler,
+		},
+		{
+			MethodName: "TimelockDelay",
+			Handler:    _Query_TimelockDelay_Handler,
+		},
+		{
+			MethodName: "TimelockedMessages",
+			Handler:    _Query_TimelockedMessages_Handler,
+		},
+		{
+			MethodName: "IndexCircuitBreaker",
+			Handler:    _Query_IndexCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "IndexStatus",
+			Handler:    _Query_IndexStatus_Handler,
+		},
+		{
+			MethodName: "Haircut",
+			Handler:    _Query_Haircut_Handler,
+		},
+		{
+			MethodName: "EffectiveBalance",
+			Handler:    _Query_EffectiveBalance_Handler,
+		},
+		{
+			MethodName: "Treasury",
+			Handler:    _Query_Treasury_Handler,
+		},
+		{
+			MethodName: "ClaimTip",
+			Handler:    _Query_ClaimTip_Handler,
+		},
+		{
+			MethodName: "Fee",
+			Handler:    _Query_Fee_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "YieldLiability",
+			Handler:    _Query_YieldLiability_Handler,
+		},
+		{
+			MethodName: "IndexAt",
+			Handler:    _Query_IndexAt_Handler,
+		},
+		{
+			MethodName: "IndexHistory",
+			Handler:    _Query_IndexHistory_Handler,
+		},
+		{
+			MethodName: "YieldDistributions",
+			Handler:    _Query_YieldDistributions_Handler,
+		},
+		{
+			MethodName: "CurrentIndex",
+			Handler:    _Query_CurrentIndex_Handler,
+		},
+		{
+			MethodName: "YieldRate",
+			Handler:    _Query_YieldRate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
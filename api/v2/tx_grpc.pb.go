@@ -19,7 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_SetYieldRecipient_FullMethodName = "/noble.dollar.v2.Msg/SetYieldRecipient"
+	Msg_SetYieldRecipient_FullMethodName       = "/noble.dollar.v2.Msg/SetYieldRecipient"
+	Msg_SetYieldClaimRecipient_FullMethodName  = "/noble.dollar.v2.Msg/SetYieldClaimRecipient"
+	Msg_SetYieldSplit_FullMethodName           = "/noble.dollar.v2.Msg/SetYieldSplit"
+	Msg_SetAutoClaim_FullMethodName            = "/noble.dollar.v2.Msg/SetAutoClaim"
+	Msg_ClaimYieldFor_FullMethodName           = "/noble.dollar.v2.Msg/ClaimYieldFor"
+	Msg_SetNonEarningAccount_FullMethodName    = "/noble.dollar.v2.Msg/SetNonEarningAccount"
+	Msg_SetTreasury_FullMethodName             = "/noble.dollar.v2.Msg/SetTreasury"
+	Msg_ReconcileStats_FullMethodName          = "/noble.dollar.v2.Msg/ReconcileStats"
+	Msg_SetClaimTip_FullMethodName             = "/noble.dollar.v2.Msg/SetClaimTip"
+	Msg_SetPausedType_FullMethodName           = "/noble.dollar.v2.Msg/SetPausedType"
+	Msg_SetFee_FullMethodName                  = "/noble.dollar.v2.Msg/SetFee"
+	Msg_SetFrozenAccount_FullMethodName        = "/noble.dollar.v2.Msg/SetFrozenAccount"
+	Msg_ClaimFrozenYield_FullMethodName        = "/noble.dollar.v2.Msg/ClaimFrozenYield"
+	Msg_GrantRole_FullMethodName               = "/noble.dollar.v2.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName              = "/noble.dollar.v2.Msg/RevokeRole"
+	Msg_SetTimelockDelay_FullMethodName        = "/noble.dollar.v2.Msg/SetTimelockDelay"
+	Msg_CancelTimelockedMessage_FullMethodName = "/noble.dollar.v2.Msg/CancelTimelockedMessage"
+	Msg_SetIndex_FullMethodName                = "/noble.dollar.v2.Msg/SetIndex"
+	Msg_SetMaxYieldRate_FullMethodName         = "/noble.dollar.v2.Msg/SetMaxYieldRate"
+	Msg_ApprovePendingIndex_FullMethodName     = "/noble.dollar.v2.Msg/ApprovePendingIndex"
+	Msg_RejectPendingIndex_FullMethodName      = "/noble.dollar.v2.Msg/RejectPendingIndex"
+	Msg_SetIndexStaleness_FullMethodName       = "/noble.dollar.v2.Msg/SetIndexStaleness"
+	Msg_SetHaircutMode_FullMethodName          = "/noble.dollar.v2.Msg/SetHaircutMode"
 )
 
 // MsgClient is the client API for Msg service.
@@ -27,6 +49,28 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
 	SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipient, opts ...grpc.CallOption) (*MsgSetYieldRecipientResponse, error)
+	SetYieldClaimRecipient(ctx context.Context, in *MsgSetYieldClaimRecipient, opts ...grpc.CallOption) (*MsgSetYieldClaimRecipientResponse, error)
+	SetYieldSplit(ctx context.Context, in *MsgSetYieldSplit, opts ...grpc.CallOption) (*MsgSetYieldSplitResponse, error)
+	SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error)
+	ClaimYieldFor(ctx context.Context, in *MsgClaimYieldFor, opts ...grpc.CallOption) (*MsgClaimYieldForResponse, error)
+	SetNonEarningAccount(ctx context.Context, in *MsgSetNonEarningAccount, opts ...grpc.CallOption) (*MsgSetNonEarningAccountResponse, error)
+	SetTreasury(ctx context.Context, in *MsgSetTreasury, opts ...grpc.CallOption) (*MsgSetTreasuryResponse, error)
+	ReconcileStats(ctx context.Context, in *MsgReconcileStats, opts ...grpc.CallOption) (*MsgReconcileStatsResponse, error)
+	SetClaimTip(ctx context.Context, in *MsgSetClaimTip, opts ...grpc.CallOption) (*MsgSetClaimTipResponse, error)
+	SetPausedType(ctx context.Context, in *MsgSetPausedType, opts ...grpc.CallOption) (*MsgSetPausedTypeResponse, error)
+	SetFee(ctx context.Context, in *MsgSetFee, opts ...grpc.CallOption) (*MsgSetFeeResponse, error)
+	SetFrozenAccount(ctx context.Context, in *MsgSetFrozenAccount, opts ...grpc.CallOption) (*MsgSetFrozenAccountResponse, error)
+	ClaimFrozenYield(ctx context.Context, in *MsgClaimFrozenYield, opts ...grpc.CallOption) (*MsgClaimFrozenYieldResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	SetTimelockDelay(ctx context.Context, in *MsgSetTimelockDelay, opts ...grpc.CallOption) (*MsgSetTimelockDelayResponse, error)
+	CancelTimelockedMessage(ctx context.Context, in *MsgCancelTimelockedMessage, opts ...grpc.CallOption) (*MsgCancelTimelockedMessageResponse, error)
+	SetIndex(ctx context.Context, in *MsgSetIndex, opts ...grpc.CallOption) (*MsgSetIndexResponse, error)
+	SetMaxYieldRate(ctx context.Context, in *MsgSetMaxYieldRate, opts ...grpc.CallOption) (*MsgSetMaxYieldRateResponse, error)
+	ApprovePendingIndex(ctx context.Context, in *MsgApprovePendingIndex, opts ...grpc.CallOption) (*MsgApprovePendingIndexResponse, error)
+	RejectPendingIndex(ctx context.Context, in *MsgRejectPendingIndex, opts ...grpc.CallOption) (*MsgRejectPendingIndexResponse, error)
+	SetIndexStaleness(ctx context.Context, in *MsgSetIndexStaleness, opts ...grpc.CallOption) (*MsgSetIndexStalenessResponse, error)
+	SetHaircutMode(ctx context.Context, in *MsgSetHaircutMode, opts ...grpc.CallOption) (*MsgSetHaircutModeResponse, error)
 }
 
 type msgClient struct {
@@ -47,11 +91,253 @@ func (c *msgClient) SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipi
 	return out, nil
 }
 
+func (c *msgClient) SetYieldClaimRecipient(ctx context.Context, in *MsgSetYieldClaimRecipient, opts ...grpc.CallOption) (*MsgSetYieldClaimRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetYieldClaimRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_SetYieldClaimRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetYieldSplit(ctx context.Context, in *MsgSetYieldSplit, opts ...grpc.CallOption) (*MsgSetYieldSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetYieldSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetYieldSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAutoClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimYieldFor(ctx context.Context, in *MsgClaimYieldFor, opts ...grpc.CallOption) (*MsgClaimYieldForResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimYieldForResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimYieldFor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNonEarningAccount(ctx context.Context, in *MsgSetNonEarningAccount, opts ...grpc.CallOption) (*MsgSetNonEarningAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetNonEarningAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_SetNonEarningAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTreasury(ctx context.Context, in *MsgSetTreasury, opts ...grpc.CallOption) (*MsgSetTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTreasuryResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReconcileStats(ctx context.Context, in *MsgReconcileStats, opts ...grpc.CallOption) (*MsgReconcileStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReconcileStatsResponse)
+	err := c.cc.Invoke(ctx, Msg_ReconcileStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClaimTip(ctx context.Context, in *MsgSetClaimTip, opts ...grpc.CallOption) (*MsgSetClaimTipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetClaimTipResponse)
+	err := c.cc.Invoke(ctx, Msg_SetClaimTip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPausedType(ctx context.Context, in *MsgSetPausedType, opts ...grpc.CallOption) (*MsgSetPausedTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPausedTypeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPausedType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFee(ctx context.Context, in *MsgSetFee, opts ...grpc.CallOption) (*MsgSetFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFrozenAccount(ctx context.Context, in *MsgSetFrozenAccount, opts ...grpc.CallOption) (*MsgSetFrozenAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFrozenAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFrozenAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimFrozenYield(ctx context.Context, in *MsgClaimFrozenYield, opts ...grpc.CallOption) (*MsgClaimFrozenYieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimFrozenYieldResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimFrozenYield_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTimelockDelay(ctx context.Context, in *MsgSetTimelockDelay, opts ...grpc.CallOption) (*MsgSetTimelockDelayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTimelockDelayResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTimelockDelay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTimelockedMessage(ctx context.Context, in *MsgCancelTimelockedMessage, opts ...grpc.CallOption) (*MsgCancelTimelockedMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelTimelockedMessageResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTimelockedMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIndex(ctx context.Context, in *MsgSetIndex, opts ...grpc.CallOption) (*MsgSetIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetIndexResponse)
+	err := c.cc.Invoke(ctx, Msg_SetIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxYieldRate(ctx context.Context, in *MsgSetMaxYieldRate, opts ...grpc.CallOption) (*MsgSetMaxYieldRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMaxYieldRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaxYieldRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApprovePendingIndex(ctx context.Context, in *MsgApprovePendingIndex, opts ...grpc.CallOption) (*MsgApprovePendingIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApprovePendingIndexResponse)
+	err := c.cc.Invoke(ctx, Msg_ApprovePendingIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectPendingIndex(ctx context.Context, in *MsgRejectPendingIndex, opts ...grpc.CallOption) (*MsgRejectPendingIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRejectPendingIndexResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectPendingIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIndexStaleness(ctx context.Context, in *MsgSetIndexStaleness, opts ...grpc.CallOption) (*MsgSetIndexStalenessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetIndexStalenessResponse)
+	err := c.cc.Invoke(ctx, Msg_SetIndexStaleness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHaircutMode(ctx context.Context, in *MsgSetHaircutMode, opts ...grpc.CallOption) (*MsgSetHaircutModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetHaircutModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHaircutMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
 type MsgServer interface {
 	SetYieldRecipient(context.Context, *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error)
+	SetYieldClaimRecipient(context.Context, *MsgSetYieldClaimRecipient) (*MsgSetYieldClaimRecipientResponse, error)
+	SetYieldSplit(context.Context, *MsgSetYieldSplit) (*MsgSetYieldSplitResponse, error)
+	SetAutoClaim(context.Context, *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error)
+	ClaimYieldFor(context.Context, *MsgClaimYieldFor) (*MsgClaimYieldForResponse, error)
+	SetNonEarningAccount(context.Context, *MsgSetNonEarningAccount) (*MsgSetNonEarningAccountResponse, error)
+	SetTreasury(context.Context, *MsgSetTreasury) (*MsgSetTreasuryResponse, error)
+	ReconcileStats(context.Context, *MsgReconcileStats) (*MsgReconcileStatsResponse, error)
+	SetClaimTip(context.Context, *MsgSetClaimTip) (*MsgSetClaimTipResponse, error)
+	SetPausedType(context.Context, *MsgSetPausedType) (*MsgSetPausedTypeResponse, error)
+	SetFee(context.Context, *MsgSetFee) (*MsgSetFeeResponse, error)
+	SetFrozenAccount(context.Context, *MsgSetFrozenAccount) (*MsgSetFrozenAccountResponse, error)
+	ClaimFrozenYield(context.Context, *MsgClaimFrozenYield) (*MsgClaimFrozenYieldResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	SetTimelockDelay(context.Context, *MsgSetTimelockDelay) (*MsgSetTimelockDelayResponse, error)
+	CancelTimelockedMessage(context.Context, *MsgCancelTimelockedMessage) (*MsgCancelTimelockedMessageResponse, error)
+	SetIndex(context.Context, *MsgSetIndex) (*MsgSetIndexResponse, error)
+	SetMaxYieldRate(context.Context, *MsgSetMaxYieldRate) (*MsgSetMaxYieldRateResponse, error)
+	ApprovePendingIndex(context.Context, *MsgApprovePendingIndex) (*MsgApprovePendingIndexResponse, error)
+	RejectPendingIndex(context.Context, *MsgRejectPendingIndex) (*MsgRejectPendingIndexResponse, error)
+	SetIndexStaleness(context.Context, *MsgSetIndexStaleness) (*MsgSetIndexStalenessResponse, error)
+	SetHaircutMode(context.Context, *MsgSetHaircutMode) (*MsgSetHaircutModeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +351,72 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) SetYieldRecipient(context.Context, *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetYieldRecipient not implemented")
 }
+func (UnimplementedMsgServer) SetYieldClaimRecipient(context.Context, *MsgSetYieldClaimRecipient) (*MsgSetYieldClaimRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldClaimRecipient not implemented")
+}
+func (UnimplementedMsgServer) SetYieldSplit(context.Context, *MsgSetYieldSplit) (*MsgSetYieldSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldSplit not implemented")
+}
+func (UnimplementedMsgServer) SetAutoClaim(context.Context, *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoClaim not implemented")
+}
+func (UnimplementedMsgServer) ClaimYieldFor(context.Context, *MsgClaimYieldFor) (*MsgClaimYieldForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimYieldFor not implemented")
+}
+func (UnimplementedMsgServer) SetNonEarningAccount(context.Context, *MsgSetNonEarningAccount) (*MsgSetNonEarningAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNonEarningAccount not implemented")
+}
+func (UnimplementedMsgServer) SetTreasury(context.Context, *MsgSetTreasury) (*MsgSetTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTreasury not implemented")
+}
+func (UnimplementedMsgServer) ReconcileStats(context.Context, *MsgReconcileStats) (*MsgReconcileStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStats not implemented")
+}
+func (UnimplementedMsgServer) SetClaimTip(context.Context, *MsgSetClaimTip) (*MsgSetClaimTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimTip not implemented")
+}
+func (UnimplementedMsgServer) SetPausedType(context.Context, *MsgSetPausedType) (*MsgSetPausedTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPausedType not implemented")
+}
+func (UnimplementedMsgServer) SetFee(context.Context, *MsgSetFee) (*MsgSetFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFee not implemented")
+}
+func (UnimplementedMsgServer) SetFrozenAccount(context.Context, *MsgSetFrozenAccount) (*MsgSetFrozenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrozenAccount not implemented")
+}
+func (UnimplementedMsgServer) ClaimFrozenYield(context.Context, *MsgClaimFrozenYield) (*MsgClaimFrozenYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFrozenYield not implemented")
+}
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) SetTimelockDelay(context.Context, *MsgSetTimelockDelay) (*MsgSetTimelockDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimelockDelay not implemented")
+}
+func (UnimplementedMsgServer) CancelTimelockedMessage(context.Context, *MsgCancelTimelockedMessage) (*MsgCancelTimelockedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimelockedMessage not implemented")
+}
+func (UnimplementedMsgServer) SetIndex(context.Context, *MsgSetIndex) (*MsgSetIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndex not implemented")
+}
+func (UnimplementedMsgServer) SetMaxYieldRate(context.Context, *MsgSetMaxYieldRate) (*MsgSetMaxYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxYieldRate not implemented")
+}
+func (UnimplementedMsgServer) ApprovePendingIndex(context.Context, *MsgApprovePendingIndex) (*MsgApprovePendingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePendingIndex not implemented")
+}
+func (UnimplementedMsgServer) RejectPendingIndex(context.Context, *MsgRejectPendingIndex) (*MsgRejectPendingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPendingIndex not implemented")
+}
+func (UnimplementedMsgServer) SetIndexStaleness(context.Context, *MsgSetIndexStaleness) (*MsgSetIndexStalenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexStaleness not implemented")
+}
+func (UnimplementedMsgServer) SetHaircutMode(context.Context, *MsgSetHaircutMode) (*MsgSetHaircutModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHaircutMode not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -104,6 +456,402 @@ func _Msg_SetYieldRecipient_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetYieldClaimRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldClaimRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldClaimRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetYieldClaimRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldClaimRecipient(ctx, req.(*MsgSetYieldClaimRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetYieldSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetYieldSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldSplit(ctx, req.(*MsgSetYieldSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoClaim(ctx, req.(*MsgSetAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimYieldFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimYieldFor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimYieldFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimYieldFor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimYieldFor(ctx, req.(*MsgClaimYieldFor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNonEarningAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNonEarningAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNonEarningAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetNonEarningAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNonEarningAccount(ctx, req.(*MsgSetNonEarningAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTreasury(ctx, req.(*MsgSetTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReconcileStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReconcileStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileStats(ctx, req.(*MsgReconcileStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimTip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetClaimTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimTip(ctx, req.(*MsgSetClaimTip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPausedType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPausedType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPausedType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPausedType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPausedType(ctx, req.(*MsgSetPausedType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFee(ctx, req.(*MsgSetFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFrozenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFrozenAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFrozenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFrozenAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFrozenAccount(ctx, req.(*MsgSetFrozenAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFrozenYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFrozenYield)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFrozenYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimFrozenYield_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFrozenYield(ctx, req.(*MsgClaimFrozenYield))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTimelockDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTimelockDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTimelockDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTimelockDelay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTimelockDelay(ctx, req.(*MsgSetTimelockDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTimelockedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTimelockedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTimelockedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelTimelockedMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTimelockedMessage(ctx, req.(*MsgCancelTimelockedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIndex(ctx, req.(*MsgSetIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxYieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxYieldRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxYieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaxYieldRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxYieldRate(ctx, req.(*MsgSetMaxYieldRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApprovePendingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprovePendingIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApprovePendingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApprovePendingIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApprovePendingIndex(ctx, req.(*MsgApprovePendingIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectPendingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectPendingIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectPendingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectPendingIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectPendingIndex(ctx, req.(*MsgRejectPendingIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIndexStaleness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIndexStaleness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIndexStaleness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetIndexStaleness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIndexStaleness(ctx, req.(*MsgSetIndexStaleness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHaircutMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHaircutMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHaircutMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHaircutMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHaircutMode(ctx, req.(*MsgSetHaircutMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +863,94 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetYieldRecipient",
 			Handler:    _Msg_SetYieldRecipient_Handler,
 		},
+		{
+			MethodName: "SetYieldClaimRecipient",
+			Handler:    _Msg_SetYieldClaimRecipient_Handler,
+		},
+		{
+			MethodName: "SetYieldSplit",
+			Handler:    _Msg_SetYieldSplit_Handler,
+		},
+		{
+			MethodName: "SetAutoClaim",
+			Handler:    _Msg_SetAutoClaim_Handler,
+		},
+		{
+			MethodName: "ClaimYieldFor",
+			Handler:    _Msg_ClaimYieldFor_Handler,
+		},
+		{
+			MethodName: "SetNonEarningAccount",
+			Handler:    _Msg_SetNonEarningAccount_Handler,
+		},
+		{
+			MethodName: "SetTreasury",
+			Handler:    _Msg_SetTreasury_Handler,
+		},
+		{
+			MethodName: "ReconcileStats",
+			Handler:    _Msg_ReconcileStats_Handler,
+		},
+		{
+			MethodName: "SetClaimTip",
+			Handler:    _Msg_SetClaimTip_Handler,
+		},
+		{
+			MethodName: "SetPausedType",
+			Handler:    _Msg_SetPausedType_Handler,
+		},
+		{
+			MethodName: "SetFee",
+			Handler:    _Msg_SetFee_Handler,
+		},
+		{
+			MethodName: "SetFrozenAccount",
+			Handler:    _Msg_SetFrozenAccount_Handler,
+		},
+		{
+			MethodName: "ClaimFrozenYield",
+			Handler:    _Msg_ClaimFrozenYield_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "SetTimelockDelay",
+			Handler:    _Msg_SetTimelockDelay_Handler,
+		},
+		{
+			MethodName: "CancelTimelockedMessage",
+			Handler:    _Msg_CancelTimelockedMessage_Handler,
+		},
+		{
+			MethodName: "SetIndex",
+			Handler:    _Msg_SetIndex_Handler,
+		},
+		{
+			MethodName: "SetMaxYieldRate",
+			Handler:    _Msg_SetMaxYieldRate_Handler,
+		},
+		{
+			MethodName: "ApprovePendingIndex",
+			Handler:    _Msg_ApprovePendingIndex_Handler,
+		},
+		{
+			MethodName: "RejectPendingIndex",
+			Handler:    _Msg_RejectPendingIndex_Handler,
+		},
+		{
+			MethodName: "SetIndexStaleness",
+			Handler:    _Msg_SetIndexStaleness_Handler,
+		},
+		{
+			MethodName: "SetHaircutMode",
+			Handler:    _Msg_SetHaircutMode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/dollar/v2/tx.proto",
@@ -41,11 +41,36 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(QueryPaused())
+	cmd.AddCommand(QueryPauses())
 	cmd.AddCommand(QueryStats())
 	cmd.AddCommand(QueryYieldRecipients())
 	cmd.AddCommand(QueryYieldRecipient())
 	cmd.AddCommand(QueryRetryAmounts())
 	cmd.AddCommand(QueryRetryAmount())
+	cmd.AddCommand(QueryYieldClaimRecipient())
+	cmd.AddCommand(QueryYieldSplit())
+	cmd.AddCommand(QueryAutoClaim())
+	cmd.AddCommand(QueryNonEarningAccounts())
+	cmd.AddCommand(QueryFrozenAccounts())
+	cmd.AddCommand(QueryRoles())
+	cmd.AddCommand(QueryAccountRoles())
+	cmd.AddCommand(QueryTimelockDelay())
+	cmd.AddCommand(QueryTimelockedMessages())
+	cmd.AddCommand(QueryIndexCircuitBreaker())
+	cmd.AddCommand(QueryIndexStatus())
+	cmd.AddCommand(QueryHaircut())
+	cmd.AddCommand(QueryEffectiveBalance())
+	cmd.AddCommand(QueryTreasury())
+	cmd.AddCommand(QueryClaimTip())
+	cmd.AddCommand(QueryFee())
+	cmd.AddCommand(QueryIndexAt())
+	cmd.AddCommand(QueryIndexHistory())
+	cmd.AddCommand(QueryYieldDistributions())
+	cmd.AddCommand(QueryCurrentIndex())
+	cmd.AddCommand(QueryInvariants())
+	cmd.AddCommand(QueryYieldLiability())
+	cmd.AddCommand(QueryYieldRate())
 
 	return cmd
 }
@@ -180,3 +205,647 @@ func QueryRetryAmount() *cobra.Command {
 
 	return cmd
 }
+
+func QueryYieldSplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "yield-split [account]",
+		Short: "Query how an account's claimed yield is split",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.YieldSplit(context.Background(), &v2.QueryYieldSplit{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAutoClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-claim [account]",
+		Short: "Query whether an account's yield is claimed automatically",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AutoClaim(context.Background(), &v2.QueryAutoClaim{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryNonEarningAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "non-earning-accounts",
+		Short: "Query the accounts whose yield is swept to the treasury",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NonEarningAccounts(context.Background(), &v2.QueryNonEarningAccounts{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts",
+		Short: "Query the accounts that are frozen",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(context.Background(), &v2.QueryFrozenAccounts{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "Query all roles granted to accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), &v2.QueryRoles{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccountRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-roles [account]",
+		Short: "Query the roles granted to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRoles(context.Background(), &v2.QueryAccountRoles{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryTimelockDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock-delay",
+		Short: "Query the delay after which timelocked admin messages are executed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TimelockDelay(context.Background(), &v2.QueryTimelockDelay{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryTimelockedMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelocked-messages",
+		Short: "Query the pending timelocked admin messages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TimelockedMessages(context.Background(), &v2.QueryTimelockedMessages{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryIndexCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-circuit-breaker",
+		Short: "Query the maximum yield rate implied by index updates, and the quarantined index",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IndexCircuitBreaker(context.Background(), &v2.QueryIndexCircuitBreaker{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryIndexStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-status",
+		Short: "Query the time since the last index update, and whether the index is stale",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IndexStatus(context.Background(), &v2.QueryIndexStatus{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryHaircut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "haircut",
+		Short: "Query whether haircut mode is enabled, and the fraction of balances backed by $M",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Haircut(context.Background(), &v2.QueryHaircut{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryEffectiveBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-balance [account]",
+		Short: "Query an account's balance, and the amount of $M backing it after any haircut",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveBalance(context.Background(), &v2.QueryEffectiveBalance{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryTreasury() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Query the treasury that the yield of non-earning accounts is swept to",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Treasury(context.Background(), &v2.QueryTreasury{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryClaimTip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-tip",
+		Short: "Query the tip paid to signers claiming yield on behalf of holders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimTip(context.Background(), &v2.QueryClaimTip{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused",
+		Short: "Query the actions that are paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Paused(context.Background(), &v2.QueryPaused{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pauses",
+		Short: "Query the active pauses, including their expiry and reason",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pauses(context.Background(), &v2.QueryPauses{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee",
+		Short: "Query the fee taken from newly accrued yield, and its collector",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Fee(context.Background(), &v2.QueryFee{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryYieldClaimRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "yield-claim-recipient [account]",
+		Short: "Query the recipient of an account's claimed yield",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.YieldClaimRecipient(context.Background(), &v2.QueryYieldClaimRecipient{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryIndexAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-at [time]",
+		Short: "Query the index that was in effect at a specific time (RFC3339 or unix seconds)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			at, err := parseTime(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IndexAt(context.Background(), &v2.QueryIndexAt{Time: at})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryIndexHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-history",
+		Short: "Query the index updates between two times (RFC3339 or unix seconds)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &v2.QueryIndexHistory{Pagination: pageReq}
+
+			if rawStartTime, _ := cmd.Flags().GetString(FlagStartTime); rawStartTime != "" {
+				req.StartTime, err = parseTime(rawStartTime)
+				if err != nil {
+					return err
+				}
+			}
+			if rawEndTime, _ := cmd.Flags().GetString(FlagEndTime); rawEndTime != "" {
+				req.EndTime, err = parseTime(rawEndTime)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.IndexHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "Inclusive lower bound of the index history")
+	cmd.Flags().String(FlagEndTime, "", "Inclusive upper bound of the index history")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "index-history")
+
+	return cmd
+}
+
+func QueryYieldDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "yield-distributions",
+		Short: "Query the yield distributions of index updates between two times (RFC3339 or unix seconds)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &v2.QueryYieldDistributions{Pagination: pageReq}
+
+			if rawStartTime, _ := cmd.Flags().GetString(FlagStartTime); rawStartTime != "" {
+				req.StartTime, err = parseTime(rawStartTime)
+				if err != nil {
+					return err
+				}
+			}
+			if rawEndTime, _ := cmd.Flags().GetString(FlagEndTime); rawEndTime != "" {
+				req.EndTime, err = parseTime(rawEndTime)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.YieldDistributions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "Inclusive lower bound of the yield distributions")
+	cmd.Flags().String(FlagEndTime, "", "Inclusive upper bound of the yield distributions")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "yield-distributions")
+
+	return cmd
+}
+
+func QueryCurrentIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-index",
+		Short: "Query the current index, extrapolated from the last attested index",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentIndex(context.Background(), &v2.QueryCurrentIndex{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryYieldRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "yield-rate [window]",
+		Short:   "Query the realized annualized yield over a window (e.g. 7d, 30d, 720h), or since genesis if omitted",
+		Example: "yield-rate 7d",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			req := &v2.QueryYieldRate{}
+			if len(args) == 1 {
+				window, err := parseWindow(args[0])
+				if err != nil {
+					return err
+				}
+				req.Window = window
+			}
+
+			res, err := queryClient.YieldRate(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the invariants of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(context.Background(), &v2.QueryInvariants{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryYieldLiability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "yield-liability",
+		Short: "Query the outstanding yield liability against the balance of the yield account",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := v2.NewQueryClient(clientCtx)
+
+			res, err := queryClient.YieldLiability(context.Background(), &v2.QueryYieldLiability{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
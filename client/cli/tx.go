@@ -22,7 +22,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -42,6 +46,28 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(TxSetYieldRecipient())
+	cmd.AddCommand(TxSetYieldClaimRecipient())
+	cmd.AddCommand(TxSetYieldSplit())
+	cmd.AddCommand(TxSetAutoClaim())
+	cmd.AddCommand(TxClaimYieldFor())
+	cmd.AddCommand(TxSetNonEarningAccount())
+	cmd.AddCommand(TxSetTreasury())
+	cmd.AddCommand(TxReconcileStats())
+	cmd.AddCommand(TxSetClaimTip())
+	cmd.AddCommand(TxSetPausedType())
+	cmd.AddCommand(TxSetFee())
+	cmd.AddCommand(TxSetFrozenAccount())
+	cmd.AddCommand(TxClaimFrozenYield())
+	cmd.AddCommand(TxGrantRole())
+	cmd.AddCommand(TxRevokeRole())
+	cmd.AddCommand(TxSetTimelockDelay())
+	cmd.AddCommand(TxCancelTimelockedMessage())
+	cmd.AddCommand(TxSetIndex())
+	cmd.AddCommand(TxSetMaxYieldRate())
+	cmd.AddCommand(TxApprovePendingIndex())
+	cmd.AddCommand(TxRejectPendingIndex())
+	cmd.AddCommand(TxSetIndexStaleness())
+	cmd.AddCommand(TxSetHaircutMode())
 
 	return cmd
 }
@@ -77,3 +103,669 @@ func TxSetYieldRecipient() *cobra.Command {
 
 	return cmd
 }
+
+func TxSetYieldClaimRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-yield-claim-recipient [recipient]",
+		Short: "Set the recipient of your claimed yield, or remove it if omitted",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgSetYieldClaimRecipient{
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+			if len(args) == 1 {
+				msg.Recipient = args[0]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetYieldSplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-yield-split [recipient:share...]",
+		Short:   "Split your claimed yield across recipients, with shares in basis points, or remove it if omitted",
+		Example: "set-yield-split noble1self:8000 noble1savings:1500 noble1charity:500",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares := make([]v2.YieldShare, 0, len(args))
+			for _, arg := range args {
+				recipient, rawShare, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid yield share %s, expected recipient:share", arg)
+				}
+				share, err := strconv.ParseUint(rawShare, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid share: %w", err)
+				}
+
+				shares = append(shares, v2.YieldShare{Recipient: recipient, Share: uint32(share)})
+			}
+
+			msg := &v2.MsgSetYieldSplit{
+				Signer: clientCtx.GetFromAddress().String(),
+				Shares: shares,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetAutoClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-claim [enabled]",
+		Short: "Opt in or out of having your yield claimed automatically after every index update",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid enabled: %w", err)
+			}
+
+			msg := &v2.MsgSetAutoClaim{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Enabled: enabled,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxClaimYieldFor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-yield-for [account] [account...]",
+		Short: "Claim the yield of one or more holders on their behalf",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgClaimYieldFor{
+				Signer:   clientCtx.GetFromAddress().String(),
+				Accounts: args,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetNonEarningAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-non-earning-account [account] [non-earning]",
+		Short: "Add or remove an account from the list of non-earning accounts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonEarning, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid non-earning: %w", err)
+			}
+
+			msg := &v2.MsgSetNonEarningAccount{
+				Signer:     clientCtx.GetFromAddress().String(),
+				Account:    args[0],
+				NonEarning: nonEarning,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetTreasury() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-treasury [treasury]",
+		Short: "Set the treasury that the yield of non-earning accounts is swept to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgSetTreasury{
+				Signer:   clientCtx.GetFromAddress().String(),
+				Treasury: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxReconcileStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-stats",
+		Short: "Recompute the core and vaults stats from state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgReconcileStats{
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetClaimTip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-claim-tip [tip]",
+		Short: "Set the tip, in basis points, paid to signers claiming yield on behalf of holders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tip, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid tip: %w", err)
+			}
+
+			msg := &v2.MsgSetClaimTip{
+				Signer: clientCtx.GetFromAddress().String(),
+				Tip:    uint32(tip),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee [fee] [collector]",
+		Short: "Set the fee, in basis points, taken from newly accrued yield, and its collector",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid fee: %w", err)
+			}
+
+			var collector string
+			if len(args) == 2 {
+				collector = args[1]
+			}
+
+			msg := &v2.MsgSetFee{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Fee:       uint32(fee),
+				Collector: collector,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetFrozenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-frozen-account [account] [frozen]",
+		Short: "Freeze or unfreeze an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			frozen, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid frozen: %w", err)
+			}
+
+			msg := &v2.MsgSetFrozenAccount{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Frozen:  frozen,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxClaimFrozenYield() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-frozen-yield [account]",
+		Short: "Claim the yield of a frozen account to its balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgClaimFrozenYield{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetPausedType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paused-type [actions...]",
+		Short: "Set the actions that are paused (claim, transfer, index, external_yield, all, or none)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused := v2.PausedType_NONE
+			for _, arg := range args {
+				action, found := v2.PausedType_value[strings.ToUpper(arg)]
+				if !found {
+					return fmt.Errorf("invalid action: %s", arg)
+				}
+				paused |= v2.PausedType(action)
+			}
+
+			msg := &v2.MsgSetPausedType{
+				Signer: clientCtx.GetFromAddress().String(),
+				Paused: paused,
+			}
+
+			if rawExpiry, _ := cmd.Flags().GetString(FlagExpiry); rawExpiry != "" {
+				expiry, err := parseTime(rawExpiry)
+				if err != nil {
+					return err
+				}
+				msg.Expiry = &expiry
+			}
+			msg.Reason, _ = cmd.Flags().GetString(FlagReason)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiry, "", "Time after which the pause is automatically lifted (RFC3339 or unix seconds)")
+	cmd.Flags().String(FlagReason, "", "Reason for the pause")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [account] [role]",
+		Short: "Grant a role to an account (pauser, yield_recipient_manager, portal_peer_manager, or index_operator)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgGrantRole{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Role:    role,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [account] [role]",
+		Short: "Revoke a role from an account (pauser, yield_recipient_manager, portal_peer_manager, or index_operator)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgRevokeRole{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Role:    role,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetTimelockDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-timelock-delay [delay]",
+		Short: "Set the delay (e.g. 48h) after which timelocked admin messages are executed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delay, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid delay: %w", err)
+			}
+
+			msg := &v2.MsgSetTimelockDelay{
+				Signer: clientCtx.GetFromAddress().String(),
+				Delay:  delay,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxCancelTimelockedMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-timelocked-message [id]",
+		Short: "Cancel a pending timelocked admin message",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			msg := &v2.MsgCancelTimelockedMessage{
+				Signer: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-index [index] [reason]",
+		Short: "Set the $M index in an emergency, e.g. during a portal outage",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid index: %w", err)
+			}
+
+			msg := &v2.MsgSetIndex{
+				Signer: clientCtx.GetFromAddress().String(),
+				Index:  index,
+				Reason: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetMaxYieldRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-yield-rate [rate]",
+		Short: "Set the maximum annual yield rate implied by index updates, above which they are quarantined",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid rate: %w", err)
+			}
+
+			msg := &v2.MsgSetMaxYieldRate{
+				Signer: clientCtx.GetFromAddress().String(),
+				Rate:   rate,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxApprovePendingIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-pending-index",
+		Short: "Approve the quarantined index",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgApprovePendingIndex{
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRejectPendingIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-pending-index",
+		Short: "Reject the quarantined index",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgRejectPendingIndex{
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetIndexStaleness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-index-staleness [threshold]",
+		Short: "Set the time (e.g. 72h) since the last index update after which the index is considered stale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid threshold: %w", err)
+			}
+
+			blockVaultLocks, err := cmd.Flags().GetBool(FlagBlockVaultLocks)
+			if err != nil {
+				return err
+			}
+
+			msg := &v2.MsgSetIndexStaleness{
+				Signer:          clientCtx.GetFromAddress().String(),
+				Threshold:       threshold,
+				BlockVaultLocks: blockVaultLocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagBlockVaultLocks, false, "Block new vault locks while the index is stale")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetHaircutMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-haircut-mode [enabled]",
+		Short: "Enable or disable accepting decreasing index updates, socializing the loss across all holders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid enabled: %w", err)
+			}
+
+			msg := &v2.MsgSetHaircutMode{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Enabled: enabled,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
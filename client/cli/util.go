@@ -22,11 +22,22 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"dollar.noble.xyz/v2/types/v2"
 )
 
+const (
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagExpiry    = "expiry"
+	FlagReason    = "reason"
+
+	FlagBlockVaultLocks = "block-vault-locks"
+)
+
 func parseProvider(input string) (v2.Provider, error) {
 	switch strings.ToLower(input) {
 	case "ibc":
@@ -37,3 +48,48 @@ func parseProvider(input string) (v2.Provider, error) {
 		return -1, fmt.Errorf("invalid provider %s", input)
 	}
 }
+
+func parseRole(input string) (v2.Role, error) {
+	role, found := v2.Role_value[strings.ToUpper(input)]
+	if !found || role == int32(v2.Role_ROLE_UNSPECIFIED) {
+		return -1, fmt.Errorf("invalid role %s", input)
+	}
+
+	return v2.Role(role), nil
+}
+
+func parseTime(input string) (time.Time, error) {
+	if unix, err := strconv.ParseInt(input, 10, 64); err == nil {
+		return time.Unix(unix, 0).UTC(), nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s", input)
+	}
+
+	return parsed, nil
+}
+
+func parseWindow(input string) (time.Duration, error) {
+	switch strings.ToLower(input) {
+	case "genesis", "all":
+		return 0, nil
+	}
+
+	if days, found := strings.CutSuffix(input, "d"); found {
+		amount, err := strconv.ParseInt(days, 10, 64)
+		if err != nil || amount < 0 {
+			return 0, fmt.Errorf("invalid window %s", input)
+		}
+
+		return time.Duration(amount) * 24 * time.Hour, nil
+	}
+
+	window, err := time.ParseDuration(input)
+	if err != nil || window < 0 {
+		return 0, fmt.Errorf("invalid window %s", input)
+	}
+
+	return window, nil
+}
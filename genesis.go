@@ -38,7 +38,11 @@ import (
 func InitGenesis(ctx context.Context, k *keeper.Keeper, address address.Codec, genesis types.GenesisState) {
 	var err error
 
-	err = k.Paused.Set(ctx, genesis.Paused)
+	paused := genesis.PausedType
+	if genesis.Paused {
+		paused |= types.PausedType_CLAIM
+	}
+	err = k.Paused.Set(ctx, int32(paused))
 	if err != nil {
 		panic(errors.Wrap(err, "unable to set genesis paused state"))
 	}
@@ -48,6 +52,32 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, address address.Codec, g
 		panic(errors.Wrap(err, "unable to set genesis index"))
 	}
 
+	if genesis.MIndex != 0 {
+		err = k.MIndex.Set(ctx, genesis.MIndex)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis m index"))
+		}
+	}
+
+	if genesis.AttestedMIndex != 0 {
+		err = k.AttestedMIndex.Set(ctx, genesis.AttestedMIndex)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis attested m index"))
+		}
+	}
+
+	if !genesis.EarnerRate.IsNil() {
+		err = k.EarnerRate.Set(ctx, genesis.EarnerRate)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis earner rate"))
+		}
+	}
+
+	err = k.ClaimTip.Set(ctx, genesis.ClaimTip)
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis claim tip"))
+	}
+
 	for rawAccount, rawPrincipal := range genesis.Principal {
 		account, err := address.StringToBytes(rawAccount)
 		if err != nil {
@@ -109,10 +139,221 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, address address.Codec, g
 		}
 	}
 
+	for rawAccount, rawRecipient := range genesis.YieldClaimRecipients {
+		account, err := address.StringToBytes(rawAccount)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode account %s", rawAccount))
+		}
+		recipient, err := address.StringToBytes(rawRecipient)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode yield claim recipient %s", rawRecipient))
+		}
+
+		err = k.YieldClaimRecipients.Set(ctx, account, recipient)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis yield claim recipient (%s:%s)", rawAccount, rawRecipient))
+		}
+	}
+
+	for rawAccount, split := range genesis.YieldSplits {
+		account, err := address.StringToBytes(rawAccount)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode account %s", rawAccount))
+		}
+
+		err = k.YieldSplits.Set(ctx, account, split)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis yield split (%s)", rawAccount))
+		}
+	}
+
+	for _, rawAccount := range genesis.AutoClaimAccounts {
+		account, err := address.StringToBytes(rawAccount)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode auto claim account %s", rawAccount))
+		}
+
+		err = k.AutoClaimAccounts.Set(ctx, account)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis auto claim account (%s)", rawAccount))
+		}
+	}
+
+	if genesis.AutoClaimInProgress {
+		cursor := []byte{}
+		if genesis.AutoClaimCursor != "" {
+			cursor, err = address.StringToBytes(genesis.AutoClaimCursor)
+			if err != nil {
+				panic(errors.Wrapf(err, "unable to decode auto claim cursor %s", genesis.AutoClaimCursor))
+			}
+		}
+
+		err = k.AutoClaimCursor.Set(ctx, cursor)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis auto claim cursor"))
+		}
+	}
+
+	for _, rawAccount := range genesis.NonEarningAccounts {
+		account, err := address.StringToBytes(rawAccount)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode non-earning account %s", rawAccount))
+		}
+
+		err = k.NonEarningAccounts.Set(ctx, account)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis non-earning account (%s)", rawAccount))
+		}
+	}
+
+	err = k.FeeRate.Set(ctx, genesis.Fee)
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis fee"))
+	}
+
+	if genesis.FeeCollector != "" {
+		collector, err := address.StringToBytes(genesis.FeeCollector)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode fee collector %s", genesis.FeeCollector))
+		}
+
+		err = k.FeeCollector.Set(ctx, collector)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis fee collector"))
+		}
+	}
+
+	for _, rawAccount := range genesis.FrozenAccounts {
+		account, err := address.StringToBytes(rawAccount)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode frozen account %s", rawAccount))
+		}
+
+		err = k.FrozenAccounts.Set(ctx, account)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis frozen account (%s)", rawAccount))
+		}
+	}
+
+	for _, grant := range genesis.Roles {
+		account, err := address.StringToBytes(grant.Account)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode role account %s", grant.Account))
+		}
+
+		err = k.Roles.Set(ctx, collections.Join(account, int32(grant.Role)))
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis role (%s, %s)", grant.Account, grant.Role))
+		}
+	}
+
+	err = k.TimelockDelay.Set(ctx, int64(genesis.TimelockDelay))
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis timelock delay"))
+	}
+
+	for _, message := range genesis.TimelockedMessages {
+		err = k.TimelockedMessages.Set(ctx, message.Id, message)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis timelocked message (%d)", message.Id))
+		}
+	}
+
+	err = k.TimelockNonce.Set(ctx, genesis.TimelockNonce)
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis timelock nonce"))
+	}
+
+	if !genesis.MaxYieldRate.IsNil() {
+		err = k.MaxYieldRate.Set(ctx, genesis.MaxYieldRate)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis maximum yield rate"))
+		}
+	}
+
+	if genesis.PendingIndex != nil {
+		err = k.PendingIndex.Set(ctx, *genesis.PendingIndex)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis pending index"))
+		}
+	}
+
+	err = k.IndexStaleness.Set(ctx, genesis.IndexStaleness)
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis index staleness"))
+	}
+
+	if genesis.IndexStale {
+		err = k.IndexStale.Set(ctx, true)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis index stale"))
+		}
+	}
+
+	if genesis.IndexHistoryStart != 0 {
+		err = k.IndexHistoryStart.Set(ctx, genesis.IndexHistoryStart)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis index history start"))
+		}
+	}
+
+	err = k.HaircutMode.Set(ctx, genesis.HaircutMode)
+	if err != nil {
+		panic(errors.Wrap(err, "unable to set genesis haircut mode"))
+	}
+
+	if !genesis.HaircutFactor.IsNil() && genesis.HaircutFactor.LT(math.LegacyOneDec()) {
+		err = k.HaircutFactor.Set(ctx, genesis.HaircutFactor)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis haircut factor"))
+		}
+	}
+
+	for _, entry := range genesis.PauseInfos {
+		err = k.PauseInfos.Set(ctx, collections.Join(entry.Module, entry.Action), entry.Info)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis pause info (%s, %d)", entry.Module, entry.Action))
+		}
+	}
+
+	if genesis.Treasury != "" {
+		treasury, err := address.StringToBytes(genesis.Treasury)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to decode treasury %s", genesis.Treasury))
+		}
+
+		err = k.Treasury.Set(ctx, treasury)
+		if err != nil {
+			panic(errors.Wrap(err, "unable to set genesis treasury"))
+		}
+	}
+
+	for _, record := range genesis.IndexHistory {
+		key := collections.Join3(record.Time.Unix(), record.Height, record.Sequence)
+		err = k.IndexHistory.Set(ctx, key, record)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis index record (%d:%d)", record.Height, record.Index))
+		}
+	}
+
+	for _, distribution := range genesis.YieldDistributions {
+		key := collections.Join3(distribution.Time.Unix(), distribution.Height, distribution.Sequence)
+		err = k.YieldDistributions.Set(ctx, key, distribution)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to set genesis yield distribution (%d:%d)", distribution.Height, distribution.Index))
+		}
+	}
+
 	if err = k.PortalOwner.Set(ctx, genesis.Portal.Owner); err != nil {
 		panic(errors.Wrap(err, "unable to set genesis portal owner"))
 	}
 
+	if genesis.Portal.PendingOwner != "" {
+		if err = k.PortalPendingOwner.Set(ctx, genesis.Portal.PendingOwner); err != nil {
+			panic(errors.Wrap(err, "unable to set genesis portal pending owner"))
+		}
+	}
+
 	if err = k.PortalPaused.Set(ctx, genesis.Portal.Paused); err != nil {
 		panic(errors.Wrap(err, "unable to set genesis portal paused state"))
 	}
@@ -190,8 +431,36 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
 	totalExternalYield, _ := k.GetTotalExternalYield(ctx)
 	yieldRecipients, _ := k.GetYieldRecipients(ctx)
 	retryAmounts, _ := k.GetRetryAmounts(ctx)
+	indexHistory, _ := k.GetIndexHistory(ctx)
+	yieldDistributions, _ := k.GetYieldDistributions(ctx)
+	earnerRate := k.GetEarnerRate(ctx)
+	yieldClaimRecipients, _ := k.GetYieldClaimRecipients(ctx)
+	claimTip := k.GetClaimTip(ctx)
+	yieldSplits, _ := k.GetYieldSplits(ctx)
+	autoClaimAccounts, _ := k.GetAutoClaimAccounts(ctx)
+	autoClaimInProgress, autoClaimCursor := k.GetAutoClaimCursor(ctx)
+	nonEarningAccounts, _ := k.GetNonEarningAccounts(ctx)
+	treasury := k.GetTreasury(ctx)
+	mIndex, _ := k.GetMIndex(ctx)
+	attestedMIndex, _ := k.AttestedMIndex.Get(ctx)
+	fee := k.GetFeeRate(ctx)
+	feeCollector := k.GetFeeCollector(ctx)
+	frozenAccounts, _ := k.GetFrozenAccounts(ctx)
+	pauseInfos, _ := k.GetPauseInfos(ctx)
+	roles, _ := k.GetRoles(ctx)
+	timelockDelay := k.GetTimelockDelay(ctx)
+	timelockedMessages, _ := k.GetTimelockedMessages(ctx)
+	timelockNonce, _ := k.TimelockNonce.Peek(ctx)
+	maxYieldRate := k.GetMaxYieldRate(ctx)
+	pendingIndex := k.GetPendingIndex(ctx)
+	indexStaleness := k.GetIndexStaleness(ctx)
+	indexStale, _ := k.IndexStale.Get(ctx)
+	indexHistoryStart, _ := k.IndexHistoryStart.Get(ctx)
+	haircutMode := k.IsHaircutMode(ctx)
+	haircutFactor := k.GetHaircutFactor(ctx)
 
 	portalOwner, _ := k.PortalOwner.Get(ctx)
+	portalPendingOwner := k.GetPortalPendingOwner(ctx)
 	portalPaused := k.GetPortalPaused(ctx)
 	portalPeers, _ := k.GetPortalPeers(ctx)
 	portalBridgingPaths, _ := k.GetPortalBridgingPaths(ctx)
@@ -212,6 +481,7 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
 			Peers:         portalPeers,
 			BridgingPaths: portalBridgingPaths,
 			Nonce:         portalNonce,
+			PendingOwner:  portalPendingOwner,
 		},
 		Vaults: vaults.GenesisState{
 			Positions:               vaultsPositions,
@@ -222,12 +492,40 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
 			SeasonTwoYieldCollector: vaultsSeasonTwoYieldCollector.String(),
 			Stats:                   vaultsStats,
 		},
-		Paused:             paused,
-		Index:              index,
-		Principal:          principal,
-		Stats:              stats,
-		TotalExternalYield: totalExternalYield,
-		YieldRecipients:    yieldRecipients,
-		RetryAmounts:       retryAmounts,
+		PausedType:           paused,
+		Index:                index,
+		Principal:            principal,
+		Stats:                stats,
+		TotalExternalYield:   totalExternalYield,
+		YieldRecipients:      yieldRecipients,
+		RetryAmounts:         retryAmounts,
+		IndexHistory:         indexHistory,
+		EarnerRate:           earnerRate,
+		YieldClaimRecipients: yieldClaimRecipients,
+		ClaimTip:             claimTip,
+		YieldSplits:          yieldSplits,
+		AutoClaimAccounts:    autoClaimAccounts,
+		AutoClaimInProgress:  autoClaimInProgress,
+		AutoClaimCursor:      autoClaimCursor,
+		NonEarningAccounts:   nonEarningAccounts,
+		Treasury:             treasury,
+		MIndex:               mIndex,
+		Fee:                  fee,
+		FeeCollector:         feeCollector,
+		FrozenAccounts:       frozenAccounts,
+		PauseInfos:           pauseInfos,
+		Roles:                roles,
+		TimelockDelay:        timelockDelay,
+		TimelockedMessages:   timelockedMessages,
+		TimelockNonce:        timelockNonce,
+		MaxYieldRate:         maxYieldRate,
+		PendingIndex:         pendingIndex,
+		IndexStaleness:       indexStaleness,
+		IndexStale:           indexStale,
+		HaircutMode:          haircutMode,
+		HaircutFactor:        haircutFactor,
+		YieldDistributions:   yieldDistributions,
+		AttestedMIndex:       attestedMIndex,
+		IndexHistoryStart:    indexHistoryStart,
 	}
 }
@@ -70,7 +70,8 @@ func (m MockICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string
 }
 
 type MockDollarKeeper struct {
-	denom string
+	denom  string
+	frozen sdk.AccAddress
 }
 
 func (m MockDollarKeeper) GetDenom() string {
@@ -87,41 +88,64 @@ func (m MockDollarKeeper) HasYieldRecipient(_ context.Context, provider v2.Provi
 	return false
 }
 
+func (m MockDollarKeeper) IsFrozen(_ context.Context, account []byte) bool {
+	return m.frozen.Equals(sdk.AccAddress(account))
+}
+
 // TestSendPacket asserts that outgoing IBC transfers work as expected in cases
 // where the denom is $USDN, as well as cases where the denom is not.
 func TestSendPacket(t *testing.T) {
 	denom := "uusdn"
+	sender, frozen := sdk.AccAddress("sender"), sdk.AccAddress("frozen")
 
 	tc := []struct {
 		name    string
 		channel string
 		data    transfertypes.FungibleTokenPacketData
-		fail    bool
+		err     string
 	}{
 		{
 			"Outgoing IBC transfer of USDN with channel yield recipient set - should not be blocked",
 			"channel-0",
-			transfertypes.NewFungibleTokenPacketData(denom, "1000000", "test", "test", "test"),
-			false,
+			transfertypes.NewFungibleTokenPacketData(denom, "1000000", sender.String(), "test", "test"),
+			"",
 		},
 		{
 			"Outgoing IBC transfer of USDN without channel yield recipient set - should be blocked",
 			"channel-1",
-			transfertypes.NewFungibleTokenPacketData(denom, "1000000", "test", "test", "test"),
-			true,
+			transfertypes.NewFungibleTokenPacketData(denom, "1000000", sender.String(), "test", "test"),
+			fmt.Sprintf("ibc transfers of %s are currently disabled on %s", denom, "channel-1"),
 		},
 		{
 			"Outgoing IBC transfer of USDC - should not be blocked",
 			"channel-0",
 			transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", "test", "test", "test"),
-			false,
+			"",
+		},
+		{
+			"Outgoing IBC transfer of USDN from a frozen account - should be blocked",
+			"channel-0",
+			transfertypes.NewFungibleTokenPacketData(denom, "1000000", frozen.String(), "test", "test"),
+			fmt.Sprintf("sender %s is frozen", frozen.String()),
+		},
+		{
+			"Outgoing IBC transfer of USDN from an invalid sender - should be blocked",
+			"channel-0",
+			transfertypes.NewFungibleTokenPacketData(denom, "1000000", "test", "test", "test"),
+			"unable to decode sender test",
+		},
+		{
+			"Outgoing IBC transfer of USDC from a frozen account - should not be blocked",
+			"channel-0",
+			transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", frozen.String(), "test", "test"),
+			"",
 		},
 	}
 
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			wrapper := MockICS4Wrapper{t}
-			keeper := MockDollarKeeper{denom: denom}
+			keeper := MockDollarKeeper{denom: denom, frozen: frozen}
 			nobleWrapper := NewICS4Wrapper(wrapper, keeper)
 
 			data, err := transfertypes.ModuleCdc.MarshalJSON(&tt.data)
@@ -132,9 +156,9 @@ func TestSendPacket(t *testing.T) {
 
 			_, err = nobleWrapper.SendPacket(ctx, nil, "transfer", tt.channel, clienttypes.Height{}, timeout, data)
 
-			if tt.fail {
+			if tt.err != "" {
 				require.Error(t, err)
-				require.ErrorContains(t, err, fmt.Sprintf("ibc transfers of %s are currently disabled on %s", denom, tt.channel))
+				require.ErrorContains(t, err, tt.err)
 			} else {
 				require.NoError(t, err)
 			}
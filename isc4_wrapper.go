@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -31,6 +32,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	"dollar.noble.xyz/v2/types"
 	"dollar.noble.xyz/v2/types/v2"
 )
 
@@ -38,7 +40,7 @@ var _ porttypes.ICS4Wrapper = &ICS4Wrapper{}
 
 // ICS4Wrapper implements the porttypes.ICS4Wrapper interface. It implements
 // custom logic in SendPacket in order to check all outgoing IBC transfers so
-// that $USDN cannot be sent to another chain.
+// that $USDN cannot be sent to another chain, or by a frozen account.
 type ICS4Wrapper struct {
 	ics4Wrapper  porttypes.ICS4Wrapper
 	dollarKeeper ICS4WrapperExpectedDollarKeeper
@@ -48,6 +50,7 @@ type ICS4Wrapper struct {
 type ICS4WrapperExpectedDollarKeeper interface {
 	GetDenom() string
 	HasYieldRecipient(ctx context.Context, provider v2.Provider, identifier string) bool
+	IsFrozen(ctx context.Context, account []byte) bool
 }
 
 // NewICS4Wrapper returns a new instance of ICS4Wrapper.
@@ -61,7 +64,7 @@ func NewICS4Wrapper(app porttypes.ICS4Wrapper, dollarKeeper ICS4WrapperExpectedD
 // SendPacket attempts to unmarshal the provided packet data as the ICS-20
 // FungibleTokenPacketData type. If the packet is a valid ICS-20 transfer, then
 // a check is performed on the denom to ensure that $USDN cannot be transferred
-// out of Noble via IBC.
+// out of Noble via IBC, and on the sender to ensure that it isn't frozen.
 func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
 	var packetData transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
@@ -74,6 +77,14 @@ func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capabi
 		if !enabled {
 			return 0, fmt.Errorf("ibc transfers of %s are currently disabled on %s", denom, sourceChannel)
 		}
+
+		sender, err := sdk.AccAddressFromBech32(packetData.Sender)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to decode sender %s", packetData.Sender)
+		}
+		if w.dollarKeeper.IsFrozen(ctx, sender) {
+			return 0, errors.Wrapf(types.ErrFrozen, "sender %s is frozen", packetData.Sender)
+		}
 	}
 
 	return w.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// AutoClaimBatchSize is the maximum number of auto claim accounts that are
+// processed in a single block.
+const AutoClaimBatchSize = 100
+
+// BeginBlocker is called at the beginning of every block.
+func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	if err := k.processExpiredPauses(ctx); err != nil {
+		return err
+	}
+	if err := k.processTimelockedMessages(ctx); err != nil {
+		return err
+	}
+	if err := k.processIndexStaleness(ctx); err != nil {
+		return err
+	}
+
+	return k.processAutoClaims(ctx)
+}
+
+// processExpiredPauses is an internal helper function that automatically
+// lifts all paused actions whose expiry has been reached.
+func (k *Keeper) processExpiredPauses(ctx context.Context) error {
+	now := k.header.GetHeaderInfo(ctx).Time
+
+	var expired []collections.Pair[string, int32]
+	var reasons []string
+	err := k.PauseInfos.Walk(ctx, nil, func(key collections.Pair[string, int32], info v2.PauseInfo) (stop bool, err error) {
+		if info.Expiry != nil && !now.Before(*info.Expiry) {
+			expired = append(expired, key)
+			reasons = append(reasons, info.Reason)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to iterate pause infos")
+	}
+
+	for i, key := range expired {
+		module, action := key.K1(), key.K2()
+		if err := k.liftPause(ctx, module, action); err != nil {
+			return errors.Wrapf(err, "unable to lift pause of %s", module)
+		}
+		if err := k.PauseInfos.Remove(ctx, key); err != nil {
+			return errors.Wrapf(err, "unable to remove pause info of %s from state", module)
+		}
+
+		err = k.event.EventManager(ctx).Emit(ctx, &v2.PauseExpired{
+			Module: module,
+			Reason: reasons[i],
+			Paused: pausedActionName(module, action),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processAutoClaims is an internal helper function that claims the yield of
+// the next batch of auto claim accounts, if a pass is in progress. A pass is
+// started on an index update if none is in progress, and continues across
+// blocks until all accounts have been processed.
+func (k *Keeper) processAutoClaims(ctx context.Context) error {
+	cursor, err := k.AutoClaimCursor.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) || k.IsPaused(ctx, v2.PausedType_CLAIM) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "unable to get auto claim cursor from state")
+	}
+
+	var rng collections.Ranger[[]byte]
+	if len(cursor) > 0 {
+		rng = new(collections.Range[[]byte]).StartExclusive(cursor)
+	}
+
+	var accounts [][]byte
+	err = k.AutoClaimAccounts.Walk(ctx, rng, func(account []byte) (stop bool, err error) {
+		accounts = append(accounts, account)
+		return len(accounts) == AutoClaimBatchSize, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get auto claim accounts from state")
+	}
+
+	for _, account := range accounts {
+		if k.IsFrozen(ctx, account) {
+			continue
+		}
+
+		address, err := k.address.BytesToString(account)
+		if err != nil {
+			return errors.Wrap(err, "unable to encode auto claim account")
+		}
+
+		// NOTE: Each claim is executed in a cached context, so that a failed
+		// claim doesn't partially apply, emit events, or halt the processing
+		// of others.
+		cacheCtx, writeCache := cacheContext(ctx)
+		yield, _, err := k.claimYield(cacheCtx, address, nil)
+		if err == nil && yield.IsPositive() {
+			err = k.event.EventManager(cacheCtx).Emit(cacheCtx, &types.YieldClaimed{
+				Account: address,
+				Amount:  yield,
+			})
+		}
+		if err != nil {
+			k.logger.Error("unable to auto claim yield", "account", address, "err", err)
+			continue
+		}
+
+		writeCache()
+	}
+
+	if len(accounts) < AutoClaimBatchSize {
+		return k.AutoClaimCursor.Remove(ctx)
+	}
+
+	return k.AutoClaimCursor.Set(ctx, accounts[len(accounts)-1])
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// GetMaxYieldRate is a utility that returns the maximum annual yield rate
+// implied by index updates. A rate of zero disables the circuit breaker.
+func (k *Keeper) GetMaxYieldRate(ctx context.Context) math.LegacyDec {
+	rate, err := k.MaxYieldRate.Get(ctx)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+
+	return rate
+}
+
+// GetPendingIndex is a utility that returns the quarantined index of $M, if
+// there is one.
+func (k *Keeper) GetPendingIndex(ctx context.Context) *v2.PendingIndex {
+	pending, err := k.PendingIndex.Get(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return &pending
+}
+
+// quarantineIndex is an internal helper function that quarantines an index
+// update of $M if the annual yield rate implied by its growth, since the last
+// index update, exceeds the maximum yield rate. It returns false if the index
+// update should instead be applied.
+func (k *Keeper) quarantineIndex(ctx context.Context, oldMIndex int64, mIndex int64) (bool, error) {
+	maxRate := k.GetMaxYieldRate(ctx)
+	if !maxRate.IsPositive() {
+		return false, nil
+	}
+
+	// NOTE: Without a previous index update, there is no elapsed time to
+	// derive the implied yield rate from.
+	record, err := k.GetLatestIndexRecord(ctx)
+	if err != nil {
+		return false, nil
+	}
+
+	now := k.header.GetHeaderInfo(ctx).Time
+	elapsed := now.Unix() - record.Time.Unix()
+	impliedRate := ""
+
+	// NOTE: Over at most a year, the implied yield rate is at least the growth
+	// of the index itself. A growth beyond the maximum yield rate is therefore
+	// quarantined without computing the implied yield rate, bounding the
+	// arguments that the rate is ever computed from.
+	growth := math.LegacyNewDec(mIndex - oldMIndex)
+	withinBound := growth.LTE(maxRate.MulInt64(oldMIndex))
+
+	// NOTE: Without elapsed time, e.g. for a second index update within the
+	// same block, there is no implied yield rate. The index update is instead
+	// applied only if its growth is within the absolute bound.
+	if elapsed <= 0 {
+		if withinBound {
+			return false, nil
+		}
+	} else if elapsed > SecondsPerYear || withinBound {
+		_, apy, err := k.GetYieldRate(oldMIndex, mIndex, elapsed)
+		if err == nil {
+			if apy.LTE(maxRate) {
+				return false, nil
+			}
+			impliedRate = apy.String()
+		}
+	}
+
+	// NOTE: A newer quarantined index replaces any previous one.
+	err = k.PendingIndex.Set(ctx, v2.PendingIndex{
+		MIndex:       mIndex,
+		ReceivedTime: now,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set pending index in state")
+	}
+
+	return true, k.event.EventManager(ctx).Emit(ctx, &v2.IndexQuarantined{
+		OldMIndex:   oldMIndex,
+		NewMIndex:   mIndex,
+		ImpliedRate: impliedRate,
+	})
+}
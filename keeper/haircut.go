@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// IsHaircutMode is a utility that returns whether decreasing index updates
+// are accepted, socializing the loss across all holders.
+func (k *Keeper) IsHaircutMode(ctx context.Context) bool {
+	enabled, _ := k.HaircutMode.Get(ctx)
+	return enabled
+}
+
+// GetHaircutFactor is a utility that returns the fraction of $USDN balances
+// that is backed by $M. If no haircut is applied, it is equal to one.
+func (k *Keeper) GetHaircutFactor(ctx context.Context) math.LegacyDec {
+	factor, err := k.HaircutFactor.Get(ctx)
+	if err != nil {
+		return math.LegacyOneDec()
+	}
+
+	return factor
+}
+
+// GetEffectiveAmount is a utility that returns the amount of $M backing the
+// provided amount of $USDN, reduced pro-rata by the haircut factor.
+func (k *Keeper) GetEffectiveAmount(ctx context.Context, amount math.Int) math.Int {
+	return k.GetHaircutFactor(ctx).MulInt(amount).TruncateInt()
+}
+
+// GetNominalAmount is a utility that returns the amount of $USDN backed by
+// the provided amount of $M, increased pro-rata by the haircut factor. It is
+// the inverse of GetEffectiveAmount, rounded down.
+func (k *Keeper) GetNominalAmount(ctx context.Context, amount math.Int) math.Int {
+	return math.LegacyNewDecFromInt(amount).Quo(k.GetHaircutFactor(ctx)).TruncateInt()
+}
+
+// getBackedIndex is an internal helper function that returns the index of
+// $USDN implied by the growth of $M, before fees, reduced by the haircut
+// factor. If it is below the current index of $USDN, the backing of $USDN
+// has a shortfall.
+func (k *Keeper) getBackedIndex(ctx context.Context, index int64, oldMIndex int64, mIndex int64) math.LegacyDec {
+	grossIndex := k.GetNetIndex(index, oldMIndex, mIndex, 0)
+	return k.GetHaircutFactor(ctx).MulInt64(grossIndex)
+}
+
+// applyHaircut is an internal helper function that absorbs any shortfall of
+// the backing of $USDN, returning the index of $USDN to continue from. In
+// haircut mode, the unclaimed yield held in the yield account is burned
+// first, lowering the index of $USDN so that the yield owed to each holder is
+// reduced pro-rata to their principal. Any remaining shortfall is tracked in
+// the haircut factor, reducing the effective value of all $USDN pro-rata,
+// while balances are left unchanged. An existing haircut factor is recovered
+// as the backed index grows back.
+func (k *Keeper) applyHaircut(ctx context.Context, index int64, backedIndex math.LegacyDec) (int64, error) {
+	oldFactor := k.GetHaircutFactor(ctx)
+	growth := backedIndex.QuoInt64(index)
+	currentSupply := k.bank.GetSupply(ctx, k.denom).Amount
+
+	newIndex := index
+	shortfall, burned := math.ZeroInt(), math.ZeroInt()
+	newFactor := math.LegacyOneDec()
+	if growth.LT(math.LegacyOneDec()) && currentSupply.IsPositive() {
+		backing := growth.MulInt(currentSupply)
+		shortfall = math.LegacyNewDecFromInt(currentSupply).Sub(backing).Ceil().TruncateInt()
+
+		if k.IsHaircutMode(ctx) {
+			burned = math.MinInt(shortfall, k.bank.GetBalance(ctx, types.YieldAddress, k.denom).Amount)
+		}
+		if burned.IsPositive() {
+			totalPrincipal, err := k.GetTotalPrincipal(ctx)
+			if err != nil {
+				return 0, errors.Wrap(err, "unable to get total principal from state")
+			}
+
+			coins := sdk.NewCoins(sdk.NewCoin(k.denom, burned))
+			err = k.bank.SendCoinsFromModuleToModule(ctx, types.YieldName, types.ModuleName, coins)
+			if err != nil {
+				return 0, errors.Wrap(err, "unable to send coins")
+			}
+			err = k.bank.BurnCoins(ctx, types.ModuleName, coins)
+			if err != nil {
+				return 0, errors.Wrap(err, "unable to burn coins")
+			}
+			err = k.IncrementTotalHaircutBurned(ctx, burned)
+			if err != nil {
+				return 0, errors.Wrap(err, "unable to increment total haircut burned")
+			}
+
+			// NOTE: The supply of $USDN tracks the present amount of the total
+			// principal, so the index is lowered by at least the burned yield
+			// per unit of principal. Otherwise, the burned yield would remain
+			// owed, and be minted again by the next index update.
+			if totalPrincipal.IsPositive() {
+				decrease := burned.MulRaw(1e12).Add(totalPrincipal).SubRaw(1).Quo(totalPrincipal)
+				newIndex = math.NewInt(index).Sub(decrease).Int64()
+			}
+		}
+
+		if remaining := currentSupply.Sub(burned); shortfall.GT(burned) {
+			newFactor = backing.QuoInt(remaining)
+		}
+	}
+
+	if newFactor.Equal(oldFactor) && !burned.IsPositive() {
+		return newIndex, nil
+	}
+
+	var err error
+	if newFactor.Equal(math.LegacyOneDec()) {
+		err = k.HaircutFactor.Remove(ctx)
+	} else {
+		err = k.HaircutFactor.Set(ctx, newFactor)
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to set haircut factor in state")
+	}
+
+	return newIndex, k.event.EventManager(ctx).Emit(ctx, &v2.HaircutApplied{
+		Shortfall:   shortfall,
+		YieldBurned: burned,
+		OldFactor:   oldFactor,
+		NewFactor:   newFactor,
+		OldIndex:    index,
+		NewIndex:    newIndex,
+	})
+}
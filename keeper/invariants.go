@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
+)
+
+// Invariant is a named invariant of the Noble Dollar module.
+type Invariant struct {
+	Name      string
+	Invariant func(k *Keeper) sdk.Invariant
+}
+
+// MaxQueryEntries is the maximum amount of principal entries and vault
+// positions that queries summing over all accounts, i.e. the invariants and
+// yield liability queries, iterate over. Beyond this, the invariants can only
+// be checked via the crisis module.
+const MaxQueryEntries = 10_000
+
+// Invariants contains all invariants of the Noble Dollar module.
+var Invariants = []Invariant{
+	{Name: "total-principal", Invariant: TotalPrincipalInvariant},
+	{Name: "supply", Invariant: SupplyInvariant},
+	{Name: "yield-reserve", Invariant: YieldReserveInvariant},
+	{Name: "vaults-principal", Invariant: VaultsPrincipalInvariant},
+}
+
+// RegisterInvariants registers all invariants of the Noble Dollar module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	for _, invariant := range Invariants {
+		ir.RegisterRoute(types.ModuleName, invariant.Name, invariant.Invariant(k))
+	}
+}
+
+// AllInvariants runs all invariants of the Noble Dollar module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range Invariants {
+			res, broken := invariant.Invariant(k)(ctx)
+			if broken {
+				return res, true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// TotalPrincipalInvariant checks that the total principal stat equals the sum
+// of the principal of all accounts, plus the tracked rounding of issuances.
+func TotalPrincipalInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stats, err := k.Stats.Get(ctx)
+		if err != nil {
+			return formatInvariant("total-principal", fmt.Sprintf("unable to get stats: %s", err)), true
+		}
+		computed, err := k.ComputeStats(ctx)
+		if err != nil {
+			return formatInvariant("total-principal", fmt.Sprintf("unable to compute stats: %s", err)), true
+		}
+
+		rounding := stats.TotalPrincipalRounding
+		if rounding.IsNil() {
+			rounding = math.ZeroInt()
+		}
+
+		broken := !stats.TotalPrincipal.Equal(computed.TotalPrincipal.Add(rounding))
+		return formatInvariant("total-principal", fmt.Sprintf(
+			"\tsum of principal: %s\n\ttotal principal rounding: %s\n\ttotal principal stat: %s\n",
+			computed.TotalPrincipal, rounding, stats.TotalPrincipal,
+		)), broken
+	}
+}
+
+// SupplyInvariant checks that the present amount of the total principal is
+// covered by the $USDN supply, within a tolerance of the tracked rounding.
+func SupplyInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		index, err := k.Index.Get(ctx)
+		if err != nil {
+			return formatInvariant("supply", fmt.Sprintf("unable to get index: %s", err)), true
+		}
+		totalPrincipal, err := k.sumPrincipal(ctx)
+		if err != nil {
+			return formatInvariant("supply", fmt.Sprintf("unable to sum principal: %s", err)), true
+		}
+
+		expectedSupply := k.GetPresentAmount(totalPrincipal, index)
+		supply := k.bank.GetSupply(ctx, k.denom).Amount
+		tolerance := k.getRoundingTolerance(ctx, index)
+
+		broken := expectedSupply.GT(supply.Add(tolerance))
+		return formatInvariant("supply", fmt.Sprintf(
+			"\texpected supply: %s\n\tsupply: %s\n\ttolerance: %s\n",
+			expectedSupply, supply, tolerance,
+		)), broken
+	}
+}
+
+// YieldReserveInvariant checks that the balance of the yield account covers
+// the outstanding claimable yield of all accounts at the last attested index,
+// within a tolerance of the tracked rounding. Balances in excess of the present
+// amount of their principal, e.g. after burning unclaimed yield to absorb a
+// haircut lowered the index, were already paid out of the yield account, and
+// are netted against the outstanding yield.
+func YieldReserveInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		index, err := k.Index.Get(ctx)
+		if err != nil {
+			return formatInvariant("yield-reserve", fmt.Sprintf("unable to get index: %s", err)), true
+		}
+
+		liability, err := k.ComputeYieldLiability(ctx, index)
+		if err != nil {
+			return formatInvariant("yield-reserve", fmt.Sprintf("unable to compute yield liability: %s", err)), true
+		}
+
+		reserve := k.bank.GetBalance(ctx, types.YieldAddress, k.denom).Amount
+		tolerance := k.getRoundingTolerance(ctx, index)
+
+		broken := liability.Liability.GT(reserve.Add(liability.Excess).Add(tolerance))
+		return formatInvariant("yield-reserve", fmt.Sprintf(
+			"\toutstanding yield: %s\n\tunclaimable yield: %s\n\texcess balances: %s\n\tyield reserve: %s\n\ttolerance: %s\n",
+			liability.Liability, liability.Rounding, liability.Excess, reserve, tolerance,
+		)), broken
+	}
+}
+
+// VaultsPrincipalInvariant checks that the total principal stat of each vault
+// equals the sum of the principal of its positions.
+func VaultsPrincipalInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stats, err := k.GetVaultsStats(ctx)
+		if err != nil {
+			return formatInvariant("vaults-principal", fmt.Sprintf("unable to get vaults stats: %s", err)), true
+		}
+		computed, err := k.ComputeVaultsStats(ctx)
+		if err != nil {
+			return formatInvariant("vaults-principal", fmt.Sprintf("unable to compute vaults stats: %s", err)), true
+		}
+
+		broken := !stats.FlexibleTotalPrincipal.Equal(computed.FlexibleTotalPrincipal) ||
+			!stats.StakedTotalPrincipal.Equal(computed.StakedTotalPrincipal)
+		return formatInvariant("vaults-principal", fmt.Sprintf(
+			"\tsum of flexible positions: %s\n\tflexible total principal stat: %s\n\tsum of staked positions: %s\n\tstaked total principal stat: %s\n",
+			computed.FlexibleTotalPrincipal, stats.FlexibleTotalPrincipal,
+			computed.StakedTotalPrincipal, stats.StakedTotalPrincipal,
+		)), broken
+	}
+}
+
+// CheckInvariants is a utility that runs all invariants, returning their results.
+func (k *Keeper) CheckInvariants(ctx context.Context) []v2.InvariantResult {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	results := make([]v2.InvariantResult, 0, len(Invariants))
+	for _, invariant := range Invariants {
+		msg, broken := invariant.Invariant(k)(sdkCtx)
+		results = append(results, v2.InvariantResult{
+			Name:    invariant.Name,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
+
+	return results
+}
+
+// ExceedsInvariantsQueryEntries is a utility that returns whether there are
+// more principal entries and vault positions than the invariants query is
+// allowed to iterate over. At most one entry past the limit is read.
+func (k *Keeper) ExceedsInvariantsQueryEntries(ctx context.Context) (bool, error) {
+	entries, err := k.countPrincipalEntries(ctx)
+	if err != nil || entries > MaxQueryEntries {
+		return true, err
+	}
+
+	err = k.VaultsPositions.Walk(ctx, nil, func(_ collections.Triple[[]byte, int32, int64], _ vaults.Position) (stop bool, err error) {
+		entries += 1
+		return entries > MaxQueryEntries, nil
+	})
+
+	return entries > MaxQueryEntries, err
+}
+
+// ExceedsYieldLiabilityQueryEntries is a utility that returns whether there
+// are more principal entries than the yield liability query is allowed to
+// iterate over. At most one entry past the limit is read.
+func (k *Keeper) ExceedsYieldLiabilityQueryEntries(ctx context.Context) (bool, error) {
+	entries, err := k.countPrincipalEntries(ctx)
+	return err != nil || entries > MaxQueryEntries, err
+}
+
+// countPrincipalEntries returns the amount of principal entries, reading at
+// most one entry past the maximum amount of query entries.
+func (k *Keeper) countPrincipalEntries(ctx context.Context) (int, error) {
+	entries := 0
+	err := k.Principal.Walk(ctx, nil, func(_ []byte, _ math.Int) (stop bool, err error) {
+		entries += 1
+		return entries > MaxQueryEntries, nil
+	})
+
+	return entries, err
+}
+
+// sumPrincipal returns the sum of the principal of all accounts.
+func (k *Keeper) sumPrincipal(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.Principal.Walk(ctx, nil, func(_ []byte, principal math.Int) (stop bool, err error) {
+		total = total.Add(principal)
+		return false, nil
+	})
+
+	return total, err
+}
+
+// getRoundingTolerance returns the present amount of the tracked rounding of
+// issuances, rounded up. As the total principal stat that yield is minted for
+// includes this rounding, it is the surplus that absorbs the rounding of the
+// principal of individual accounts.
+func (k *Keeper) getRoundingTolerance(ctx context.Context, index int64) math.Int {
+	stats, err := k.Stats.Get(ctx)
+	if err != nil || stats.TotalPrincipalRounding.IsNil() || !stats.TotalPrincipalRounding.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	return k.GetPresentAmount(stats.TotalPrincipalRounding, index).AddRaw(1)
+}
+
+func formatInvariant(name string, msg string) string {
+	return sdk.FormatInvariant(types.ModuleName, name, msg)
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
+	"dollar.noble.xyz/v2/utils"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestInvariants(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewQueryServerV2(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	broken := func() map[string]bool {
+		res, err := server.Invariants(ctx, &v2.QueryInvariants{})
+		require.NoError(t, err)
+		require.Len(t, res.Invariants, len(keeper.Invariants))
+
+		broken := make(map[string]bool)
+		for _, invariant := range res.Invariants {
+			broken[invariant.Name] = invariant.Broken
+		}
+		return broken
+	}
+
+	// ARRANGE: Alice and Bob mint, Alice transfers to Bob, and the index grows.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE+3), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(50*ONE+7), nil))
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12+13))
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(7*ONE+1), nil))
+	require.NoError(t, bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(33*ONE+1)))))
+
+	// ASSERT: The rounding of issuances is tracked.
+	stats, err := k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, stats.TotalPrincipalRounding.IsPositive())
+
+	// ACT: Check the invariants.
+	res, stop := keeper.AllInvariants(k)(ctx)
+	// ASSERT: No invariant is broken.
+	require.False(t, stop, res)
+	require.Equal(t, map[string]bool{
+		"total-principal":  false,
+		"supply":           false,
+		"yield-reserve":    false,
+		"vaults-principal": false,
+	}, broken())
+
+	// ASSERT: The tolerance is the present amount of the tracked rounding, rounded up.
+	index, err := k.Index.Get(ctx)
+	require.NoError(t, err)
+	tolerance := k.GetPresentAmount(stats.TotalPrincipalRounding, index).AddRaw(1)
+	msg, _ := keeper.YieldReserveInvariant(k)(ctx)
+	require.Contains(t, msg, fmt.Sprintf("tolerance: %s\n", tolerance))
+
+	// ARRANGE: Reconcile the stats, so that there is no tracked rounding left.
+	_, err = keeper.NewMsgServerV2(k).ReconcileStats(ctx, &v2.MsgReconcileStats{Signer: "authority"})
+	require.NoError(t, err)
+	// ASSERT: There is no tolerance anymore, while no invariant is broken.
+	msg, stop = keeper.YieldReserveInvariant(k)(ctx)
+	require.False(t, stop, msg)
+	require.Contains(t, msg, "tolerance: 0\n")
+
+	// ARRANGE: Drain the yield account.
+	bank.Balances[types.YieldAddress.String()] = sdk.NewCoins()
+	// ASSERT: The supply and yield reserve invariants are broken.
+	require.Equal(t, map[string]bool{
+		"total-principal":  false,
+		"supply":           true,
+		"yield-reserve":    true,
+		"vaults-principal": false,
+	}, broken())
+
+	// ARRANGE: Drift the total principal and vaults stats.
+	stats, err = k.Stats.Get(ctx)
+	require.NoError(t, err)
+	stats.TotalPrincipal = stats.TotalPrincipal.AddRaw(1)
+	require.NoError(t, k.Stats.Set(ctx, stats))
+	require.NoError(t, k.IncrementVaultTotalPrincipal(ctx, vaults.STAKED, math.NewInt(1)))
+	// ASSERT: All invariants are broken.
+	res, stop = keeper.AllInvariants(k)(ctx)
+	require.True(t, stop)
+	require.Contains(t, res, "total-principal")
+	require.Equal(t, map[string]bool{
+		"total-principal":  true,
+		"supply":           true,
+		"yield-reserve":    true,
+		"vaults-principal": true,
+	}, broken())
+
+	// ARRANGE: There are more accounts than the query is allowed to iterate over.
+	for i := 0; i < keeper.MaxQueryEntries; i++ {
+		require.NoError(t, k.Principal.Set(ctx, utils.TestAccount().Bytes, math.OneInt()))
+	}
+	// ACT: Query the invariants.
+	_, err = server.Invariants(ctx, &v2.QueryInvariants{})
+	// ASSERT: The query should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestYieldLiability(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	alice, bob, charlie := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0, and the index grows by 10%.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ACT: Query the yield liability.
+	res, err := queryServer.YieldLiability(ctx, &v2.QueryYieldLiability{})
+	// ASSERT: The yield of Alice and Bob is fully backed by the yield account.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(20*ONE), res.Liability)
+	require.Equal(t, math.NewInt(20*ONE), res.Reserve)
+	require.True(t, res.Surplus.IsZero())
+	require.True(t, res.Rounding.IsZero())
+	require.Equal(t, int64(2), res.Accounts)
+	require.True(t, res.Solvent)
+
+	// ARRANGE: Alice claims her yield.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+	// ARRANGE: Charlie is owed a single unit of yield.
+	require.NoError(t, k.Principal.Set(ctx, charlie.Bytes, math.NewInt(10*ONE)))
+	bank.Balances[charlie.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(11*ONE-1)))
+
+	// ACT: Query the yield liability.
+	res, err = queryServer.YieldLiability(ctx, &v2.QueryYieldLiability{})
+	// ASSERT: Only the yield of Bob is a liability, and that of Charlie is rounding.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), res.Liability)
+	require.Equal(t, math.NewInt(10*ONE), res.Reserve)
+	require.Equal(t, math.OneInt(), res.Rounding)
+	require.True(t, res.Excess.IsZero())
+	require.Equal(t, int64(3), res.Accounts)
+	require.True(t, res.Solvent)
+	// ASSERT: The yield reserve invariant reports the same liability and rounding.
+	msg, stop := keeper.YieldReserveInvariant(k)(ctx)
+	require.False(t, stop, msg)
+	require.Contains(t, msg, fmt.Sprintf("outstanding yield: %d\n\tunclaimable yield: 1\n", 10*ONE))
+
+	// ARRANGE: Charlie holds a balance in excess of the present amount of his principal.
+	bank.Balances[charlie.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(12*ONE)))
+
+	// ACT: Query the yield liability.
+	res, err = queryServer.YieldLiability(ctx, &v2.QueryYieldLiability{})
+	// ASSERT: The excess balance of Charlie is reported separately.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), res.Liability)
+	require.True(t, res.Rounding.IsZero())
+	require.Equal(t, math.NewInt(ONE), res.Excess)
+
+	// ARRANGE: Drain the yield account.
+	bank.Balances[types.YieldAddress.String()] = sdk.NewCoins()
+
+	// ACT: Query the yield liability.
+	res, err = queryServer.YieldLiability(ctx, &v2.QueryYieldLiability{})
+	// ASSERT: The yield account is insolvent.
+	require.NoError(t, err)
+	require.True(t, res.Reserve.IsZero())
+	require.Equal(t, math.NewInt(-10*ONE), res.Surplus)
+	require.False(t, res.Solvent)
+
+	// ACT: Attempt to query with a nil request.
+	_, err = queryServer.YieldLiability(ctx, nil)
+	// ASSERT: The query should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ARRANGE: There are more accounts than the query is allowed to iterate over.
+	for i := 0; i < keeper.MaxQueryEntries; i++ {
+		require.NoError(t, k.Principal.Set(ctx, utils.TestAccount().Bytes, math.OneInt()))
+	}
+	// ACT: Query the yield liability.
+	_, err = queryServer.YieldLiability(ctx, &v2.QueryYieldLiability{})
+	// ASSERT: The query should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
@@ -67,15 +67,44 @@ type Keeper struct {
 	warp     *warpkeeper.Keeper
 	wormhole portal.WormholeKeeper
 
-	Paused             collections.Item[bool]
+	Paused             collections.Item[int32]
 	Index              collections.Item[int64]
 	Principal          collections.Map[[]byte, math.Int]
 	Stats              collections.Item[v2.Stats]
 	TotalExternalYield collections.Map[collections.Pair[int32, string], math.Int]
 	YieldRecipients    collections.Map[collections.Pair[int32, string], string]
 	RetryAmounts       collections.Map[collections.Pair[int32, string], math.Int]
+	IndexHistory       collections.Map[collections.Triple[int64, int64, uint64], v2.IndexRecord]
+	YieldDistributions collections.Map[collections.Triple[int64, int64, uint64], v2.YieldDistribution]
+	EarnerRate         collections.Item[math.LegacyDec]
+
+	YieldClaimRecipients collections.Map[[]byte, []byte]
+	ClaimTip             collections.Item[uint32]
+	YieldSplits          collections.Map[[]byte, v2.YieldSplit]
+	AutoClaimAccounts    collections.KeySet[[]byte]
+	AutoClaimCursor      collections.Item[[]byte]
+	NonEarningAccounts   collections.KeySet[[]byte]
+	Treasury             collections.Item[[]byte]
+	MIndex               collections.Item[int64]
+	AttestedMIndex       collections.Item[int64]
+	FeeRate              collections.Item[uint32]
+	FeeCollector         collections.Item[[]byte]
+	FrozenAccounts       collections.KeySet[[]byte]
+	PauseInfos           collections.Map[collections.Pair[string, int32], v2.PauseInfo]
+	Roles                collections.KeySet[collections.Pair[[]byte, int32]]
+	TimelockDelay        collections.Item[int64]
+	TimelockNonce        collections.Sequence
+	TimelockedMessages   *collections.IndexedMap[uint64, v2.TimelockedMessage, TimelockedMessagesIndexes]
+	MaxYieldRate         collections.Item[math.LegacyDec]
+	PendingIndex         collections.Item[v2.PendingIndex]
+	IndexStaleness       collections.Item[v2.IndexStaleness]
+	IndexStale           collections.Item[bool]
+	IndexHistoryStart    collections.Item[int64]
+	HaircutMode          collections.Item[bool]
+	HaircutFactor        collections.Item[math.LegacyDec]
 
 	PortalOwner         collections.Item[string]
+	PortalPendingOwner  collections.Item[string]
 	PortalPaused        collections.Item[bool]
 	PortalPeers         collections.Map[uint16, portal.Peer]
 	PortalBridgingPaths collections.Map[collections.Pair[uint16, []byte], bool]
@@ -141,15 +170,44 @@ func NewKeeper(
 		warp:     warp,
 		wormhole: wormhole,
 
-		Paused:             collections.NewItem(builder, types.PausedKey, "paused", collections.BoolValue),
+		Paused:             collections.NewItem(builder, types.PausedKey, "paused", collections.Int32Value),
 		Index:              collections.NewItem(builder, types.IndexKey, "index", collections.Int64Value),
 		Principal:          collections.NewMap(builder, types.PrincipalPrefix, "principal", collections.BytesKey, sdk.IntValue),
 		Stats:              collections.NewItem(builder, types.StatsKey, "stats", codec.CollValue[v2.Stats](cdc)),
 		TotalExternalYield: collections.NewMap(builder, types.TotalExternalYieldPrefix, "total_external_yield", collections.PairKeyCodec(collections.Int32Key, collections.StringKey), sdk.IntValue),
 		YieldRecipients:    collections.NewMap(builder, types.YieldRecipientPrefix, "yield_recipients", collections.PairKeyCodec(collections.Int32Key, collections.StringKey), collections.StringValue),
 		RetryAmounts:       collections.NewMap(builder, types.RetryAmountPrefix, "retry_amounts", collections.PairKeyCodec(collections.Int32Key, collections.StringKey), sdk.IntValue),
+		IndexHistory:       collections.NewMap(builder, types.IndexHistoryPrefix, "index_history", collections.TripleKeyCodec(collections.Int64Key, collections.Int64Key, collections.Uint64Key), codec.CollValue[v2.IndexRecord](cdc)),
+		YieldDistributions: collections.NewMap(builder, types.YieldDistributionPrefix, "yield_distributions", collections.TripleKeyCodec(collections.Int64Key, collections.Int64Key, collections.Uint64Key), codec.CollValue[v2.YieldDistribution](cdc)),
+		EarnerRate:         collections.NewItem(builder, types.EarnerRateKey, "earner_rate", sdk.LegacyDecValue),
+
+		YieldClaimRecipients: collections.NewMap(builder, types.YieldClaimRecipientPrefix, "yield_claim_recipients", collections.BytesKey, collections.BytesValue),
+		ClaimTip:             collections.NewItem(builder, types.ClaimTipKey, "claim_tip", collections.Uint32Value),
+		YieldSplits:          collections.NewMap(builder, types.YieldSplitPrefix, "yield_splits", collections.BytesKey, codec.CollValue[v2.YieldSplit](cdc)),
+		AutoClaimAccounts:    collections.NewKeySet(builder, types.AutoClaimAccountPrefix, "auto_claim_accounts", collections.BytesKey),
+		AutoClaimCursor:      collections.NewItem(builder, types.AutoClaimCursorKey, "auto_claim_cursor", collections.BytesValue),
+		NonEarningAccounts:   collections.NewKeySet(builder, types.NonEarningAccountPrefix, "non_earning_accounts", collections.BytesKey),
+		Treasury:             collections.NewItem(builder, types.TreasuryKey, "treasury", collections.BytesValue),
+		MIndex:               collections.NewItem(builder, types.MIndexKey, "m_index", collections.Int64Value),
+		AttestedMIndex:       collections.NewItem(builder, types.AttestedMIndexKey, "attested_m_index", collections.Int64Value),
+		FeeRate:              collections.NewItem(builder, types.FeeRateKey, "fee_rate", collections.Uint32Value),
+		FeeCollector:         collections.NewItem(builder, types.FeeCollectorKey, "fee_collector", collections.BytesValue),
+		FrozenAccounts:       collections.NewKeySet(builder, types.FrozenAccountPrefix, "frozen_accounts", collections.BytesKey),
+		PauseInfos:           collections.NewMap(builder, types.PauseInfoPrefix, "pause_infos", collections.PairKeyCodec(collections.StringKey, collections.Int32Key), codec.CollValue[v2.PauseInfo](cdc)),
+		Roles:                collections.NewKeySet(builder, types.RolePrefix, "roles", collections.PairKeyCodec(collections.BytesKey, collections.Int32Key)),
+		TimelockDelay:        collections.NewItem(builder, types.TimelockDelayKey, "timelock_delay", collections.Int64Value),
+		TimelockNonce:        collections.NewSequence(builder, types.TimelockNonceKey, "timelock_nonce"),
+		TimelockedMessages:   collections.NewIndexedMap(builder, types.TimelockedMessagePrefix, "timelocked_messages", collections.Uint64Key, codec.CollValue[v2.TimelockedMessage](cdc), NewTimelockedMessagesIndexes(builder)),
+		MaxYieldRate:         collections.NewItem(builder, types.MaxYieldRateKey, "max_yield_rate", sdk.LegacyDecValue),
+		PendingIndex:         collections.NewItem(builder, types.PendingIndexKey, "pending_index", codec.CollValue[v2.PendingIndex](cdc)),
+		IndexStaleness:       collections.NewItem(builder, types.IndexStalenessKey, "index_staleness", codec.CollValue[v2.IndexStaleness](cdc)),
+		IndexStale:           collections.NewItem(builder, types.IndexStaleKey, "index_stale", collections.BoolValue),
+		IndexHistoryStart:    collections.NewItem(builder, types.IndexHistoryStartKey, "index_history_start", collections.Int64Value),
+		HaircutMode:          collections.NewItem(builder, types.HaircutModeKey, "haircut_mode", collections.BoolValue),
+		HaircutFactor:        collections.NewItem(builder, types.HaircutFactorKey, "haircut_factor", sdk.LegacyDecValue),
 
 		PortalOwner:         collections.NewItem(builder, portal.OwnerKey, "portal_owner", collections.StringValue),
+		PortalPendingOwner:  collections.NewItem(builder, portal.PendingOwnerKey, "portal_pending_owner", collections.StringValue),
 		PortalPaused:        collections.NewItem(builder, portal.PausedKey, "portal_paused", collections.BoolValue),
 		PortalPeers:         collections.NewMap(builder, portal.PeerPrefix, "portal_peers", collections.Uint16Key, codec.CollValue[portal.Peer](cdc)),
 		PortalBridgingPaths: collections.NewMap(builder, portal.BridgingPathPrefix, "portal_bridging_paths", collections.PairKeyCodec(collections.Uint16Key, collections.BytesKey), collections.BoolValue),
@@ -206,6 +264,22 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, sender, recipient sdk.Ac
 			}
 		}
 
+		// We don't want to allow any transfers from or to frozen accounts.
+		// NOTE: This is checked after yield payouts, so that the yield of
+		// frozen accounts can still be claimed by the authority.
+		if k.IsFrozen(ctx, sender) {
+			return recipient, sdkerrors.Wrapf(types.ErrFrozen, "sender %s is frozen", sender.String())
+		}
+		if k.IsFrozen(ctx, recipient) {
+			return recipient, sdkerrors.Wrapf(types.ErrFrozen, "recipient %s is frozen", recipient.String())
+		}
+
+		// We don't want to allow any transfers between accounts while transfers are paused.
+		// -> Issuance and withdrawal are still allowed.
+		if !sender.Equals(types.ModuleAddress) && !recipient.Equals(types.ModuleAddress) && k.IsPaused(ctx, v2.PausedType_TRANSFER) {
+			return recipient, sdkerrors.Wrapf(types.ErrPaused, "transfers of %s are paused", k.denom)
+		}
+
 		// When burning and transferring, the $M token executes the
 		// `_getPrincipalAmountRoundedUp` function. When minting, the $M token
 		// executes the `_getPrincipalAmountRoundedDown` function. As $USDN
@@ -228,21 +302,18 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, sender, recipient sdk.Ac
 					return recipient, sdkerrors.Wrap(err, "unable to get sender principal from state")
 				}
 			}
-			err = k.Principal.Set(ctx, sender, senderPrincipal.Sub(principal))
+			// NOTE: In haircut mode, once unclaimed yield was burned to absorb
+			// a shortfall, a balance can exceed the present amount of its
+			// principal. At most the sender's principal is transferred, so
+			// that the excess moves alongside the balance instead of creating
+			// principal.
+			if k.IsHaircutMode(ctx) {
+				principal = math.MinInt(principal, math.MaxInt(senderPrincipal, math.ZeroInt()))
+			}
+			err = k.SetPrincipal(ctx, sender, senderPrincipal, senderPrincipal.Sub(principal))
 			if err != nil {
 				return recipient, sdkerrors.Wrap(err, "unable to set sender principal to state")
 			}
-
-			balance := k.bank.GetBalance(ctx, sender, k.denom)
-			if balance.IsZero() {
-				// If the sender's $USDN balance is zero, this indicates that
-				// they are no longer a holder, and we should decrement the
-				// statistic.
-				err = k.DecrementTotalHolders(ctx)
-				if err != nil {
-					return recipient, sdkerrors.Wrap(err, "unable to decrement total holders")
-				}
-			}
 		} else {
 			err = k.IncrementTotalPrincipal(ctx, principal)
 			if err != nil {
@@ -254,7 +325,14 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, sender, recipient sdk.Ac
 		// -> Transfer from User to Module account.
 		if !recipient.Equals(types.ModuleAddress) {
 			if sender.Equals(types.ModuleAddress) {
-				principal = k.GetPrincipalAmountRoundedDown(amount, index)
+				roundedDown := k.GetPrincipalAmountRoundedDown(amount, index)
+				// The total principal stat was incremented by the rounded up
+				// principal, so we track the difference to keep it reconcilable.
+				err = k.IncrementTotalPrincipalRounding(ctx, principal.Sub(roundedDown))
+				if err != nil {
+					return recipient, sdkerrors.Wrap(err, "unable to increment total principal rounding")
+				}
+				principal = roundedDown
 			}
 
 			recipientPrincipal, err := k.Principal.Get(ctx, recipient)
@@ -265,21 +343,10 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, sender, recipient sdk.Ac
 					return recipient, sdkerrors.Wrap(err, "unable to get recipient principal from state")
 				}
 			}
-			err = k.Principal.Set(ctx, recipient, recipientPrincipal.Add(principal))
+			err = k.SetPrincipal(ctx, recipient, recipientPrincipal, recipientPrincipal.Add(principal))
 			if err != nil {
 				return recipient, sdkerrors.Wrap(err, "unable to set recipient principal to state")
 			}
-
-			balance := k.bank.GetBalance(ctx, recipient, k.denom)
-			if balance.IsZero() {
-				// If the recipient's $USDN balance is zero, this indicates
-				// that they are a new holder, and we should increment the
-				// statistic.
-				err = k.IncrementTotalHolders(ctx)
-				if err != nil {
-					return recipient, sdkerrors.Wrap(err, "unable to increment total holders")
-				}
-			}
 		} else {
 			err = k.DecrementTotalPrincipal(ctx, principal)
 			if err != nil {
@@ -303,8 +370,81 @@ func (k *Keeper) GetVaultsSeasonTwoYieldCollector(ctx context.Context) (sdk.AccA
 	return collector, err
 }
 
-// GetYield is a utility that returns the user's current amount of claimable $USDN yield.
+// GetCurrentIndex is a utility that returns the current index, continuously
+// extrapolated from the last attested index using the latest earner rate.
+func (k *Keeper) GetCurrentIndex(ctx context.Context) (int64, error) {
+	index, err := k.Index.Get(ctx)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "unable to get index from state")
+	}
+
+	rate := k.GetEarnerRate(ctx)
+	if !rate.IsPositive() {
+		return index, nil
+	}
+
+	record, err := k.GetLatestIndexRecord(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return index, nil
+		}
+
+		return 0, sdkerrors.Wrap(err, "unable to get latest index record from state")
+	}
+
+	elapsed := k.header.GetHeaderInfo(ctx).Time.Unix() - record.Time.Unix()
+	if elapsed <= 0 {
+		return index, nil
+	}
+
+	return k.GetContinuousIndex(index, rate, elapsed), nil
+}
+
+// GetYield is a utility that returns the user's current amount of accrued
+// $USDN yield, based on the continuously extrapolated current index. As yield
+// is only minted up until the last attested index, this is only used for
+// display, while claims are bounded by GetAttestedYield.
 func (k *Keeper) GetYield(ctx context.Context, account string) (math.Int, []byte, error) {
+	index, err := k.GetCurrentIndex(ctx)
+	if err != nil {
+		return math.ZeroInt(), nil, sdkerrors.Wrap(err, "unable to get current index")
+	}
+
+	yield, bz, err := k.getYield(ctx, account, index)
+	if err != nil {
+		return math.ZeroInt(), nil, err
+	}
+
+	// As the current index is extrapolated beyond the last attested index,
+	// yield is only minted up until the latter. We therefore bound the yield
+	// by what is currently available in the yield account.
+	available := k.bank.GetBalance(ctx, types.YieldAddress, k.denom).Amount
+
+	return math.MinInt(yield, available), bz, nil
+}
+
+// GetAttestedYield is a utility that returns the user's amount of claimable
+// $USDN yield, based on the last attested index.
+func (k *Keeper) GetAttestedYield(ctx context.Context, account string) (math.Int, []byte, error) {
+	index, err := k.Index.Get(ctx)
+	if err != nil {
+		return math.ZeroInt(), nil, sdkerrors.Wrap(err, "unable to get index from state")
+	}
+
+	yield, bz, err := k.getYield(ctx, account, index)
+	if err != nil {
+		return math.ZeroInt(), nil, err
+	}
+
+	// NOTE: The yield account can fall short of the attested yield once it has
+	// been burned to absorb a haircut, so we bound the yield by its balance.
+	available := k.bank.GetBalance(ctx, types.YieldAddress, k.denom).Amount
+
+	return math.MinInt(yield, available), bz, nil
+}
+
+// getYield returns the user's amount of claimable $USDN yield at a given index.
+func (k *Keeper) getYield(ctx context.Context, account string, index int64) (math.Int, []byte, error) {
 	bz, err := k.address.StringToBytes(account)
 	if err != nil {
 		return math.ZeroInt(), nil, sdkerrors.Wrapf(err, "unable to decode account %s", account)
@@ -319,11 +459,6 @@ func (k *Keeper) GetYield(ctx context.Context, account string) (math.Int, []byte
 		principal = math.ZeroInt()
 	}
 
-	index, err := k.Index.Get(ctx)
-	if err != nil {
-		return math.ZeroInt(), nil, sdkerrors.Wrap(err, "unable to get index from state")
-	}
-
 	currentBalance := k.bank.GetBalance(ctx, bz, k.denom).Amount
 	expectedBalance := k.GetPresentAmount(principal, index)
 
@@ -422,6 +557,10 @@ func (k *Keeper) HandlePayload(ctx context.Context, payload []byte, eventsPayloa
 			return fmt.Errorf("wrong destination token: expected %d, got %d", portal.RawToken, tokenPayload.DestinationToken)
 		}
 
+		if k.IsFrozen(ctx, tokenPayload.Recipient) {
+			return sdkerrors.Wrapf(types.ErrFrozen, "recipient %s is frozen", sdk.AccAddress(tokenPayload.Recipient).String())
+		}
+
 		if err := k.Mint(ctx, tokenPayload.Recipient, tokenPayload.Amount, &tokenPayload.Index); err != nil {
 			return err
 		}
@@ -457,3 +596,18 @@ func (k *Keeper) HandlePayload(ctx context.Context, payload []byte, eventsPayloa
 
 	return nil
 }
+
+// cacheContext is an internal helper function that branches both the state and
+// the events of a context. Neither are applied to the parent context until the
+// returned write function is called, so that discarding a failed execution
+// also discards any events it emitted.
+func cacheContext(ctx context.Context) (sdk.Context, func()) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cms := sdkCtx.MultiStore().CacheMultiStore()
+	cacheCtx := sdkCtx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+
+	return cacheCtx, func() {
+		sdkCtx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		cms.Write()
+	}
+}
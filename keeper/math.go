@@ -20,7 +20,34 @@
 
 package keeper
 
-import "cosmossdk.io/math"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// SecondsPerYear is the number of seconds in a 365-day year, as used by the
+// $M token, used when annualizing the growth of the index.
+const SecondsPerYear = 31_536_000
+
+// maxAnnualizedGrowth is the upper bound of the natural logarithm of the
+// annualized index growth, used to avoid overflows when compounding.
+var maxAnnualizedGrowth = math.LegacyNewDec(10)
+
+// maxIndexGrowth is the upper bound of the growth between two indexes, i.e.
+// the floor of e^10, used to reject out of bounds growth before computing its
+// natural logarithm.
+const maxIndexGrowth = 22_026
+
+var (
+	// sqrt2 and halfSqrt2 bound the range that the argument of ln is reduced
+	// into, for which its series converges quickly.
+	sqrt2     = math.LegacyMustNewDecFromStr("1.414213562373095049")
+	halfSqrt2 = math.LegacyMustNewDecFromStr("0.707106781186547524")
+	// ln2 is the natural logarithm of two, used when reducing the argument of ln.
+	ln2 = lnSeries(math.LegacyNewDec(2))
+)
 
 // GetPrincipalAmountRoundedUp returns the rounded up principal given a present amount.
 //
@@ -42,3 +69,116 @@ func (k *Keeper) GetPrincipalAmountRoundedDown(presentAmount math.Int, index int
 func (k *Keeper) GetPresentAmount(principalAmount math.Int, index int64) (presentAmount math.Int) {
 	return principalAmount.MulRaw(index).QuoRaw(1e12)
 }
+
+// GetNetIndex returns the new index of $USDN given the index growth of $M, net
+// of the provided fee rate in basis points, rounded down.
+func (k *Keeper) GetNetIndex(index int64, oldMIndex int64, newMIndex int64, fee uint32) int64 {
+	grossIndex := math.NewInt(index).MulRaw(newMIndex).QuoRaw(oldMIndex)
+	growth := grossIndex.SubRaw(index).MulRaw(int64(v2.MaxFee - fee)).QuoRaw(v2.MaxFee)
+
+	return growth.AddRaw(index).Int64()
+}
+
+// GetYieldRate returns the realized yield between two indexes that are the
+// provided amount of seconds apart, annualized both without compounding (APR)
+// and with continuous compounding (APY).
+func (k *Keeper) GetYieldRate(startIndex int64, endIndex int64, elapsed int64) (apr math.LegacyDec, apy math.LegacyDec, err error) {
+	if startIndex <= 0 || endIndex <= 0 || elapsed <= 0 {
+		return math.LegacyZeroDec(), math.LegacyZeroDec(), fmt.Errorf("invalid yield rate inputs (start index: %d, end index: %d, elapsed: %d)", startIndex, endIndex, elapsed)
+	}
+
+	if math.NewInt(endIndex).GT(math.NewInt(startIndex).MulRaw(maxIndexGrowth)) ||
+		math.NewInt(startIndex).GT(math.NewInt(endIndex).MulRaw(maxIndexGrowth)) {
+		return math.LegacyZeroDec(), math.LegacyZeroDec(), fmt.Errorf("growth from %d to %d is out of bounds", startIndex, endIndex)
+	}
+
+	growth := math.LegacyNewDec(endIndex).QuoInt64(startIndex)
+	apr = growth.Sub(math.LegacyOneDec()).MulInt64(SecondsPerYear).QuoInt64(elapsed)
+
+	exponent := ln(growth).MulInt64(SecondsPerYear).QuoInt64(elapsed)
+	if exponent.Abs().GT(maxAnnualizedGrowth) {
+		return math.LegacyZeroDec(), math.LegacyZeroDec(), fmt.Errorf("annualized growth of %s is out of bounds", growth)
+	}
+	apy = exp(exponent).Sub(math.LegacyOneDec())
+
+	return apr, apy, nil
+}
+
+// GetImpliedEarnerRate returns the continuously compounded annual rate implied by
+// the growth between two indexes that are the provided amount of seconds apart.
+// Growth that is out of bounds implies no rate, so that the index isn't extrapolated.
+func (k *Keeper) GetImpliedEarnerRate(startIndex int64, endIndex int64, elapsed int64) math.LegacyDec {
+	if startIndex <= 0 || endIndex <= startIndex || elapsed <= 0 {
+		return math.LegacyZeroDec()
+	}
+	if math.NewInt(endIndex).GT(math.NewInt(startIndex).MulRaw(maxIndexGrowth)) {
+		return math.LegacyZeroDec()
+	}
+
+	growth := math.LegacyNewDec(endIndex).QuoInt64(startIndex)
+	rate := ln(growth).MulInt64(SecondsPerYear).QuoInt64(elapsed)
+	if rate.GT(maxAnnualizedGrowth) {
+		return math.LegacyZeroDec()
+	}
+
+	return rate
+}
+
+// GetContinuousIndex returns the index continuously compounded at the provided
+// annual rate for the provided amount of seconds, rounded down.
+//
+// https://github.com/m0-foundation/protocol/blob/b1c6e624ed09a9e28f4ae45cd87fda610fafe446/src/abstract/ContinuousIndexing.sol
+func (k *Keeper) GetContinuousIndex(index int64, rate math.LegacyDec, elapsed int64) int64 {
+	exponent := rate.MulInt64(elapsed).QuoInt64(SecondsPerYear)
+	if !exponent.IsPositive() || exponent.GT(maxAnnualizedGrowth) {
+		return index
+	}
+
+	return exp(exponent).MulInt64(index).TruncateInt64()
+}
+
+// ln returns the natural logarithm of a positive decimal. The argument is
+// first reduced into [√2/2, √2] by factoring out powers of two, as the series
+// used only converges quickly for values close to one.
+func ln(x math.LegacyDec) math.LegacyDec {
+	k := int64(0)
+	for x.GT(sqrt2) {
+		x = x.QuoInt64(2)
+		k++
+	}
+	for x.IsPositive() && x.LT(halfSqrt2) {
+		x = x.MulInt64(2)
+		k--
+	}
+
+	return lnSeries(x).Add(ln2.MulInt64(k))
+}
+
+// lnSeries returns the natural logarithm of a positive decimal, computed
+// using the series ln(x) = 2 * artanh((x - 1) / (x + 1)).
+func lnSeries(x math.LegacyDec) math.LegacyDec {
+	one := math.LegacyOneDec()
+	y := x.Sub(one).Quo(x.Add(one))
+	y2 := y.Mul(y)
+
+	sum := math.LegacyZeroDec()
+	term := y
+	for n := int64(1); !term.IsZero(); n += 2 {
+		sum = sum.Add(term.QuoInt64(n))
+		term = term.Mul(y2)
+	}
+
+	return sum.MulInt64(2)
+}
+
+// exp returns the exponential of a decimal, computed using its Taylor series.
+func exp(x math.LegacyDec) math.LegacyDec {
+	sum := math.LegacyOneDec()
+	term := math.LegacyOneDec()
+	for n := int64(1); !term.IsZero(); n++ {
+		term = term.Mul(x).QuoInt64(n)
+		sum = sum.Add(term)
+	}
+
+	return sum
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestGetYieldRate(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, _ := mocks.DollarKeeperWithKeepers(t, bank, account)
+	year := int64(keeper.SecondsPerYear)
+
+	tc := []struct {
+		name        string
+		startIndex  int64
+		endIndex    int64
+		elapsed     int64
+		expectedAPY float64
+	}{
+		{name: "small growth", startIndex: 1e12, endIndex: 1.05e12, elapsed: year, expectedAPY: 0.05},
+		{name: "six-fold growth", startIndex: 1e12, endIndex: 6e12, elapsed: 10 * year, expectedAPY: 0.196231},
+		{name: "eight-fold growth", startIndex: 1e12, endIndex: 8e12, elapsed: 3 * year, expectedAPY: 1},
+		{name: "hundred-fold growth", startIndex: 1e12, endIndex: 100e12, elapsed: 10 * year, expectedAPY: 0.584893},
+		{name: "ten-fold decrease", startIndex: 1e12, endIndex: 0.1e12, elapsed: 10 * year, expectedAPY: -0.205672},
+		{name: "thousand-fold decrease", startIndex: 1e12, endIndex: 1e9, elapsed: 10 * year, expectedAPY: -0.498813},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT: Compute the yield rate between both indexes.
+			_, apy, err := k.GetYieldRate(tt.startIndex, tt.endIndex, tt.elapsed)
+			// ASSERT: The yield rate is compounded continuously.
+			require.NoError(t, err)
+			require.InDelta(t, tt.expectedAPY, apy.MustFloat64(), 1e-6)
+		})
+	}
+
+	// ACT: Compute the yield rate of an index that grows beyond the bounds.
+	_, _, err := k.GetYieldRate(1e12, 1e17, 100*year)
+	// ASSERT: The computation should've failed.
+	require.Error(t, err)
+
+	// ACT: Compute the yield rate of an index that decreases beyond the bounds.
+	_, _, err = k.GetYieldRate(1e17, 1e12, 100*year)
+	// ASSERT: The computation should've failed.
+	require.Error(t, err)
+
+	// ACT: Compute the yield rate of an index that grows six-fold within a day.
+	_, _, err = k.GetYieldRate(1e12, 6e12, 86_400)
+	// ASSERT: The computation should've failed, as its annualized growth is out of bounds.
+	require.Error(t, err)
+}
+
+func TestGetImpliedEarnerRate(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, _ := mocks.DollarKeeperWithKeepers(t, bank, account)
+	year := int64(keeper.SecondsPerYear)
+
+	// ACT: Compute the earner rate of an index that grows eight-fold over three years.
+	rate := k.GetImpliedEarnerRate(1e12, 8e12, 3*year)
+	// ASSERT: The rate is ln(2).
+	require.InDelta(t, 0.693147, rate.MustFloat64(), 1e-6)
+
+	// ACT: Compute the earner rate of an index that grows six-fold within a day.
+	rate = k.GetImpliedEarnerRate(1e12, 6e12, 86_400)
+	// ASSERT: No rate is implied, as its annualized growth is out of bounds.
+	require.True(t, rate.IsZero())
+	require.Equal(t, int64(6e12), k.GetContinuousIndex(6e12, rate, 86_400))
+
+	// ACT: Compute the earner rate of an index that grows beyond the bounds.
+	rate = k.GetImpliedEarnerRate(1e12, 1e17, 100*year)
+	// ASSERT: No rate is implied.
+	require.True(t, rate.IsZero())
+}
@@ -32,8 +32,9 @@ import (
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper  *Keeper
-	v1Stats collections.Item[types.Stats]
+	keeper   *Keeper
+	v1Stats  collections.Item[types.Stats]
+	v2Paused collections.Item[[]byte]
 }
 
 // NewMigrator returns a new Migrator.
@@ -41,8 +42,9 @@ func NewMigrator(keeper *Keeper) Migrator {
 	builder := collections.NewSchemaBuilder(keeper.store)
 
 	return Migrator{
-		keeper:  keeper,
-		v1Stats: collections.NewItem(builder, types.StatsKey, "stats", codec.CollValue[types.Stats](keeper.cdc)),
+		keeper:   keeper,
+		v1Stats:  collections.NewItem(builder, types.StatsKey, "stats", codec.CollValue[types.Stats](keeper.cdc)),
+		v2Paused: collections.NewItem(builder, types.PausedKey, "paused", collections.BytesValue),
 	}
 }
 
@@ -66,3 +68,46 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// NOTE: Previously, the total holders stat was updated based on balances
+	// that were read before the underlying transfer was executed, meaning
+	// that it was never decremented. We recompute all stats from state.
+	err := m.keeper.reconcileStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to reconcile noble dollar stats")
+	}
+
+	// NOTE: Previously, the paused state was a boolean that only paused yield
+	// claims. We migrate it to the equivalent bitmask of paused actions. As
+	// booleans are encoded as a single byte, any other value is left as is.
+	v2Paused, err := m.v2Paused.Get(ctx)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return errors.Wrap(err, "failed to get noble dollar v2 paused state")
+	}
+	if len(v2Paused) <= 1 {
+		paused := v2.PausedType_NONE
+		if len(v2Paused) == 1 && v2Paused[0] != 0 {
+			paused = v2.PausedType_CLAIM
+		}
+
+		err = m.keeper.Paused.Set(ctx, int32(paused))
+		if err != nil {
+			return errors.Wrap(err, "failed to set noble dollar paused type")
+		}
+	}
+
+	// NOTE: Previously, index updates weren't recorded, so the time of the
+	// last index update is unknown. We record the start of the index history,
+	// so that the staleness of the index is measured from the upgrade until
+	// the first index update.
+	if _, err = m.keeper.GetLatestIndexRecord(ctx); errors.IsOf(err, collections.ErrNotFound) {
+		err = m.keeper.IndexHistoryStart.Set(ctx, m.keeper.header.GetHeaderInfo(ctx).Time.Unix())
+		if err != nil {
+			return errors.Wrap(err, "failed to set noble dollar index history start")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
+	"dollar.noble.xyz/v2/utils"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	alice, bob, charlie := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Set the principal of three accounts, one of which is no longer a holder.
+	require.NoError(t, k.Principal.Set(ctx, alice.Bytes, math.NewInt(ONE)))
+	require.NoError(t, k.Principal.Set(ctx, bob.Bytes, math.NewInt(ONE)))
+	require.NoError(t, k.Principal.Set(ctx, charlie.Bytes, math.ZeroInt()))
+
+	// ARRANGE: Set the positions of two vault users, one of which has two positions.
+	require.NoError(t, k.VaultsPositions.Set(ctx, collections.Join3(alice.Bytes, int32(vaults.STAKED), int64(1)), vaults.Position{
+		Principal: math.NewInt(ONE),
+		Amount:    math.NewInt(ONE),
+	}))
+	require.NoError(t, k.VaultsPositions.Set(ctx, collections.Join3(alice.Bytes, int32(vaults.STAKED), int64(2)), vaults.Position{
+		Principal: math.NewInt(ONE),
+		Amount:    math.NewInt(ONE),
+	}))
+	require.NoError(t, k.VaultsPositions.Set(ctx, collections.Join3(bob.Bytes, int32(vaults.FLEXIBLE), int64(1)), vaults.Position{
+		Principal: math.NewInt(3 * ONE),
+		Amount:    math.NewInt(3 * ONE),
+	}))
+
+	// ARRANGE: Set drifted stats.
+	require.NoError(t, k.Stats.Set(ctx, v2.Stats{
+		TotalHolders:      10,
+		TotalPrincipal:    math.NewInt(5 * ONE),
+		TotalYieldAccrued: math.NewInt(ONE),
+	}))
+	require.NoError(t, k.VaultsStats.Set(ctx, vaults.Stats{
+		FlexibleTotalPrincipal:                   math.NewInt(ONE),
+		FlexibleTotalUsers:                       5,
+		FlexibleTotalDistributedRewardsPrincipal: math.NewInt(ONE),
+		StakedTotalPrincipal:                     math.ZeroInt(),
+		StakedTotalUsers:                         0,
+	}))
+
+	// ARRANGE: Pause index updates.
+	require.NoError(t, k.Paused.Set(ctx, int32(v2.PausedType_INDEX)))
+
+	// ARRANGE: Configure the index as stale after a day, without any index records.
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.IndexStaleness.Set(ctx, v2.IndexStaleness{Threshold: 24 * time.Hour}))
+
+	// ACT: Migrate from version 2 to 3.
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	// ASSERT: The stats were recomputed from the principal.
+	stats, err := k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TotalHolders)
+	require.Equal(t, math.NewInt(2*ONE), stats.TotalPrincipal)
+	require.Equal(t, math.NewInt(ONE), stats.TotalYieldAccrued)
+
+	// ASSERT: The vaults stats were recomputed from the positions.
+	vaultsStats, err := k.GetVaultsStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), vaultsStats.StakedTotalUsers)
+	require.Equal(t, math.NewInt(2*ONE), vaultsStats.StakedTotalPrincipal)
+	require.Equal(t, uint64(1), vaultsStats.FlexibleTotalUsers)
+	require.Equal(t, math.NewInt(3*ONE), vaultsStats.FlexibleTotalPrincipal)
+	require.Equal(t, math.NewInt(ONE), vaultsStats.FlexibleTotalDistributedRewardsPrincipal)
+
+	// ASSERT: The already migrated paused type was left as is.
+	require.Equal(t, v2.PausedType_INDEX, k.GetPaused(ctx))
+
+	// ASSERT: The staleness of the index is measured from the upgrade.
+	lastUpdate, found := k.GetLastIndexUpdate(ctx)
+	require.True(t, found)
+	require.Equal(t, start, lastUpdate)
+	require.False(t, k.IsIndexStale(ctx))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(25 * time.Hour)})
+	require.True(t, k.IsIndexStale(ctx))
+}
@@ -21,6 +21,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"sort"
 
@@ -50,20 +51,15 @@ func NewMsgServer(keeper *Keeper) types.MsgServer {
 }
 
 func (k msgServer) ClaimYield(ctx context.Context, msg *types.MsgClaimYield) (*types.MsgClaimYieldResponse, error) {
-	if k.GetPaused(ctx) {
+	if k.IsPaused(ctx, v2.PausedType_CLAIM) {
 		return nil, types.ErrPaused
 	}
 
-	yield, account, err := k.GetYield(ctx, msg.Signer)
+	yield, _, err := k.claimYield(ctx, msg.Signer, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.YieldName, account, sdk.NewCoins(sdk.NewCoin(k.denom, yield)))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to distribute yield to user")
-	}
-
 	return &types.MsgClaimYieldResponse{}, k.event.EventManager(ctx).Emit(ctx, &types.YieldClaimed{
 		Account: msg.Signer,
 		Amount:  yield,
@@ -72,11 +68,21 @@ func (k msgServer) ClaimYield(ctx context.Context, msg *types.MsgClaimYield) (*t
 
 func (k msgServer) SetPausedState(ctx context.Context, msg *types.MsgSetPausedState) (*types.MsgSetPausedStateResponse, error) {
 	// Ensure that the signer has the required authority.
-	if msg.Signer != k.authority {
-		return nil, errors.Wrapf(vaults.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	if !k.IsAuthorized(ctx, msg.Signer, v2.Role_PAUSER) {
+		return nil, errors.Wrapf(vaults.ErrInvalidAuthority, "expected %s or a %s, got %s", k.authority, v2.Role_PAUSER, msg.Signer)
 	}
 
-	if err := k.Paused.Set(ctx, msg.Paused); err != nil {
+	// NOTE: For backwards compatibility, this only configures whether yield
+	// claims are paused, leaving all other paused actions untouched.
+	oldPaused := k.GetPaused(ctx)
+	paused := oldPaused &^ v2.PausedType_CLAIM
+	if msg.Paused {
+		paused |= v2.PausedType_CLAIM
+	}
+	if err := k.setPauseInfo(ctx, types.ModuleName, int32(oldPaused), int32(paused), msg.Expiry, msg.Reason); err != nil {
+		return nil, err
+	}
+	if err := k.Paused.Set(ctx, int32(paused)); err != nil {
 		return nil, err
 	}
 
@@ -103,10 +109,27 @@ func (k *Keeper) Burn(ctx context.Context, sender []byte, amount math.Int) error
 }
 
 func (k *Keeper) Mint(ctx context.Context, recipient []byte, amount math.Int, index *int64) error {
+	// NOTE: An index update that can't be applied doesn't fail the transfer,
+	// but is reported. An index that isn't above the current index is
+	// expected, as transfers can be delivered out of order.
 	if index != nil {
-		_ = k.UpdateIndex(ctx, *index)
+		err := k.UpdateIndex(ctx, *index)
+		if err != nil && !errors.IsOf(err, types.ErrDecreasingIndex) {
+			err = k.event.EventManager(ctx).Emit(ctx, &v2.IndexUpdateSkipped{
+				MIndex: *index,
+				Reason: err.Error(),
+			})
+			if err != nil {
+				return err
+			}
+		}
 	}
 
+	// NOTE: While a haircut is applied, the amount of $M is minted as the
+	// larger amount of $USDN that it backs, so that the haircut factor is
+	// unaffected by new issuance.
+	amount = k.GetNominalAmount(ctx, amount)
+
 	coins := sdk.NewCoins(sdk.NewCoin(k.denom, amount))
 	err := k.bank.MintCoins(ctx, types.ModuleName, coins)
 	if err != nil {
@@ -120,13 +143,98 @@ func (k *Keeper) Mint(ctx context.Context, recipient []byte, amount math.Int, in
 	return nil
 }
 
-func (k *Keeper) UpdateIndex(ctx context.Context, index int64) error {
+// UpdateIndex updates the index of $USDN given the latest index of $M. If a
+// fee is configured, the index of $USDN only grows by the index growth of $M
+// net of the fee, so that all yield derived from it is net of the fee.
+func (k *Keeper) UpdateIndex(ctx context.Context, mIndex int64) error {
+	if k.IsPaused(ctx, v2.PausedType_INDEX) {
+		return errors.Wrap(types.ErrPaused, "index updates are paused")
+	}
+
+	oldMIndex, err := k.GetMIndex(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get m index from state")
+	}
+	// NOTE: A decreasing index is only accepted in haircut mode, in which case
+	// the loss is socialized across all holders.
+	if mIndex == oldMIndex || (mIndex < oldMIndex && !k.IsHaircutMode(ctx)) {
+		return types.ErrDecreasingIndex
+	}
+
+	// Quarantine the index, instead of minting yield, if the implied growth
+	// exceeds the maximum yield rate configured by the authority.
+	if quarantined, err := k.quarantineIndex(ctx, oldMIndex, mIndex); quarantined || err != nil {
+		return err
+	}
+
+	err = k.applyIndex(ctx, oldMIndex, mIndex)
+	if err != nil {
+		return err
+	}
+
+	return k.setAttestedMIndex(ctx, mIndex)
+}
+
+// setAttestedMIndex is an internal helper function that records the last
+// index of $M attested by the portal, which bounds emergency index updates.
+func (k *Keeper) setAttestedMIndex(ctx context.Context, mIndex int64) error {
+	err := k.AttestedMIndex.Set(ctx, mIndex)
+	if err != nil {
+		return errors.Wrap(err, "unable to set attested m index in state")
+	}
+
+	return nil
+}
+
+// applyIndex is an internal helper function that moves the $M index from the
+// provided old to new value, updating the index of $USDN accordingly and
+// reconciling the supply by minting any newly accrued yield.
+func (k *Keeper) applyIndex(ctx context.Context, oldMIndex int64, mIndex int64) error {
+	// NOTE: Rewards of the vaults during Season One are recorded per index, so
+	// a decreasing index isn't supported until it has ended.
+	if mIndex < oldMIndex && !k.IsVaultsSeasonOneEnded(ctx) {
+		return errors.Wrap(types.ErrInvalidIndex, "decreasing index is unsupported during vaults season one")
+	}
+
 	oldIndex, err := k.Index.Get(ctx)
 	if err != nil {
 		return errors.Wrap(err, "unable to get index from state")
 	}
-	if index <= oldIndex {
-		return types.ErrDecreasingIndex
+
+	// NOTE: While the backing of $USDN has a shortfall, e.g. after the index
+	// of $M decreased, the index of $USDN is only lowered by the unclaimed
+	// yield burned to absorb it, and the remaining shortfall is tracked in the
+	// haircut factor. The index of $USDN only grows again once the index of $M
+	// has recovered the remaining shortfall.
+	backedIndex := k.getBackedIndex(ctx, oldIndex, oldMIndex, mIndex)
+	grossIndex, err := k.applyHaircut(ctx, oldIndex, backedIndex)
+	if err != nil {
+		return err
+	}
+	if backedIndex.GT(math.LegacyNewDec(oldIndex)) {
+		grossIndex = backedIndex.TruncateInt64()
+	}
+	fee := k.GetFeeRate(ctx)
+	index := grossIndex
+	if grossIndex > oldIndex {
+		index = k.GetNetIndex(oldIndex, oldIndex, grossIndex, fee)
+	}
+
+	err = k.MIndex.Set(ctx, mIndex)
+	if err != nil {
+		return errors.Wrap(err, "unable to set m index in state")
+	}
+
+	// Derive the latest earner rate from the growth since the last index
+	// update, used for continuously extrapolating the index until the next.
+	if record, err := k.GetLatestIndexRecord(ctx); err == nil {
+		elapsed := k.header.GetHeaderInfo(ctx).Time.Unix() - record.Time.Unix()
+		if elapsed > 0 {
+			err = k.EarnerRate.Set(ctx, k.GetImpliedEarnerRate(record.Index, index, elapsed))
+			if err != nil {
+				return errors.Wrap(err, "unable to set earner rate in state")
+			}
+		}
 	}
 
 	err = k.Index.Set(ctx, index)
@@ -134,15 +242,66 @@ func (k *Keeper) UpdateIndex(ctx context.Context, index int64) error {
 		return errors.Wrap(err, "unable to set index in state")
 	}
 
+	err = k.SetIndexRecord(ctx, index)
+	if err != nil {
+		return errors.Wrap(err, "unable to set index record in state")
+	}
+
+	err = k.refreshIndexStaleness(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Start a new pass over the auto claim accounts, processed in batches at
+	// the beginning of the following blocks. A pass that is still in progress
+	// is continued instead, as accounts it has yet to process are claimed at
+	// the latest index anyway. Otherwise, frequent index updates would keep
+	// restarting the pass, and later accounts would never be claimed.
+	if inProgress, _ := k.AutoClaimCursor.Has(ctx); !inProgress {
+		err = k.AutoClaimCursor.Set(ctx, []byte{})
+		if err != nil {
+			return errors.Wrap(err, "unable to set auto claim cursor in state")
+		}
+	}
+
 	totalPrincipal, err := k.GetTotalPrincipal(ctx)
 	if err != nil {
 		return errors.Wrap(err, "unable to get total principal from state")
 	}
 
 	currentSupply := k.bank.GetSupply(ctx, k.denom).Amount
-	expectedSupply := k.GetPresentAmount(totalPrincipal, index)
+	expectedSupply := k.GetPresentAmount(totalPrincipal, grossIndex)
+	accrued := expectedSupply.Sub(currentSupply)
+
+	// Record how the newly accrued yield is distributed, so that it can be
+	// reconciled without relying on emitted events.
+	distribution := v2.YieldDistribution{
+		Index:                index,
+		Height:               k.header.GetHeaderInfo(ctx).Height,
+		Time:                 k.header.GetHeaderInfo(ctx).Time,
+		TotalYield:           math.ZeroInt(),
+		Fees:                 math.ZeroInt(),
+		Treasury:             math.ZeroInt(),
+		FlexibleVault:        math.ZeroInt(),
+		StakedVaultCollector: math.ZeroInt(),
+		UserPool:             math.ZeroInt(),
+	}
+
+	// Take the fee out of the newly accrued yield, which is the difference
+	// between the gross and net present amount of the total principal.
+	if fee > 0 && accrued.IsPositive() {
+		amount := math.MinInt(expectedSupply.Sub(k.GetPresentAmount(totalPrincipal, index)), accrued)
+		err = k.collectFee(ctx, amount)
+		if err != nil {
+			return err
+		}
+		accrued = accrued.Sub(amount)
+		distribution.Fees = amount
+	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.denom, expectedSupply.Sub(currentSupply)))
+	// NOTE: The expected supply can be below the current supply if the index
+	// was corrected downwards, in which case there is no yield to mint.
+	coins := sdk.NewCoins(sdk.NewCoin(k.denom, math.MaxInt(accrued, math.ZeroInt())))
 	yield := math.ZeroInt()
 	if coins.IsAllPositive() {
 		err = k.bank.MintCoins(ctx, types.ModuleName, coins)
@@ -154,34 +313,63 @@ func (k *Keeper) UpdateIndex(ctx context.Context, index int64) error {
 			return errors.Wrap(err, "unable to send coins")
 		}
 		yield = coins.AmountOf(k.denom)
+		distribution.TotalYield = yield
 		err = k.IncrementTotalYieldAccrued(ctx, yield)
 		if err != nil {
 			return errors.Wrap(err, "unable to increment total yield accrued")
 		}
 	}
 
+	// NOTE: While transfers are paused, any yield handling that requires a
+	// transfer between accounts is skipped, and the yield keeps accruing
+	// until the next index update.
+	transfersPaused := k.IsPaused(ctx, v2.PausedType_TRANSFER)
+
+	// Sweep the yield of non-earning accounts to the treasury. This is done
+	// first, so that it takes precedence over any other yield handling.
+	if !transfersPaused {
+		err = k.sweepNonEarningYield(ctx, &distribution)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Handle the vaults yield logic for Season One only if it has not ended.
 	if !k.IsVaultsSeasonOneEnded(ctx) {
-		if err := k.handleVaultsYieldSeasonOne(ctx, index); err != nil {
+		if err := k.handleVaultsYieldSeasonOne(ctx, index, &distribution); err != nil {
 			return err
 		}
-	} else {
+	} else if !transfersPaused {
 		// Handle the vaults yield logic for Season Two.
-		if err := k.handleVaultsYieldSeasonTwo(ctx); err != nil {
+		if err := k.handleVaultsYieldSeasonTwo(ctx, &distribution); err != nil {
 			return err
 		}
 	}
 
-	// Claim and transfer the yield of ibc external chains.
-	err = k.claimExternalYieldIBC(ctx)
-	if err != nil {
-		return err
+	if !k.IsPaused(ctx, v2.PausedType_EXTERNAL_YIELD) {
+		// Claim and transfer the yield of ibc external chains.
+		err = k.claimExternalYieldIBC(ctx, &distribution)
+		if err != nil {
+			return err
+		}
+
+		// Claim and transfer the yield of hyperlane external chains.
+		err = k.claimExternalYieldHyperlane(ctx, &distribution)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Claim and transfer the yield of hyperlane external chains.
-	err = k.claimExternalYieldHyperlane(ctx)
+	// The remaining yield stays in the yield account, claimable by holders.
+	distributed := distribution.Treasury.Add(distribution.FlexibleVault).Add(distribution.StakedVaultCollector)
+	for _, external := range distribution.ExternalYield {
+		distributed = distributed.Add(external.Amount)
+	}
+	distribution.UserPool = math.MaxInt(yield.Sub(distributed), math.ZeroInt())
+
+	err = k.SetYieldDistribution(ctx, distribution)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "unable to set yield distribution in state")
 	}
 
 	return k.event.EventManager(ctx).Emit(ctx, &types.IndexUpdated{
@@ -192,9 +380,246 @@ func (k *Keeper) UpdateIndex(ctx context.Context, index int64) error {
 	})
 }
 
+// collectFee is an internal helper function that mints a fee taken from newly
+// accrued yield to the fee collector, if one is set.
+func (k *Keeper) collectFee(ctx context.Context, amount math.Int) error {
+	collector, err := k.FeeCollector.Get(ctx)
+	if err != nil || !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.denom, amount))
+	err = k.bank.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return errors.Wrap(err, "unable to mint fee")
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, collector, coins)
+	if err != nil {
+		return errors.Wrap(err, "unable to send fee to collector")
+	}
+
+	err = k.IncrementTotalFeesAccrued(ctx, amount)
+	if err != nil {
+		return errors.Wrap(err, "unable to increment total fees accrued")
+	}
+
+	collectorAddress, _ := k.address.BytesToString(collector)
+	return k.event.EventManager(ctx).Emit(ctx, &v2.FeeCollected{
+		Collector: collectorAddress,
+		Amount:    amount,
+	})
+}
+
+// sweepNonEarningYield is an internal helper function that sweeps the yield
+// of all non-earning accounts to the treasury, if one is set. The yield is
+// first claimed to the account, and then transferred alongside its underlying
+// principal, so that the account doesn't accrue any further yield on it.
+func (k *Keeper) sweepNonEarningYield(ctx context.Context, distribution *v2.YieldDistribution) error {
+	treasury, err := k.Treasury.Get(ctx)
+	if err != nil {
+		return nil
+	}
+	treasuryAddress, _ := k.address.BytesToString(treasury)
+
+	var accounts [][]byte
+	err = k.NonEarningAccounts.Walk(ctx, nil, func(account []byte) (stop bool, err error) {
+		accounts = append(accounts, account)
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get non-earning accounts from state")
+	}
+
+	for _, account := range accounts {
+		// NOTE: The yield of frozen accounts keeps accruing until unfrozen.
+		if k.IsFrozen(ctx, account) {
+			continue
+		}
+
+		address, err := k.address.BytesToString(account)
+		if err != nil {
+			return errors.Wrap(err, "unable to encode non-earning account")
+		}
+
+		yield, _, err := k.GetAttestedYield(ctx, address)
+		if err != nil {
+			return err
+		}
+		if !yield.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(k.denom, yield))
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.YieldName, account, coins)
+		if err != nil {
+			return errors.Wrapf(err, "unable to claim yield of non-earning account %s", address)
+		}
+		err = k.bank.SendCoins(ctx, account, treasury, coins)
+		if err != nil {
+			return errors.Wrapf(err, "unable to sweep yield of non-earning account %s", address)
+		}
+		distribution.Treasury = distribution.Treasury.Add(yield)
+
+		err = k.event.EventManager(ctx).Emit(ctx, &v2.YieldSwept{
+			Account:  address,
+			Treasury: treasuryAddress,
+			Amount:   yield,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isModuleYieldAccount is an internal helper function that returns whether
+// the yield of an account is claimed by the module itself on index updates.
+// This includes module accounts, such as the vaults and Hyperlane collateral,
+// and the escrow accounts of IBC channels with a yield recipient.
+func (k *Keeper) isModuleYieldAccount(ctx context.Context, account []byte) bool {
+	if bytes.Equal(account, vaults.FlexibleVaultAddress) || bytes.Equal(account, vaults.StakedVaultAddress) ||
+		bytes.Equal(account, authtypes.NewModuleAddress(warptypes.ModuleName)) {
+		return true
+	}
+	if _, ok := k.account.GetAccount(ctx, account).(sdk.ModuleAccountI); ok {
+		return true
+	}
+
+	yieldRecipients, _ := k.GetYieldRecipientsByProvider(ctx, v2.Provider_IBC)
+	for channelId := range yieldRecipients {
+		if bytes.Equal(account, transfertypes.GetEscrowAddress(transfertypes.PortID, channelId)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// claimYieldFor is an internal helper function that claims the yield of an
+// account on behalf of a claimer. The claim is executed in a cached context,
+// so that a failed claim doesn't partially apply or emit any events.
+func (k *Keeper) claimYieldFor(ctx context.Context, account string, claimer []byte) (yield math.Int, tip math.Int, err error) {
+	bz, err := k.address.StringToBytes(account)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), errors.Wrapf(err, "unable to decode account %s", account)
+	}
+
+	if k.isModuleYieldAccount(ctx, bz) {
+		return math.ZeroInt(), math.ZeroInt(), errors.Wrapf(types.ErrInvalidRequest, "yield of %s is claimed by the module", account)
+	}
+
+	cacheCtx, writeCache := cacheContext(ctx)
+	yield, tip, err = k.claimYield(cacheCtx, account, claimer)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	writeCache()
+
+	return yield, tip, nil
+}
+
+// claimYield is an internal helper function that claims the yield of an
+// account. If a claimer is provided, the current claim tip is paid to them
+// out of the claimed yield. The remaining yield is then forwarded to the
+// account's yield claim recipient, if one is set.
+//
+// NOTE: Yield is claimed based on the last attested index, so that claims
+// never pay out yield that is owed to other holders.
+func (k *Keeper) claimYield(ctx context.Context, account string, claimer []byte) (yield math.Int, tip math.Int, err error) {
+	yield, bz, err := k.GetAttestedYield(ctx, account)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	if has, _ := k.NonEarningAccounts.Has(ctx, bz); has {
+		return math.ZeroInt(), math.ZeroInt(), errors.Wrapf(types.ErrNonEarning, "yield of %s is swept to the treasury", account)
+	}
+	if k.IsFrozen(ctx, bz) {
+		return math.ZeroInt(), math.ZeroInt(), errors.Wrapf(types.ErrFrozen, "yield of %s is only claimable by the authority", account)
+	}
+
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.YieldName, bz, sdk.NewCoins(sdk.NewCoin(k.denom, yield)))
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), errors.Wrap(err, "unable to distribute yield to user")
+	}
+
+	// NOTE: Both the tip and the forwarded yield are paid using a regular
+	// transfer, so that the underlying principal is transferred alongside it.
+	// Otherwise, the yield would remain claimable by the holder.
+	tip = math.ZeroInt()
+	if claimer != nil && !bytes.Equal(claimer, bz) {
+		tip = yield.MulRaw(int64(k.GetClaimTip(ctx))).QuoRaw(10_000)
+		if tip.IsPositive() {
+			err = k.bank.SendCoins(ctx, bz, claimer, sdk.NewCoins(sdk.NewCoin(k.denom, tip)))
+			if err != nil {
+				return math.ZeroInt(), math.ZeroInt(), errors.Wrap(err, "unable to pay claim tip")
+			}
+		}
+	}
+
+	remaining := yield.Sub(tip)
+	if !remaining.IsPositive() {
+		return yield, tip, nil
+	}
+
+	if split, err := k.YieldSplits.Get(ctx, bz); err == nil {
+		return yield, tip, k.splitYield(ctx, account, bz, split, remaining)
+	}
+
+	recipient, err := k.YieldClaimRecipients.Get(ctx, bz)
+	if err != nil {
+		return yield, tip, nil
+	}
+
+	return yield, tip, k.forwardYield(ctx, account, bz, recipient, remaining)
+}
+
+// splitYield is an internal helper function that splits claimed yield across
+// the shares of a holder's yield split. Shares are paid out in order, and any
+// rounding dust remains with the holder.
+func (k *Keeper) splitYield(ctx context.Context, account string, bz []byte, split v2.YieldSplit, yield math.Int) error {
+	for _, share := range split.Shares {
+		recipient, err := k.address.StringToBytes(share.Recipient)
+		if err != nil {
+			return errors.Wrapf(err, "unable to decode yield share recipient %s", share.Recipient)
+		}
+		if bytes.Equal(recipient, bz) {
+			continue
+		}
+
+		amount := yield.MulRaw(int64(share.Share)).QuoRaw(v2.TotalYieldSplitShares)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		err = k.forwardYield(ctx, account, bz, recipient, amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// forwardYield is an internal helper function that forwards claimed yield
+// from a holder to a recipient.
+func (k *Keeper) forwardYield(ctx context.Context, account string, bz []byte, recipient []byte, amount math.Int) error {
+	err := k.bank.SendCoins(ctx, bz, recipient, sdk.NewCoins(sdk.NewCoin(k.denom, amount)))
+	if err != nil {
+		return errors.Wrap(err, "unable to forward yield to recipient")
+	}
+
+	recipientAddress, _ := k.address.BytesToString(recipient)
+	return k.event.EventManager(ctx).Emit(ctx, &v2.YieldClaimRedirected{
+		Account:   account,
+		Recipient: recipientAddress,
+		Amount:    amount,
+	})
+}
+
 func (k *Keeper) claimModuleYield(ctx context.Context, addr sdk.Address) (math.Int, error) {
 	// Get the Yield of the module address.
-	yield, _, err := k.GetYield(ctx, addr.String())
+	yield, _, err := k.GetAttestedYield(ctx, addr.String())
 	if err != nil {
 		return math.ZeroInt(), nil
 	}
@@ -212,7 +637,7 @@ func (k *Keeper) claimModuleYield(ctx context.Context, addr sdk.Address) (math.I
 
 func (k *Keeper) claimStakedVaultYield(ctx context.Context) (math.Int, error) {
 	// Get the Yield of the Staked Vault.
-	yield, _, err := k.GetYield(ctx, vaults.StakedVaultAddress.String())
+	yield, _, err := k.GetAttestedYield(ctx, vaults.StakedVaultAddress.String())
 	if err != nil {
 		return math.ZeroInt(), nil
 	}
@@ -240,7 +665,7 @@ func (k *Keeper) claimStakedVaultYield(ctx context.Context) (math.Int, error) {
 	if err != nil {
 		stakedPrincipal = math.ZeroInt()
 	}
-	if err = k.Principal.Set(ctx, vaults.StakedVaultAddress, stakedPrincipal.Sub(yieldPrincipal)); err != nil {
+	if err = k.SetPrincipal(ctx, vaults.StakedVaultAddress, stakedPrincipal, stakedPrincipal.Sub(yieldPrincipal)); err != nil {
 		return math.ZeroInt(), err
 	}
 
@@ -249,13 +674,13 @@ func (k *Keeper) claimStakedVaultYield(ctx context.Context) (math.Int, error) {
 	if err != nil {
 		flexiblePrincipal = math.ZeroInt()
 	}
-	if err = k.Principal.Set(ctx, vaults.FlexibleVaultAddress, flexiblePrincipal.Add(yieldPrincipal)); err != nil {
+	if err = k.SetPrincipal(ctx, vaults.FlexibleVaultAddress, flexiblePrincipal, flexiblePrincipal.Add(yieldPrincipal)); err != nil {
 		return math.ZeroInt(), err
 	}
 	return yield, nil
 }
 
-func (k *Keeper) claimExternalYieldIBC(ctx context.Context) error {
+func (k *Keeper) claimExternalYieldIBC(ctx context.Context, distribution *v2.YieldDistribution) error {
 	provider := v2.Provider_IBC
 	yieldRecipients, err := k.GetYieldRecipientsByProvider(ctx, provider)
 	if err != nil {
@@ -304,6 +729,7 @@ func (k *Keeper) claimExternalYieldIBC(ctx context.Context) error {
 				return errors.Wrapf(err, "unable to increment retry amount for %s/%s", provider, channelId)
 			}
 		}
+		distribution.ExternalYield = append(distribution.ExternalYield, newExternalYieldDistribution(provider, channelId, yield, retryAmount, transferErr))
 
 		err = k.IncrementTotalExternalYield(ctx, provider, channelId, yield)
 		if err != nil {
@@ -318,7 +744,7 @@ func (k *Keeper) claimExternalYieldIBC(ctx context.Context) error {
 	return nil
 }
 
-func (k *Keeper) claimExternalYieldHyperlane(ctx context.Context) error {
+func (k *Keeper) claimExternalYieldHyperlane(ctx context.Context, distribution *v2.YieldDistribution) error {
 	provider := v2.Provider_HYPERLANE
 	yieldRecipients, err := k.GetYieldRecipientsByProvider(ctx, provider)
 	if err != nil {
@@ -413,6 +839,7 @@ func (k *Keeper) claimExternalYieldHyperlane(ctx context.Context) error {
 				return errors.Wrapf(err, "unable to increment retry amount for %s/%s", provider, identifier)
 			}
 		}
+		distribution.ExternalYield = append(distribution.ExternalYield, newExternalYieldDistribution(provider, identifier, yieldPortion, retryAmount, transferErr))
 
 		err = k.IncrementTotalExternalYield(ctx, provider, identifier, yieldPortion)
 		if err != nil {
@@ -427,9 +854,27 @@ func (k *Keeper) claimExternalYieldHyperlane(ctx context.Context) error {
 	return nil
 }
 
+// newExternalYieldDistribution is an internal helper function that records
+// the yield distributed to an external chain, carrying forward the accrued
+// yield if its transfer failed.
+func newExternalYieldDistribution(provider v2.Provider, identifier string, amount math.Int, retryAmount math.Int, transferErr error) v2.ExternalYieldDistribution {
+	carriedForward := math.ZeroInt()
+	if transferErr != nil {
+		carriedForward = amount.Add(retryAmount)
+	}
+
+	return v2.ExternalYieldDistribution{
+		Provider:       provider,
+		Identifier:     identifier,
+		Amount:         amount,
+		RetryAmount:    retryAmount,
+		CarriedForward: carriedForward,
+	}
+}
+
 // handleVaultsYieldSeasonOne handles the logic of the vaults for Season One.
 // Yield from the Staked vault gets redirected to the Flexible vault.
-func (k *Keeper) handleVaultsYieldSeasonOne(ctx context.Context, index int64) error {
+func (k *Keeper) handleVaultsYieldSeasonOne(ctx context.Context, index int64, distribution *v2.YieldDistribution) error {
 	// Claim the yield of the Flexible vault.
 	flexibleYield, err := k.claimModuleYield(ctx, vaults.FlexibleVaultAddress)
 	if err != nil {
@@ -461,13 +906,14 @@ func (k *Keeper) handleVaultsYieldSeasonOne(ctx context.Context, index int64) er
 	}); err != nil {
 		return err
 	}
+	distribution.FlexibleVault = rewards
 
 	return nil
 }
 
 // handleVaultsYieldSeasonTwo handles the logic of the vaults for Season Two.
 // Yield from the Staked vault gets redirected to a configured collector address.
-func (k *Keeper) handleVaultsYieldSeasonTwo(ctx context.Context) error {
+func (k *Keeper) handleVaultsYieldSeasonTwo(ctx context.Context, distribution *v2.YieldDistribution) error {
 	// Claim the yield of the Staked vault.
 	yield, err := k.claimModuleYield(ctx, vaults.StakedVaultAddress)
 	if err != nil {
@@ -488,6 +934,7 @@ func (k *Keeper) handleVaultsYieldSeasonTwo(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	distribution.StakedVaultCollector = yield
 
 	return nil
 }
@@ -29,10 +29,13 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	"dollar.noble.xyz/v2/types"
 	"dollar.noble.xyz/v2/types/portal"
 	"dollar.noble.xyz/v2/types/portal/ntt"
+	"dollar.noble.xyz/v2/types/v2"
 )
 
 var _ portal.MsgServer = &portalMsgServer{}
@@ -74,15 +77,20 @@ func (k portalMsgServer) Transfer(ctx context.Context, msg *portal.MsgTransfer)
 		return nil, errors.Wrap(portal.ErrInvalidRecipient, "recipient must be 32 bytes")
 	}
 
-	index, err := k.Index.Get(ctx)
+	// NOTE: The index of $M is bridged, as the index of $USDN is net of fees.
+	index, err := k.GetMIndex(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to get index from state")
+		return nil, errors.Wrap(err, "unable to get m index from state")
 	}
 
+	// NOTE: While a haircut is applied, only the amount of $M backing the
+	// transferred $USDN is bridged, while the full amount is burned.
+	amount := k.GetEffectiveAmount(ctx, msg.Amount)
+
 	additionalPayload := portal.EncodeAdditionalPayload(index, msg.DestinationToken)
 
 	rawNativeTokenTransfer := ntt.EncodeNativeTokenTransfer(ntt.NativeTokenTransfer{
-		Amount:            msg.Amount.Uint64(),
+		Amount:            amount.Uint64(),
 		SourceToken:       portal.RawToken,
 		To:                msg.Recipient,
 		ToChain:           msg.DestinationChainId,
@@ -93,6 +101,9 @@ func (k portalMsgServer) Transfer(ctx context.Context, msg *portal.MsgTransfer)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to decode account %s", msg.Signer)
 	}
+	if k.IsFrozen(ctx, sender) {
+		return nil, errors.Wrapf(types.ErrFrozen, "sender %s is frozen", msg.Signer)
+	}
 	rawSender := make([]byte, 32)
 	copy(rawSender[32-len(sender):], sender)
 
@@ -146,7 +157,7 @@ func (k portalMsgServer) Transfer(ctx context.Context, msg *portal.MsgTransfer)
 		DestinationToken:   msg.DestinationToken,
 		Sender:             msg.Signer,
 		Recipient:          msg.Recipient,
-		Amount:             msg.Amount,
+		Amount:             amount,
 		Index:              index,
 		MessageId:          messageId,
 	}); err != nil {
@@ -158,7 +169,7 @@ func (k portalMsgServer) Transfer(ctx context.Context, msg *portal.MsgTransfer)
 		"transfer_sent",
 		event.Attribute{Key: "recipient", Value: string(msg.Recipient)},
 		event.Attribute{Key: "refund_address", Value: ""},
-		event.Attribute{Key: "amount", Value: strconv.Itoa(int(msg.Amount.Int64()))},
+		event.Attribute{Key: "amount", Value: strconv.Itoa(int(amount.Int64()))},
 		event.Attribute{Key: "fee", Value: ""},
 		event.Attribute{Key: "recipient_chain", Value: strconv.Itoa(int(msg.DestinationChainId))},
 		event.Attribute{Key: "msg_sequence", Value: strconv.Itoa(int(nonce))},
@@ -174,7 +185,11 @@ func (k portalMsgServer) Transfer(ctx context.Context, msg *portal.MsgTransfer)
 }
 
 func (k portalMsgServer) SetPausedState(ctx context.Context, msg *portal.MsgSetPausedState) (*portal.MsgSetPausedStateResponse, error) {
-	if err := k.EnsureOwner(ctx, msg.Signer); err != nil {
+	if err := k.ensureOwnerOrRole(ctx, msg.Signer, msg, v2.Role_PAUSER); err != nil {
+		return nil, err
+	}
+
+	if err := k.setPauseInfo(ctx, portal.SubmoduleName, portalPausedType(k.GetPortalPaused(ctx)), portalPausedType(msg.Paused), msg.Expiry, msg.Reason); err != nil {
 		return nil, err
 	}
 
@@ -190,7 +205,7 @@ func (k portalMsgServer) SetPausedState(ctx context.Context, msg *portal.MsgSetP
 }
 
 func (k portalMsgServer) SetPeer(ctx context.Context, msg *portal.MsgSetPeer) (*portal.MsgSetPeerResponse, error) {
-	if err := k.EnsureOwner(ctx, msg.Signer); err != nil {
+	if err := k.ensureOwnerOrRole(ctx, msg.Signer, msg, v2.Role_PORTAL_PEER_MANAGER); err != nil {
 		return nil, err
 	}
 
@@ -218,6 +233,14 @@ func (k portalMsgServer) SetPeer(ctx context.Context, msg *portal.MsgSetPeer) (*
 		return nil, errors.Wrap(portal.ErrInvalidPeer, "manager must not be empty")
 	}
 
+	queued, err := k.timelock(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &portal.MsgSetPeerResponse{}, nil
+	}
+
 	peer, _ := k.PortalPeers.Get(ctx, msg.Chain)
 	err = k.PortalPeers.Set(ctx, msg.Chain, portal.Peer{
 		Transceiver: msg.Transceiver,
@@ -237,7 +260,7 @@ func (k portalMsgServer) SetPeer(ctx context.Context, msg *portal.MsgSetPeer) (*
 }
 
 func (k portalMsgServer) SetBridgingPath(ctx context.Context, msg *portal.MsgSetBridgingPath) (*portal.MsgSetBridgingPathResponse, error) {
-	if err := k.EnsureOwner(ctx, msg.Signer); err != nil {
+	if err := k.ensureOwnerOrRole(ctx, msg.Signer, msg, v2.Role_PORTAL_PEER_MANAGER); err != nil {
 		return nil, err
 	}
 
@@ -257,6 +280,14 @@ func (k portalMsgServer) SetBridgingPath(ctx context.Context, msg *portal.MsgSet
 		return nil, errors.Wrap(portal.ErrInvalidBridgePath, "destination token must not be empty")
 	}
 
+	queued, err := k.timelock(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &portal.MsgSetBridgingPathResponse{}, nil
+	}
+
 	key := collections.Join(msg.DestinationChainId, msg.DestinationToken)
 	err = k.PortalBridgingPaths.Set(ctx, key, msg.Supported)
 	if err != nil {
@@ -271,29 +302,118 @@ func (k portalMsgServer) SetBridgingPath(ctx context.Context, msg *portal.MsgSet
 }
 
 func (k portalMsgServer) TransferOwnership(ctx context.Context, msg *portal.MsgTransferOwnership) (*portal.MsgTransferOwnershipResponse, error) {
-	if err := k.EnsureOwner(ctx, msg.Signer); err != nil {
+	if err := k.EnsureOwner(ctx, msg.Signer, msg); err != nil {
 		return nil, err
 	}
 
 	if _, err := k.address.StringToBytes(msg.NewOwner); err != nil {
 		return nil, errors.Wrap(err, "unable to decode new owner address")
 	}
-	if msg.NewOwner == msg.Signer {
+	owner, _ := k.PortalOwner.Get(ctx)
+	if msg.NewOwner == owner {
 		return nil, portal.ErrSameOwner
 	}
 
-	if err := k.PortalOwner.Set(ctx, msg.NewOwner); err != nil {
+	queued, err := k.timelock(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &portal.MsgTransferOwnershipResponse{}, nil
+	}
+
+	// NOTE: The ownership is only transferred once the new owner accepts it,
+	// protecting against transfers to an incorrect address.
+	if err := k.PortalPendingOwner.Set(ctx, msg.NewOwner); err != nil {
+		return nil, errors.Wrap(err, "unable to set pending owner in state")
+	}
+
+	return &portal.MsgTransferOwnershipResponse{}, k.event.EventManager(ctx).Emit(ctx, &portal.OwnershipTransferStarted{
+		Owner:        owner,
+		PendingOwner: msg.NewOwner,
+	})
+}
+
+func (k portalMsgServer) AcceptOwnership(ctx context.Context, msg *portal.MsgAcceptOwnership) (*portal.MsgAcceptOwnershipResponse, error) {
+	pendingOwner := k.GetPortalPendingOwner(ctx)
+	if pendingOwner == "" {
+		return nil, portal.ErrNoPendingOwner
+	}
+	if msg.Signer != pendingOwner {
+		return nil, errors.Wrapf(portal.ErrNotPendingOwner, "expected %s, got %s", pendingOwner, msg.Signer)
+	}
+
+	owner, _ := k.PortalOwner.Get(ctx)
+	if err := k.PortalOwner.Set(ctx, pendingOwner); err != nil {
+		return nil, errors.Wrap(err, "unable to set owner in state")
+	}
+	if err := k.PortalPendingOwner.Remove(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to remove pending owner from state")
+	}
+
+	return &portal.MsgAcceptOwnershipResponse{}, k.event.EventManager(ctx).Emit(ctx, &portal.OwnershipTransferred{
+		PreviousOwner: owner,
+		NewOwner:      pendingOwner,
+	})
+}
+
+func (k portalMsgServer) CancelOwnershipTransfer(ctx context.Context, msg *portal.MsgCancelOwnershipTransfer) (*portal.MsgCancelOwnershipTransferResponse, error) {
+	if err := k.EnsureOwner(ctx, msg.Signer, msg); err != nil {
+		return nil, err
+	}
+
+	pendingOwner := k.GetPortalPendingOwner(ctx)
+	if pendingOwner == "" {
+		return nil, portal.ErrNoPendingOwner
+	}
+
+	if err := k.PortalPendingOwner.Remove(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to remove pending owner from state")
+	}
+
+	owner, _ := k.PortalOwner.Get(ctx)
+	return &portal.MsgCancelOwnershipTransferResponse{}, k.event.EventManager(ctx).Emit(ctx, &portal.OwnershipTransferCancelled{
+		Owner:        owner,
+		PendingOwner: pendingOwner,
+	})
+}
+
+func (k portalMsgServer) SetOwner(ctx context.Context, msg *portal.MsgSetOwner) (*portal.MsgSetOwnerResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if _, err := k.address.StringToBytes(msg.Owner); err != nil {
+		return nil, errors.Wrap(err, "unable to decode owner address")
+	}
+
+	owner, _ := k.PortalOwner.Get(ctx)
+	if err := k.PortalOwner.Set(ctx, msg.Owner); err != nil {
 		return nil, errors.Wrap(err, "unable to set owner in state")
 	}
+	// NOTE: Any pending ownership transfer started by the previous owner is
+	// discarded, as governance has taken control of the ownership.
+	if err := k.PortalPendingOwner.Remove(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to remove pending owner from state")
+	}
 
-	return &portal.MsgTransferOwnershipResponse{}, k.event.EventManager(ctx).Emit(ctx, &portal.OwnershipTransferred{
-		PreviousOwner: msg.Signer,
-		NewOwner:      msg.NewOwner,
+	return &portal.MsgSetOwnerResponse{}, k.event.EventManager(ctx).Emit(ctx, &portal.OwnershipReassigned{
+		PreviousOwner: owner,
+		NewOwner:      msg.Owner,
 	})
 }
 
-// EnsureOwner is a utility that ensures a message was signed by the portal owner.
-func (k portalMsgServer) EnsureOwner(ctx context.Context, signer string) error {
+// EnsureOwner is a utility that ensures a message was signed by the portal
+// owner. The authority can execute any owner-gated message, in which case an
+// event is emitted recording that governance acted in place of the owner.
+func (k portalMsgServer) EnsureOwner(ctx context.Context, signer string, msg sdk.Msg) error {
+	if signer == k.authority {
+		return k.event.EventManager(ctx).Emit(ctx, &portal.GovernanceOverride{
+			Authority:  signer,
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+		})
+	}
+
 	owner, _ := k.PortalOwner.Get(ctx)
 	if owner == "" {
 		return portal.ErrNoOwner
@@ -305,3 +425,19 @@ func (k portalMsgServer) EnsureOwner(ctx context.Context, signer string) error {
 
 	return nil
 }
+
+// ensureOwnerOrRole is an internal helper function that ensures a message was
+// signed by either the portal owner, or an account that has been granted the
+// provided role.
+func (k portalMsgServer) ensureOwnerOrRole(ctx context.Context, signer string, msg sdk.Msg, role v2.Role) error {
+	err := k.EnsureOwner(ctx, signer, msg)
+	if err == nil {
+		return nil
+	}
+
+	if account, decodeErr := k.address.StringToBytes(signer); decodeErr == nil && k.HasRole(ctx, account, role) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/portal"
+	"dollar.noble.xyz/v2/utils"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestPortalOwnershipTransfer(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+
+	server := keeper.NewPortalMsgServer(k)
+	queryServer := keeper.NewPortalQueryServer(k)
+	owner, alice, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.PortalOwner.Set(ctx, owner.Address))
+
+	// ACT: Attempt to accept the ownership without a pending transfer.
+	_, err := server.AcceptOwnership(ctx, &portal.MsgAcceptOwnership{Signer: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, portal.ErrNoPendingOwner)
+
+	// ACT: Start an ownership transfer to Alice.
+	_, err = server.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: owner.Address, NewOwner: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: The ownership wasn't transferred yet.
+	res, err := queryServer.PendingOwner(ctx, &portal.QueryPendingOwner{})
+	require.NoError(t, err)
+	require.Equal(t, alice.Address, res.PendingOwner)
+	currentOwner, _ := k.PortalOwner.Get(ctx)
+	require.Equal(t, owner.Address, currentOwner)
+
+	// ACT: Cancel the ownership transfer.
+	_, err = server.CancelOwnershipTransfer(ctx, &portal.MsgCancelOwnershipTransfer{Signer: owner.Address})
+	require.NoError(t, err)
+	// ASSERT: Alice can no longer accept the ownership.
+	_, err = server.AcceptOwnership(ctx, &portal.MsgAcceptOwnership{Signer: alice.Address})
+	require.ErrorIs(t, err, portal.ErrNoPendingOwner)
+
+	// ACT: Start an ownership transfer to Alice, and attempt to accept it as Bob.
+	_, err = server.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: owner.Address, NewOwner: alice.Address})
+	require.NoError(t, err)
+	_, err = server.AcceptOwnership(ctx, &portal.MsgAcceptOwnership{Signer: bob.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, portal.ErrNotPendingOwner)
+
+	// ACT: Accept the ownership as Alice.
+	_, err = server.AcceptOwnership(ctx, &portal.MsgAcceptOwnership{Signer: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: Alice is the owner, and there is no pending owner.
+	currentOwner, _ = k.PortalOwner.Get(ctx)
+	require.Equal(t, alice.Address, currentOwner)
+	require.Empty(t, k.GetPortalPendingOwner(ctx))
+
+	// ACT: Attempt to cancel an ownership transfer as the previous owner.
+	_, err = server.CancelOwnershipTransfer(ctx, &portal.MsgCancelOwnershipTransfer{Signer: owner.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, portal.ErrNotOwner)
+}
+
+func TestPortalGovernanceOverride(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+
+	server := keeper.NewPortalMsgServer(k)
+	owner, alice, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to pause the portal as the authority without an owner.
+	_, err := server.SetPausedState(ctx, &portal.MsgSetPausedState{Signer: "authority", Paused: true})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.GetPortalPaused(ctx))
+
+	// ACT: Attempt to reassign the ownership as a non-authority.
+	_, err = server.SetOwner(ctx, &portal.MsgSetOwner{Signer: alice.Address, Owner: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to reassign the ownership to an invalid address.
+	_, err = server.SetOwner(ctx, &portal.MsgSetOwner{Signer: "authority", Owner: "invalid"})
+	// ASSERT: The action should've failed.
+	require.Error(t, err)
+
+	// ARRANGE: Set an owner, and start an ownership transfer to Alice.
+	require.NoError(t, k.PortalOwner.Set(ctx, owner.Address))
+	_, err = server.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: owner.Address, NewOwner: alice.Address})
+	require.NoError(t, err)
+
+	// ACT: Reassign the ownership to Bob as the authority.
+	_, err = server.SetOwner(ctx, &portal.MsgSetOwner{Signer: "authority", Owner: bob.Address})
+	// ASSERT: Bob is the owner, and the pending transfer was discarded.
+	require.NoError(t, err)
+	currentOwner, _ := k.PortalOwner.Get(ctx)
+	require.Equal(t, bob.Address, currentOwner)
+	require.Empty(t, k.GetPortalPendingOwner(ctx))
+	_, err = server.AcceptOwnership(ctx, &portal.MsgAcceptOwnership{Signer: alice.Address})
+	require.ErrorIs(t, err, portal.ErrNoPendingOwner)
+
+	// ACT: Start an ownership transfer to Alice as the authority.
+	_, err = server.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: "authority", NewOwner: alice.Address})
+	require.NoError(t, err)
+	// ACT: Cancel the ownership transfer as the authority.
+	_, err = server.CancelOwnershipTransfer(ctx, &portal.MsgCancelOwnershipTransfer{Signer: "authority"})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Empty(t, k.GetPortalPendingOwner(ctx))
+
+	// ACT: Attempt to transfer the ownership to the current owner as the authority.
+	_, err = server.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: "authority", NewOwner: bob.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, portal.ErrSameOwner)
+}
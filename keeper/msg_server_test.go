@@ -0,0 +1,1712 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/portal"
+	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
+	"dollar.noble.xyz/v2/utils"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestClaimYieldContinuousIndex(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := keeper.SecondsPerYear * time.Second
+
+	// ARRANGE: Alice and Bob both mint 100 USDN at an index of 1.0.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.UpdateIndex(ctx, 1e12+1))
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+
+	// ARRANGE: The index grows by 5% over a year.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(year)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.05e12))
+	require.InDelta(t, 10*ONE, bank.Balances[types.YieldAddress.String()].AmountOf("uusdn").Int64(), 1)
+
+	// ACT: Half a year passes without an index update.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(year + year/2)})
+	currentIndex, err := k.GetCurrentIndex(ctx)
+	// ASSERT: The current index is extrapolated at the earner rate.
+	require.NoError(t, err)
+	require.InDelta(t, 1.07593e12, currentIndex, 1e6)
+
+	// ASSERT: The yield of Bob is extrapolated from the current index.
+	yield, _, err := k.GetYield(ctx, bob.Address)
+	require.NoError(t, err)
+	require.InDelta(t, 7.593*ONE, yield.Int64(), 0.001*ONE)
+
+	// ASSERT: The yield query returns both the extrapolated and attested yield of Bob.
+	res, err := keeper.NewQueryServer(k).Yield(ctx, &types.QueryYield{Account: bob.Address})
+	require.NoError(t, err)
+	require.Equal(t, yield, res.ClaimableAmount)
+	require.InDelta(t, 5*ONE, res.AttestedAmount.Int64(), 2)
+
+	// ACT: Bob claims his yield.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: bob.Address})
+	// ASSERT: Bob received yield based on the last attested index.
+	require.NoError(t, err)
+	require.InDelta(t, 105*ONE, bank.Balances[bob.Address].AmountOf("uusdn").Int64(), 2)
+
+	// ASSERT: The attested yield of Alice is still fully backed by the yield account.
+	yield, _, err = k.GetAttestedYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.InDelta(t, 5*ONE, yield.Int64(), 2)
+	msg, stop := keeper.YieldReserveInvariant(k)(ctx)
+	require.False(t, stop, msg)
+
+	// ACT: The index is attested again at the end of the second year.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: start.Add(2 * year)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.1025e12))
+
+	// ASSERT: Both Alice and Bob end up with the same total amount.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: bob.Address})
+	require.NoError(t, err)
+	require.InDelta(t, 110.25*ONE, bank.Balances[alice.Address].AmountOf("uusdn").Int64(), 2)
+	require.InDelta(t, 110.25*ONE, bank.Balances[bob.Address].AmountOf("uusdn").Int64(), 2)
+}
+
+func TestClaimYieldWithRecipient(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice mints 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Alice attempts to route her yield to herself.
+	_, err := serverV2.SetYieldClaimRecipient(ctx, &v2.MsgSetYieldClaimRecipient{
+		Signer:    alice.Address,
+		Recipient: alice.Address,
+	})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ACT: Alice routes her yield to Bob.
+	_, err = serverV2.SetYieldClaimRecipient(ctx, &v2.MsgSetYieldClaimRecipient{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+	})
+	require.NoError(t, err)
+
+	// ARRANGE: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ACT: Alice claims her yield.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+
+	// ASSERT: Bob received the yield, and Alice has no more yield to claim.
+	require.Equal(t, math.NewInt(100*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(10*ONE), bank.Balances[bob.Address].AmountOf("uusdn"))
+	yield, _, err := k.GetYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.True(t, yield.IsZero())
+
+	// ACT: Alice removes her yield claim recipient.
+	_, err = serverV2.SetYieldClaimRecipient(ctx, &v2.MsgSetYieldClaimRecipient{Signer: alice.Address})
+	require.NoError(t, err)
+	has, err := k.YieldClaimRecipients.Has(ctx, alice.Bytes)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestClaimYieldFor(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	alice, bob, charlie := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to set a claim tip with an invalid authority.
+	_, err := server.SetClaimTip(ctx, &v2.MsgSetClaimTip{Signer: alice.Address, Tip: 10})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set a claim tip above the maximum.
+	_, err = server.SetClaimTip(ctx, &v2.MsgSetClaimTip{Signer: "authority", Tip: v2.MaxClaimTip + 1})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidClaimTip)
+
+	// ACT: Set a claim tip of 1%.
+	_, err = server.SetClaimTip(ctx, &v2.MsgSetClaimTip{Signer: "authority", Tip: v2.MaxClaimTip})
+	require.NoError(t, err)
+	require.Equal(t, uint32(v2.MaxClaimTip), k.GetClaimTip(ctx))
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0, and Bob routes his yield to Alice.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+	_, err = server.SetYieldClaimRecipient(ctx, &v2.MsgSetYieldClaimRecipient{
+		Signer:    bob.Address,
+		Recipient: alice.Address,
+	})
+	require.NoError(t, err)
+
+	// ARRANGE: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ACT: Charlie claims the yield of Alice, Bob, and himself.
+	res, err := server.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{
+		Signer:   charlie.Address,
+		Accounts: []string{alice.Address, bob.Address, charlie.Address},
+	})
+	// ASSERT: The yield was claimed, and Charlie received a tip from both claims.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(20*ONE), res.TotalYield)
+	require.Equal(t, math.NewInt(0.2*ONE), res.TotalTip)
+	require.Equal(t, math.NewInt(0.2*ONE), bank.Balances[charlie.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(119.8*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(100*ONE), bank.Balances[bob.Address].AmountOf("uusdn"))
+
+	// ASSERT: Neither Alice nor Bob have any more yield to claim.
+	for _, holder := range []string{alice.Address, bob.Address} {
+		yield, _, err := k.GetYield(ctx, holder)
+		require.NoError(t, err)
+		require.True(t, yield.IsZero())
+	}
+
+	// ARRANGE: A module account and the escrow account of an IBC channel hold USDN.
+	moduleAccount := authtypes.NewEmptyModuleAccount("module")
+	account.SetModuleAccount(ctx, moduleAccount)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.NoError(t, k.Mint(ctx, moduleAccount.GetAddress(), math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, escrow, math.NewInt(100*ONE), nil))
+	// ARRANGE: Dave mints 100 USDN and splits his yield between Frank and Erin, who is frozen.
+	dave, erin, frank := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.Mint(ctx, dave.Bytes, math.NewInt(100*ONE), nil))
+	_, err = server.SetYieldSplit(ctx, &v2.MsgSetYieldSplit{
+		Signer: dave.Address,
+		Shares: []v2.YieldShare{
+			{Recipient: frank.Address, Share: 5000},
+			{Recipient: erin.Address, Share: 5000},
+		},
+	})
+	require.NoError(t, err)
+	_, err = server.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: "authority", Account: erin.Address, Frozen: true})
+	require.NoError(t, err)
+	// ARRANGE: Frank mints 100 USDN.
+	require.NoError(t, k.Mint(ctx, frank.Bytes, math.NewInt(100*ONE), nil))
+	// ARRANGE: Grace and Henry mint 100 USDN, and are frozen and non-earning respectively.
+	grace, henry := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.Mint(ctx, grace.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, henry.Bytes, math.NewInt(100*ONE), nil))
+	_, err = server.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: "authority", Account: grace.Address, Frozen: true})
+	require.NoError(t, err)
+	_, err = server.SetNonEarningAccount(ctx, &v2.MsgSetNonEarningAccount{Signer: "authority", Account: henry.Address, NonEarning: true})
+	require.NoError(t, err)
+	// ARRANGE: The index grows, and a yield recipient is set for the IBC channel.
+	require.NoError(t, k.UpdateIndex(ctx, 1.21e12))
+	require.NoError(t, k.YieldRecipients.Set(ctx, collections.Join(int32(v2.Provider_IBC), "channel-0"), "recipient"))
+
+	// ACT: Charlie claims the yield of an invalid account, the module account, the escrow account, Frank, Grace, and Henry.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = server.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{
+		Signer: charlie.Address,
+		Accounts: []string{
+			"invalid", moduleAccount.GetAddress().String(), escrow.String(),
+			frank.Address, grace.Address, henry.Address,
+		},
+	})
+	// ASSERT: Only the yield of Frank was claimed.
+	require.NoError(t, err)
+	require.InDelta(t, 10*ONE, res.TotalYield.Int64(), 2)
+	require.InDelta(t, 109.9*ONE, bank.Balances[frank.Address].AmountOf("uusdn").Int64(), 2)
+	// ASSERT: A failed claim was reported for all other accounts.
+	reasons := failedClaims(ctx)
+	require.Len(t, reasons, 5)
+	require.Contains(t, reasons[grace.Address], types.ErrFrozen.Error())
+	require.Contains(t, reasons[henry.Address], types.ErrNonEarning.Error())
+
+	// ACT: Charlie claims the yield of Dave.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = server.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{
+		Signer:   charlie.Address,
+		Accounts: []string{dave.Address},
+	})
+	// ASSERT: The claim failed, and none of its events were emitted.
+	require.NoError(t, err)
+	require.True(t, res.TotalYield.IsZero())
+	require.Contains(t, failedClaims(ctx), dave.Address)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, "noble.dollar.v2.YieldClaimRedirected", event.Type)
+	}
+	// ASSERT: The yield of the module and escrow accounts is still claimable by the module.
+	for _, holder := range []string{moduleAccount.GetAddress().String(), escrow.String()} {
+		yield, _, err := k.GetAttestedYield(ctx, holder)
+		require.NoError(t, err)
+		require.True(t, yield.IsPositive())
+	}
+}
+
+// failedClaims returns the reason of every failed claim reported in a context, by account.
+func failedClaims(ctx sdk.Context) map[string]string {
+	reasons := make(map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "noble.dollar.v2.YieldClaimForFailed" {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = strings.Trim(attribute.Value, `"`)
+		}
+		reasons[attributes["account"]] = attributes["reason"]
+	}
+	return reasons
+}
+
+func TestClaimYieldWithSplit(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	alice, bob, charlie := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice mints 100.00001 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE+10), nil))
+
+	// ACT: Alice attempts to set a split that doesn't add up to 100%.
+	_, err := serverV2.SetYieldSplit(ctx, &v2.MsgSetYieldSplit{
+		Signer: alice.Address,
+		Shares: []v2.YieldShare{
+			{Recipient: alice.Address, Share: 8000},
+			{Recipient: bob.Address, Share: 1500},
+		},
+	})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ACT: Alice splits her yield 80% to herself, 15% to Bob, and 5% to Charlie.
+	_, err = serverV2.SetYieldSplit(ctx, &v2.MsgSetYieldSplit{
+		Signer: alice.Address,
+		Shares: []v2.YieldShare{
+			{Recipient: alice.Address, Share: 8000},
+			{Recipient: bob.Address, Share: 1500},
+			{Recipient: charlie.Address, Share: 500},
+		},
+	})
+	require.NoError(t, err)
+
+	// ACT: Alice attempts to also set a yield claim recipient.
+	_, err = serverV2.SetYieldClaimRecipient(ctx, &v2.MsgSetYieldClaimRecipient{
+		Signer:    alice.Address,
+		Recipient: bob.Address,
+	})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ARRANGE: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ACT: Alice claims her yield.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+
+	// ASSERT: The yield was split, with the rounding dust remaining with Alice.
+	require.Equal(t, math.NewInt(1.5*ONE), bank.Balances[bob.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(0.5*ONE), bank.Balances[charlie.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(108*ONE+11), bank.Balances[alice.Address].AmountOf("uusdn"))
+	yield, _, err := k.GetYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.True(t, yield.IsZero())
+
+	// ACT: Alice removes her yield split.
+	_, err = serverV2.SetYieldSplit(ctx, &v2.MsgSetYieldSplit{Signer: alice.Address})
+	require.NoError(t, err)
+	has, err := k.YieldSplits.Has(ctx, alice.Bytes)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestAutoClaim(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+
+	// ARRANGE: More holders than fit in a single batch mint 1 USDN and opt in to auto claiming.
+	holders := make([]utils.Account, keeper.AutoClaimBatchSize+1)
+	for i := range holders {
+		holders[i] = utils.TestAccount()
+		require.NoError(t, k.Mint(ctx, holders[i].Bytes, math.NewInt(ONE), nil))
+		_, err := server.SetAutoClaim(ctx, &v2.MsgSetAutoClaim{Signer: holders[i].Address, Enabled: true})
+		require.NoError(t, err)
+	}
+
+	// ARRANGE: A holder that didn't opt in mints 1 USDN.
+	other := utils.TestAccount()
+	require.NoError(t, k.Mint(ctx, other.Bytes, math.NewInt(ONE), nil))
+
+	// ACT: Process auto claims before any index update.
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: No pass is in progress.
+	inProgress, _ := k.GetAutoClaimCursor(ctx)
+	require.False(t, inProgress)
+
+	// ARRANGE: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+	inProgress, _ = k.GetAutoClaimCursor(ctx)
+	require.True(t, inProgress)
+
+	// ACT: Process the first batch of auto claims.
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: Only a single batch was processed, and the pass is still in progress.
+	claimed := 0
+	for _, holder := range holders {
+		if bank.Balances[holder.Address].AmountOf("uusdn").Equal(math.NewInt(1.1 * ONE)) {
+			claimed++
+		}
+	}
+	require.Equal(t, keeper.AutoClaimBatchSize, claimed)
+	inProgress, cursor := k.GetAutoClaimCursor(ctx)
+	require.True(t, inProgress)
+	require.NotEmpty(t, cursor)
+
+	// ACT: The index grows by another 10% while the pass is in progress.
+	require.NoError(t, k.UpdateIndex(ctx, 1.21e12))
+	// ASSERT: The pass was continued rather than restarted.
+	inProgress, next := k.GetAutoClaimCursor(ctx)
+	require.True(t, inProgress)
+	require.Equal(t, cursor, next)
+
+	// ACT: Process the second batch of auto claims.
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The remaining holder has claimed their yield at the latest index, and the pass has ended.
+	claimed = 0
+	for _, holder := range holders {
+		if bank.Balances[holder.Address].AmountOf("uusdn").Equal(math.NewInt(1.21 * ONE)) {
+			claimed++
+		}
+	}
+	require.Equal(t, 1, claimed)
+	inProgress, _ = k.GetAutoClaimCursor(ctx)
+	require.False(t, inProgress)
+
+	// ASSERT: The holder that didn't opt in still has yield to claim.
+	require.Equal(t, math.NewInt(ONE), bank.Balances[other.Address].AmountOf("uusdn"))
+	yield, _, err := k.GetYield(ctx, other.Address)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(0.21*ONE), yield)
+
+	// ACT: A holder opts out of auto claiming.
+	_, err = server.SetAutoClaim(ctx, &v2.MsgSetAutoClaim{Signer: holders[0].Address, Enabled: false})
+	require.NoError(t, err)
+	// ASSERT: The holder was removed from the registry.
+	has, err := k.AutoClaimAccounts.Has(ctx, holders[0].Bytes)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestTotalHolders(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	coins := sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(10*ONE)))
+
+	// ACT: Alice and Bob both mint 10 USDN.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(10*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(10*ONE), nil))
+	// ASSERT: There are two holders.
+	stats, err := k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TotalHolders)
+
+	// ACT: Alice transfers her entire balance to Bob.
+	require.NoError(t, bank.SendCoins(ctx, alice.Bytes, bob.Bytes, coins))
+	// ASSERT: There is only one holder.
+	stats, err = k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.TotalHolders)
+
+	// ACT: Bob transfers part of his balance back to Alice.
+	require.NoError(t, bank.SendCoins(ctx, bob.Bytes, alice.Bytes, coins))
+	// ASSERT: There are two holders again.
+	stats, err = k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TotalHolders)
+}
+
+func TestReconcileStats(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	alice := utils.TestAccount()
+
+	// ARRANGE: Alice mints 10 USDN, and the total holders stat drifts.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(10*ONE), nil))
+	require.NoError(t, k.IncrementTotalHolders(ctx))
+
+	// ACT: Attempt to reconcile stats with an invalid authority.
+	_, err := server.ReconcileStats(ctx, &v2.MsgReconcileStats{Signer: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Reconcile stats.
+	_, err = server.ReconcileStats(ctx, &v2.MsgReconcileStats{Signer: "authority"})
+	require.NoError(t, err)
+
+	// ASSERT: The stats match the state.
+	stats, err := k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.TotalHolders)
+	require.Equal(t, math.NewInt(10*ONE), stats.TotalPrincipal)
+}
+
+func TestNonEarningAccounts(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	alice, bob, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Attempt to mark Alice as non-earning with an invalid authority.
+	_, err := serverV2.SetNonEarningAccount(ctx, &v2.MsgSetNonEarningAccount{
+		Signer:     bob.Address,
+		Account:    alice.Address,
+		NonEarning: true,
+	})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Set the treasury, and mark Alice as non-earning.
+	_, err = serverV2.SetTreasury(ctx, &v2.MsgSetTreasury{Signer: "authority", Treasury: treasury.Address})
+	require.NoError(t, err)
+	_, err = serverV2.SetNonEarningAccount(ctx, &v2.MsgSetNonEarningAccount{
+		Signer:     "authority",
+		Account:    alice.Address,
+		NonEarning: true,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to mark the treasury as non-earning.
+	_, err = serverV2.SetNonEarningAccount(ctx, &v2.MsgSetNonEarningAccount{
+		Signer:     "authority",
+		Account:    treasury.Address,
+		NonEarning: true,
+	})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrNonEarning)
+
+	// ACT: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ASSERT: Alice's yield was swept to the treasury.
+	require.Equal(t, math.NewInt(100*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(10*ONE), bank.Balances[treasury.Address].AmountOf("uusdn"))
+	yield, _, err := k.GetYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.True(t, yield.IsZero())
+
+	// ASSERT: Bob's yield is still claimable.
+	yield, _, err = k.GetYield(ctx, bob.Address)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), yield)
+
+	// ACT: Alice attempts to claim her yield.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrNonEarning)
+
+	// ACT: Bob claims the yield of Alice and himself.
+	res, err := serverV2.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{
+		Signer:   bob.Address,
+		Accounts: []string{alice.Address, bob.Address},
+	})
+	// ASSERT: Alice was skipped.
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), res.TotalYield)
+}
+
+func TestFee(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	alice, bob, collector := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to set a fee with an invalid authority.
+	_, err := server.SetFee(ctx, &v2.MsgSetFee{Signer: alice.Address, Fee: 1_000, Collector: collector.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set a fee above the maximum.
+	_, err = server.SetFee(ctx, &v2.MsgSetFee{Signer: "authority", Fee: v2.MaxFee + 1, Collector: collector.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidFee)
+
+	// ACT: Attempt to set a fee without a collector.
+	_, err = server.SetFee(ctx, &v2.MsgSetFee{Signer: "authority", Fee: 1_000})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidFee)
+
+	// ACT: Attempt to set the yield account as the collector.
+	_, err = server.SetFee(ctx, &v2.MsgSetFee{Signer: "authority", Fee: 1_000, Collector: types.YieldAddress.String()})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ACT: Set a fee of 10%.
+	_, err = server.SetFee(ctx, &v2.MsgSetFee{Signer: "authority", Fee: 1_000, Collector: collector.Address})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1_000), k.GetFeeRate(ctx))
+	require.Equal(t, collector.Address, k.GetFeeCollector(ctx))
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: The index of $M grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+	// ASSERT: The index of $USDN grows by 9%, net of the fee.
+	index, err := k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.09e12), index)
+	mIndex, err := k.GetMIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.1e12), mIndex)
+	// ASSERT: 10% of the accrued yield is minted to the collector, the rest to the yield account.
+	require.Equal(t, math.NewInt(2*ONE), bank.Balances[collector.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(18*ONE), bank.Balances[types.YieldAddress.String()].AmountOf("uusdn"))
+	stats, err := k.Stats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2*ONE), stats.TotalFeesAccrued)
+	require.Equal(t, math.NewInt(18*ONE), stats.TotalYieldAccrued)
+	// ASSERT: Alice and Bob can claim yield net of the fee.
+	yield, _, err := k.GetYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(9*ONE), yield)
+	yield, _, err = k.GetYield(ctx, bob.Address)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(9*ONE), yield)
+
+	// ACT: Attempt to update to an index below the index of $M, but above the index of $USDN.
+	err = k.UpdateIndex(ctx, 1.095e12)
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrDecreasingIndex)
+
+	// ACT: Remove the fee, and the index of $M grows by another 10%.
+	_, err = server.SetFee(ctx, &v2.MsgSetFee{Signer: "authority", Fee: 0})
+	require.NoError(t, err)
+	require.Empty(t, k.GetFeeCollector(ctx))
+	require.NoError(t, k.UpdateIndex(ctx, 1.21e12))
+	// ASSERT: The index of $USDN grows by the full 10%.
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.199e12), index)
+	// ASSERT: No further fees were accrued, and the accounting remains solvent.
+	require.Equal(t, math.NewInt(2*ONE), bank.Balances[collector.Address].AmountOf("uusdn"))
+	for _, result := range k.CheckInvariants(ctx) {
+		require.False(t, result.Broken, result.Message)
+	}
+}
+
+func TestFrozenAccounts(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	vaultsServer := keeper.NewVaultsMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Attempt to freeze Alice with an invalid authority.
+	_, err := serverV2.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: bob.Address, Account: alice.Address, Frozen: true})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to freeze the yield account.
+	_, err = serverV2.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: "authority", Account: types.YieldAddress.String(), Frozen: true})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrFrozen)
+
+	// ACT: Freeze Alice.
+	_, err = serverV2.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: "authority", Account: alice.Address, Frozen: true})
+	require.NoError(t, err)
+	require.True(t, k.IsFrozen(ctx, alice.Bytes))
+
+	// ACT: Attempt to transfer from and to Alice.
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE))))
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrFrozen)
+	err = bank.SendCoins(ctx, bob.Bytes, alice.Bytes, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE))))
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrFrozen)
+
+	// ACT: Attempt to lock in a vault as Alice.
+	_, err = vaultsServer.Lock(ctx, &vaults.MsgLock{Signer: alice.Address, Vault: vaults.STAKED, Amount: math.NewInt(ONE)})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrFrozen)
+
+	// ARRANGE: The index grows by 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+
+	// ASSERT: Alice's yield keeps accruing.
+	yield, _, err := k.GetYield(ctx, alice.Address)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), yield)
+
+	// ACT: Attempt to claim Alice's yield as Alice.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrFrozen)
+
+	// ACT: Claim yield for Alice and Bob as Bob.
+	res, err := serverV2.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{Signer: bob.Address, Accounts: []string{alice.Address, bob.Address}})
+	require.NoError(t, err)
+	// ASSERT: Only Bob's yield was claimed.
+	require.Equal(t, math.NewInt(10*ONE), res.TotalYield)
+	require.Equal(t, math.NewInt(100*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+
+	// ACT: Attempt to claim Bob's yield as the authority.
+	_, err = serverV2.ClaimFrozenYield(ctx, &v2.MsgClaimFrozenYield{Signer: "authority", Account: bob.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ACT: Claim Alice's yield as the authority.
+	claimRes, err := serverV2.ClaimFrozenYield(ctx, &v2.MsgClaimFrozenYield{Signer: "authority", Account: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: The yield was claimed to Alice's balance.
+	require.Equal(t, math.NewInt(10*ONE), claimRes.Amount)
+	require.Equal(t, math.NewInt(110*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+
+	// ACT: Unfreeze Alice, and transfer from Alice to Bob.
+	_, err = serverV2.SetFrozenAccount(ctx, &v2.MsgSetFrozenAccount{Signer: "authority", Account: alice.Address, Frozen: false})
+	require.NoError(t, err)
+	err = bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE))))
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsFrozen(ctx, alice.Bytes))
+}
+
+func TestPausedType(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	transfer := sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE)))
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Attempt to set the paused type with an invalid authority.
+	_, err := serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: alice.Address, Paused: v2.PausedType_ALL})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set an unknown paused type.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: 16})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidPausedType)
+
+	// ACT: Pause index updates.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_INDEX})
+	require.NoError(t, err)
+	// ASSERT: Index updates fail, while transfers still succeed.
+	require.ErrorIs(t, k.UpdateIndex(ctx, 1.1e12), types.ErrPaused)
+	require.NoError(t, bank.SendCoins(ctx, alice.Bytes, bob.Bytes, transfer))
+
+	// ACT: Pause transfers and yield claims.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_TRANSFER | v2.PausedType_CLAIM})
+	require.NoError(t, err)
+	require.Equal(t, v2.PausedType_TRANSFER|v2.PausedType_CLAIM, k.GetPaused(ctx))
+	// ASSERT: Transfers fail, while issuance and index updates still succeed.
+	require.ErrorIs(t, bank.SendCoins(ctx, alice.Bytes, bob.Bytes, transfer), types.ErrPaused)
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(ONE), nil))
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+	// ASSERT: Yield claims fail.
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.ErrorIs(t, err, types.ErrPaused)
+	_, err = serverV2.ClaimYieldFor(ctx, &v2.MsgClaimYieldFor{Signer: bob.Address, Accounts: []string{alice.Address}})
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ACT: Unpause yield claims using the legacy message.
+	_, err = server.SetPausedState(ctx, &types.MsgSetPausedState{Signer: "authority", Paused: false})
+	require.NoError(t, err)
+	// ASSERT: Only yield claims were unpaused.
+	require.Equal(t, v2.PausedType_TRANSFER, k.GetPaused(ctx))
+	_, err = server.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+}
+
+func TestPauseExpiry(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	serverV2 := keeper.NewMsgServerV2(k)
+	portalServer := keeper.NewPortalMsgServer(k)
+	vaultsServer := keeper.NewVaultsMsgServer(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := start.Add(time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.PortalOwner.Set(ctx, "owner"))
+
+	// ACT: Attempt to pause with an expiry in the past.
+	past := start.Add(-time.Hour)
+	_, err := serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_ALL, Expiry: &past})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.Equal(t, v2.PausedType_NONE, k.GetPaused(ctx))
+
+	// ACT: Pause the core module until the expiry, and the portal and vaults indefinitely.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_TRANSFER, Expiry: &expiry, Reason: "incident"})
+	require.NoError(t, err)
+	_, err = portalServer.SetPausedState(ctx, &portal.MsgSetPausedState{Signer: "owner", Paused: true, Reason: "upgrade"})
+	require.NoError(t, err)
+	_, err = vaultsServer.SetPausedState(ctx, &vaults.MsgSetPausedState{Signer: "authority", Paused: vaults.LOCK, Expiry: &expiry})
+	require.NoError(t, err)
+	// ASSERT: All active pauses are described.
+	res, err := queryServer.Pauses(ctx, &v2.QueryPauses{})
+	require.NoError(t, err)
+	require.Equal(t, []v2.ActivePause{
+		{Module: types.ModuleName, Paused: "TRANSFER", Info: v2.PauseInfo{Expiry: &expiry, Reason: "incident"}},
+		{Module: portal.SubmoduleName, Paused: "true", Info: v2.PauseInfo{Reason: "upgrade"}},
+		{Module: vaults.SubmoduleName, Paused: "LOCK", Info: v2.PauseInfo{Expiry: &expiry}},
+	}, res.Pauses)
+
+	// ACT: Begin a block before the expiry.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: expiry.Add(-time.Second)})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The pauses are still active.
+	require.Equal(t, v2.PausedType_TRANSFER, k.GetPaused(ctx))
+	require.Equal(t, vaults.LOCK, k.GetVaultsPaused(ctx))
+
+	// ACT: Begin a block at the expiry.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: expiry})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The expired pauses were lifted, while the indefinite pause remains.
+	require.Equal(t, v2.PausedType_NONE, k.GetPaused(ctx))
+	require.Equal(t, vaults.NONE, k.GetVaultsPaused(ctx))
+	require.True(t, k.GetPortalPaused(ctx))
+	res, err = queryServer.Pauses(ctx, &v2.QueryPauses{})
+	require.NoError(t, err)
+	require.Equal(t, []v2.ActivePause{
+		{Module: portal.SubmoduleName, Paused: "true", Info: v2.PauseInfo{Reason: "upgrade"}},
+	}, res.Pauses)
+
+	// ACT: Unpause the portal.
+	_, err = portalServer.SetPausedState(ctx, &portal.MsgSetPausedState{Signer: "owner", Paused: false})
+	require.NoError(t, err)
+	// ASSERT: The pause info was removed.
+	infos, err := k.GetPauseInfos(ctx)
+	require.NoError(t, err)
+	require.Empty(t, infos)
+}
+
+func TestPauseExpiryPerAction(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	vaultsServer := keeper.NewVaultsMsgServer(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := start.Add(time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+
+	// ACT: Pause transfers indefinitely, and yield claims until the expiry.
+	_, err := serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_TRANSFER, Reason: "incident"})
+	require.NoError(t, err)
+	_, err = server.SetPausedState(ctx, &types.MsgSetPausedState{Signer: "authority", Paused: true, Expiry: &expiry, Reason: "maintenance"})
+	require.NoError(t, err)
+	// ACT: Pause vault locks indefinitely, and all vault actions until the expiry.
+	_, err = vaultsServer.SetPausedState(ctx, &vaults.MsgSetPausedState{Signer: "authority", Paused: vaults.LOCK})
+	require.NoError(t, err)
+	_, err = vaultsServer.SetPausedState(ctx, &vaults.MsgSetPausedState{Signer: "authority", Paused: vaults.ALL, Expiry: &expiry})
+	require.NoError(t, err)
+	// ASSERT: Each paused action is described with its own expiry and reason.
+	res, err := queryServer.Pauses(ctx, &v2.QueryPauses{})
+	require.NoError(t, err)
+	require.Equal(t, []v2.ActivePause{
+		{Module: types.ModuleName, Paused: "CLAIM", Info: v2.PauseInfo{Expiry: &expiry, Reason: "maintenance"}},
+		{Module: types.ModuleName, Paused: "TRANSFER", Info: v2.PauseInfo{Reason: "incident"}},
+		{Module: vaults.SubmoduleName, Paused: "LOCK", Info: v2.PauseInfo{}},
+		{Module: vaults.SubmoduleName, Paused: "UNLOCK", Info: v2.PauseInfo{Expiry: &expiry}},
+	}, res.Pauses)
+
+	// ACT: Begin a block at the expiry.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: expiry})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: Only the timed actions were lifted.
+	require.Equal(t, v2.PausedType_TRANSFER, k.GetPaused(ctx))
+	require.Equal(t, vaults.LOCK, k.GetVaultsPaused(ctx))
+	infos, err := k.GetPauseInfos(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []v2.PauseInfoEntry{
+		{Module: types.ModuleName, Action: int32(v2.PausedType_TRANSFER), Info: v2.PauseInfo{Reason: "incident"}},
+		{Module: vaults.SubmoduleName, Action: int32(vaults.LOCK), Info: v2.PauseInfo{}},
+	}, infos)
+}
+
+func TestRoles(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServer(k)
+	serverV2 := keeper.NewMsgServerV2(k)
+	portalServer := keeper.NewPortalMsgServer(k)
+	vaultsServer := keeper.NewVaultsMsgServer(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.PortalOwner.Set(ctx, "owner"))
+
+	// ACT: Attempt to grant a role with an invalid authority.
+	_, err := serverV2.GrantRole(ctx, &v2.MsgGrantRole{Signer: alice.Address, Account: alice.Address, Role: v2.Role_PAUSER})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to grant an unknown role.
+	_, err = serverV2.GrantRole(ctx, &v2.MsgGrantRole{Signer: "authority", Account: alice.Address, Role: v2.Role_ROLE_UNSPECIFIED})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	// ACT: Attempt to pause without a role.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: alice.Address, Paused: v2.PausedType_ALL})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Grant Alice the pauser role, and Bob the portal peer manager role.
+	_, err = serverV2.GrantRole(ctx, &v2.MsgGrantRole{Signer: "authority", Account: alice.Address, Role: v2.Role_PAUSER})
+	require.NoError(t, err)
+	_, err = serverV2.GrantRole(ctx, &v2.MsgGrantRole{Signer: "authority", Account: bob.Address, Role: v2.Role_PORTAL_PEER_MANAGER})
+	require.NoError(t, err)
+	// ASSERT: The roles are returned by the queries.
+	roles, err := queryServer.AccountRoles(ctx, &v2.QueryAccountRoles{Account: alice.Address})
+	require.NoError(t, err)
+	require.Equal(t, []v2.Role{v2.Role_PAUSER}, roles.Roles)
+	allRoles, err := queryServer.Roles(ctx, &v2.QueryRoles{})
+	require.NoError(t, err)
+	require.Len(t, allRoles.Roles, 2)
+
+	// ACT: Alice pauses the Noble Dollar and its submodules.
+	_, err = server.SetPausedState(ctx, &types.MsgSetPausedState{Signer: alice.Address, Paused: true})
+	require.NoError(t, err)
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: alice.Address, Paused: v2.PausedType_ALL})
+	require.NoError(t, err)
+	_, err = portalServer.SetPausedState(ctx, &portal.MsgSetPausedState{Signer: alice.Address, Paused: true})
+	require.NoError(t, err)
+	_, err = vaultsServer.SetPausedState(ctx, &vaults.MsgSetPausedState{Signer: alice.Address, Paused: vaults.ALL})
+	require.NoError(t, err)
+	// ASSERT: Everything is paused.
+	require.Equal(t, v2.PausedType_ALL, k.GetPaused(ctx))
+	require.True(t, k.GetPortalPaused(ctx))
+	require.Equal(t, vaults.ALL, k.GetVaultsPaused(ctx))
+
+	// ACT: Alice attempts to set a yield recipient.
+	_, err = serverV2.SetYieldRecipient(ctx, &v2.MsgSetYieldRecipient{Signer: alice.Address, Provider: v2.Provider_IBC, Identifier: "channel-0", Recipient: "recipient"})
+	// ASSERT: The action should've failed, as Alice isn't a yield recipient manager.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Bob attempts to set a peer, and to unpause the portal.
+	_, err = portalServer.SetPeer(ctx, &portal.MsgSetPeer{Signer: bob.Address})
+	// ASSERT: Bob passes the permission check, and fails on the invalid peer.
+	require.ErrorIs(t, err, portal.ErrInvalidPeer)
+	_, err = portalServer.SetPausedState(ctx, &portal.MsgSetPausedState{Signer: bob.Address, Paused: false})
+	// ASSERT: The action should've failed, as Bob isn't a pauser.
+	require.ErrorIs(t, err, portal.ErrNotOwner)
+
+	// ACT: Revoke Alice's pauser role.
+	_, err = serverV2.RevokeRole(ctx, &v2.MsgRevokeRole{Signer: "authority", Account: alice.Address, Role: v2.Role_PAUSER})
+	require.NoError(t, err)
+	// ASSERT: Alice can no longer pause.
+	_, err = serverV2.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: alice.Address, Paused: v2.PausedType_NONE})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = vaultsServer.SetPausedState(ctx, &vaults.MsgSetPausedState{Signer: alice.Address, Paused: vaults.NONE})
+	require.ErrorIs(t, err, vaults.ErrInvalidAuthority)
+
+	// ACT: Attempt to revoke a role that isn't granted.
+	_, err = serverV2.RevokeRole(ctx, &v2.MsgRevokeRole{Signer: "authority", Account: alice.Address, Role: v2.Role_PAUSER})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+}
+
+func TestTimelock(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	serverV2 := keeper.NewMsgServerV2(k)
+	portalServer := keeper.NewPortalMsgServer(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	owner, bob := utils.TestAccount(), utils.TestAccount()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	delay := 48 * time.Hour
+	peer := bytes.Repeat([]byte{1}, 32)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.PortalOwner.Set(ctx, owner.Address))
+
+	// ACT: Attempt to set the timelock delay with an invalid authority.
+	_, err := serverV2.SetTimelockDelay(ctx, &v2.MsgSetTimelockDelay{Signer: owner.Address, Delay: delay})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set a negative timelock delay.
+	_, err = serverV2.SetTimelockDelay(ctx, &v2.MsgSetTimelockDelay{Signer: "authority", Delay: -delay})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ARRANGE: Set a timelock delay, and grant Bob the portal peer manager role.
+	_, err = serverV2.SetTimelockDelay(ctx, &v2.MsgSetTimelockDelay{Signer: "authority", Delay: delay})
+	require.NoError(t, err)
+	_, err = serverV2.GrantRole(ctx, &v2.MsgGrantRole{Signer: "authority", Account: bob.Address, Role: v2.Role_PORTAL_PEER_MANAGER})
+	require.NoError(t, err)
+
+	// ACT: Attempt to queue an invalid peer.
+	_, err = portalServer.SetPeer(ctx, &portal.MsgSetPeer{Signer: owner.Address, Chain: 2})
+	// ASSERT: The action should've failed on submission.
+	require.ErrorIs(t, err, portal.ErrInvalidPeer)
+
+	// ACT: Queue a peer, an ownership transfer and a bridging path.
+	_, err = portalServer.SetPeer(ctx, &portal.MsgSetPeer{Signer: owner.Address, Chain: 2, Transceiver: peer, Manager: peer})
+	require.NoError(t, err)
+	_, err = portalServer.TransferOwnership(ctx, &portal.MsgTransferOwnership{Signer: owner.Address, NewOwner: bob.Address})
+	require.NoError(t, err)
+	_, err = portalServer.SetBridgingPath(ctx, &portal.MsgSetBridgingPath{Signer: bob.Address, DestinationChainId: 2, DestinationToken: peer, Supported: true})
+	require.NoError(t, err)
+	// ASSERT: Nothing was applied, and all messages are pending.
+	has, _ := k.PortalPeers.Has(ctx, 2)
+	require.False(t, has)
+	portalOwner, _ := k.PortalOwner.Get(ctx)
+	require.Equal(t, owner.Address, portalOwner)
+	res, err := queryServer.TimelockedMessages(ctx, &v2.QueryTimelockedMessages{})
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 3)
+	require.Equal(t, start.Add(delay), res.Messages[0].ExecutionTime)
+
+	// ACT: Cancel the ownership transfer, and revoke Bob's role.
+	_, err = serverV2.CancelTimelockedMessage(ctx, &v2.MsgCancelTimelockedMessage{Signer: "authority", Id: res.Messages[1].Id})
+	require.NoError(t, err)
+	_, err = serverV2.RevokeRole(ctx, &v2.MsgRevokeRole{Signer: "authority", Account: bob.Address, Role: v2.Role_PORTAL_PEER_MANAGER})
+	require.NoError(t, err)
+
+	// ACT: Begin a block before the execution time.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(delay - time.Second)})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: Nothing was applied.
+	has, _ = k.PortalPeers.Has(ctx, 2)
+	require.False(t, has)
+
+	// ARRANGE: Queue another peer.
+	_, err = portalServer.SetPeer(ctx, &portal.MsgSetPeer{Signer: owner.Address, Chain: 3, Transceiver: peer, Manager: peer})
+	require.NoError(t, err)
+
+	// ACT: Begin a block at the execution time.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(delay)}).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The peer was set, while the cancelled transfer and the unauthorized bridging path weren't applied.
+	has, _ = k.PortalPeers.Has(ctx, 2)
+	require.True(t, has)
+	portalOwner, _ = k.PortalOwner.Get(ctx)
+	require.Equal(t, owner.Address, portalOwner)
+	has, _ = k.PortalBridgingPaths.Has(ctx, collections.Join(uint16(2), peer))
+	require.False(t, has)
+	// ASSERT: Only the events of the executed peer were emitted.
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{
+		"noble.dollar.portal.v1.PeerUpdated",
+		"noble.dollar.v2.TimelockedMessageExecuted",
+		"noble.dollar.v2.TimelockedMessageFailed",
+	}, events)
+	// ASSERT: Only the peer queued later is still pending.
+	messages, err := k.GetTimelockedMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, start.Add(2*delay-time.Second), messages[0].ExecutionTime)
+	has, _ = k.PortalPeers.Has(ctx, 3)
+	require.False(t, has)
+}
+
+func TestSetIndex(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	alice, operator := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice mints 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Attempt to set the index with an invalid authority.
+	_, err := server.SetIndex(ctx, &v2.MsgSetIndex{Signer: alice.Address, Index: 1.01e12, Reason: "outage"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set the index without a reason.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1.01e12})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ACT: Attempt to set the current index.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1e12, Reason: "outage"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidIndex)
+
+	// ACT: Attempt to set an index beyond the maximum change.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1.01e12 + 1, Reason: "outage"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidIndex)
+
+	// ARRANGE: Grant the index operator role.
+	_, err = server.GrantRole(ctx, &v2.MsgGrantRole{Signer: "authority", Account: operator.Address, Role: v2.Role_INDEX_OPERATOR})
+	require.NoError(t, err)
+
+	// ACT: Set the index to the maximum change as the index operator.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: operator.Address, Index: 1.01e12, Reason: "outage"})
+	// ASSERT: The index was updated, and the accrued yield was minted.
+	require.NoError(t, err)
+	index, err := k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.01e12), index)
+	mIndex, err := k.GetMIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.01e12), mIndex)
+	require.Equal(t, math.NewInt(ONE), bank.Balances[types.YieldAddress.String()].AmountOf("uusdn"))
+
+	// ACT: Attempt to compound another maximum change on top of the last.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: operator.Address, Index: 1.0201e12, Reason: "outage"})
+	// ASSERT: The action should've failed, as the change is measured from the attested index.
+	require.ErrorIs(t, err, types.ErrInvalidIndex)
+
+	// ARRANGE: Pause index updates.
+	_, err = server.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_INDEX})
+	require.NoError(t, err)
+
+	// ACT: Attempt to set the index as the index operator while index updates are paused.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: operator.Address, Index: 1.005e12, Reason: "outage"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ACT: Attempt to correct the index downwards outside of haircut mode.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1.005e12, Reason: "incorrect index"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrDecreasingIndex)
+
+	// ARRANGE: Enable haircut mode.
+	_, err = server.SetHaircutMode(ctx, &v2.MsgSetHaircutMode{Signer: "authority", Enabled: true})
+	require.NoError(t, err)
+
+	// ACT: Attempt to correct the index downwards during Season One of the vaults.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1.005e12, Reason: "incorrect index"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidIndex)
+
+	// ARRANGE: End Season One of the vaults.
+	require.NoError(t, k.VaultsSeasonOneEnded.Set(ctx, true))
+
+	// ACT: Correct the index downwards.
+	_, err = server.SetIndex(ctx, &v2.MsgSetIndex{Signer: "authority", Index: 1.005e12, Reason: "incorrect index"})
+	// ASSERT: The index of $M was corrected, and the shortfall was absorbed by burning unclaimed yield, lowering the index of $USDN.
+	require.NoError(t, err)
+	mIndex, err = k.GetMIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.005e12), mIndex)
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.InDelta(t, 1.005e12, float64(index), 1e4)
+	require.Equal(t, math.LegacyOneDec(), k.GetHaircutFactor(ctx))
+	require.InDelta(t, 0.5*ONE, bank.Balances[types.YieldAddress.String()].AmountOf("uusdn").Int64(), 1)
+
+	// ACT: Attempt a regular index update below the current index.
+	err = k.UpdateIndex(ctx, 1.004e12)
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrPaused)
+}
+
+func TestIndexCircuitBreaker(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	alice := utils.TestAccount()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := keeper.SecondsPerYear * time.Second
+
+	// ACT: Attempt to set the maximum yield rate with an invalid authority.
+	_, err := server.SetMaxYieldRate(ctx, &v2.MsgSetMaxYieldRate{Signer: alice.Address, Rate: math.LegacyMustNewDecFromStr("0.1")})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set a negative maximum yield rate.
+	_, err = server.SetMaxYieldRate(ctx, &v2.MsgSetMaxYieldRate{Signer: "authority", Rate: math.LegacyMustNewDecFromStr("-0.1")})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ACT: Set a maximum yield rate of 10%.
+	_, err = server.SetMaxYieldRate(ctx, &v2.MsgSetMaxYieldRate{Signer: "authority", Rate: math.LegacyMustNewDecFromStr("0.1")})
+	require.NoError(t, err)
+
+	// ARRANGE: Alice mints 100 USDN at an index of 1.0.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.UpdateIndex(ctx, 1e12+1))
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: The index grows by 5% over a year.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(year)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.05e12))
+	// ASSERT: The index update was applied.
+	index, err := k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.05e12), index)
+	require.InDelta(t, 5*ONE, bank.Balances[types.YieldAddress.String()].AmountOf("uusdn").Int64(), 1)
+
+	// ACT: The index grows by another 5% over a day.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(year + 24*time.Hour)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.1025e12))
+	// ASSERT: The index update was quarantined, and no yield was minted.
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.05e12), index)
+	require.InDelta(t, 5*ONE, bank.Balances[types.YieldAddress.String()].AmountOf("uusdn").Int64(), 1)
+	res, err := queryServer.IndexCircuitBreaker(ctx, &v2.QueryIndexCircuitBreaker{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), res.MaxYieldRate)
+	require.NotNil(t, res.PendingIndex)
+	require.Equal(t, int64(1.1025e12), res.PendingIndex.MIndex)
+
+	// ACT: The index grows by an extreme ratio on the same day.
+	require.NoError(t, k.UpdateIndex(ctx, 1e18))
+	// ASSERT: The index update was quarantined, replacing the pending index.
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.05e12), index)
+	require.Equal(t, int64(1e18), k.GetPendingIndex(ctx).MIndex)
+
+	// ACT: Attempt to approve the pending index with an invalid authority.
+	_, err = server.ApprovePendingIndex(ctx, &v2.MsgApprovePendingIndex{Signer: alice.Address})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Reject the pending index.
+	_, err = server.RejectPendingIndex(ctx, &v2.MsgRejectPendingIndex{Signer: "authority"})
+	require.NoError(t, err)
+	// ASSERT: The pending index was removed.
+	require.Nil(t, k.GetPendingIndex(ctx))
+	_, err = server.ApprovePendingIndex(ctx, &v2.MsgApprovePendingIndex{Signer: "authority"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ARRANGE: The same index update is delivered again.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1025e12))
+	require.NotNil(t, k.GetPendingIndex(ctx))
+
+	// ARRANGE: Pause index updates.
+	_, err = server.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_INDEX})
+	require.NoError(t, err)
+
+	// ACT: Attempt to approve the pending index while index updates are paused.
+	_, err = server.ApprovePendingIndex(ctx, &v2.MsgApprovePendingIndex{Signer: "authority"})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ACT: Unpause index updates, and approve the pending index.
+	_, err = server.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_NONE})
+	require.NoError(t, err)
+	_, err = server.ApprovePendingIndex(ctx, &v2.MsgApprovePendingIndex{Signer: "authority"})
+	require.NoError(t, err)
+	// ASSERT: The index update was applied, and the accrued yield was minted.
+	require.Nil(t, k.GetPendingIndex(ctx))
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.1025e12), index)
+	require.InDelta(t, 10.25*ONE, bank.Balances[types.YieldAddress.String()].AmountOf("uusdn").Int64(), 1)
+
+	// ACT: The index grows slightly again within the same block.
+	require.NoError(t, k.UpdateIndex(ctx, 1.1026e12))
+	// ASSERT: The index update was applied, as its growth is within the absolute bound.
+	require.Nil(t, k.GetPendingIndex(ctx))
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.1026e12), index)
+
+	// ACT: The index grows by 20% within the same block.
+	require.NoError(t, k.UpdateIndex(ctx, 1.32312e12))
+	// ASSERT: The index update was quarantined, as its growth exceeds the absolute bound.
+	require.Equal(t, int64(1.32312e12), k.GetPendingIndex(ctx).MIndex)
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.1026e12), index)
+
+	// ARRANGE: Reject the pending index, and pause index updates.
+	_, err = server.RejectPendingIndex(ctx, &v2.MsgRejectPendingIndex{Signer: "authority"})
+	require.NoError(t, err)
+	_, err = server.SetPausedType(ctx, &v2.MsgSetPausedType{Signer: "authority", Paused: v2.PausedType_INDEX})
+	require.NoError(t, err)
+
+	// ACT: Alice receives a transfer delivered alongside an index update.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: start.Add(year + 48*time.Hour)}).WithEventManager(sdk.NewEventManager())
+	newIndex := int64(1.1027e12)
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(ONE), &newIndex))
+	// ASSERT: The transfer was issued at the current index, and the skipped index update was reported.
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.1026e12), index)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "noble.dollar.v2.IndexUpdateSkipped", events[0].Type)
+}
+
+func TestIndexStaleness(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	vaultsServer := keeper.NewVaultsMsgServer(k)
+	alice := utils.TestAccount()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ACT: Attempt to set the index staleness with an invalid authority.
+	_, err := server.SetIndexStaleness(ctx, &v2.MsgSetIndexStaleness{Signer: alice.Address, Threshold: 24 * time.Hour})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set a negative staleness threshold.
+	_, err = server.SetIndexStaleness(ctx, &v2.MsgSetIndexStaleness{Signer: "authority", Threshold: -time.Hour})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// ACT: Set a staleness threshold of a day, blocking vault locks while stale.
+	_, err = server.SetIndexStaleness(ctx, &v2.MsgSetIndexStaleness{Signer: "authority", Threshold: 24 * time.Hour, BlockVaultLocks: true})
+	require.NoError(t, err)
+	// ASSERT: The index isn't stale, as it was never updated.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	res, err := queryServer.IndexStatus(ctx, &v2.QueryIndexStatus{})
+	require.NoError(t, err)
+	require.Nil(t, res.LastUpdate)
+	require.False(t, res.Stale)
+
+	// ARRANGE: Alice mints 100 USDN at an index of 1.0.
+	require.NoError(t, k.UpdateIndex(ctx, 1e12+1))
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+
+	// ACT: Half a day passes without an index update.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(12 * time.Hour)})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The index isn't stale.
+	res, err = queryServer.IndexStatus(ctx, &v2.QueryIndexStatus{})
+	require.NoError(t, err)
+	require.Equal(t, start, *res.LastUpdate)
+	require.Equal(t, 12*time.Hour, res.Elapsed)
+	require.False(t, res.Stale)
+
+	// ACT: Another day passes without an index update.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(36 * time.Hour)})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The index is stale, and reported as such.
+	res, err = queryServer.IndexStatus(ctx, &v2.QueryIndexStatus{})
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+	reported, _ := k.IndexStale.Get(ctx)
+	require.True(t, reported)
+
+	// ACT: Attempt to lock in a vault as Alice.
+	_, err = vaultsServer.Lock(ctx, &vaults.MsgLock{Signer: alice.Address, Vault: vaults.STAKED, Amount: math.NewInt(ONE)})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, vaults.ErrActionPaused)
+
+	// ACT: The index is updated.
+	require.NoError(t, k.UpdateIndex(ctx, 1.01e12))
+	// ASSERT: The index is no longer stale, nor reported as such.
+	res, err = queryServer.IndexStatus(ctx, &v2.QueryIndexStatus{})
+	require.NoError(t, err)
+	require.False(t, res.Stale)
+	reported, _ = k.IndexStale.Get(ctx)
+	require.False(t, reported)
+}
+
+func TestHaircut(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	serverV1 := keeper.NewMsgServer(k)
+	server := keeper.NewMsgServerV2(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	alice, bob, carol := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0, which grows by 10%.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+	yieldAddress := types.YieldAddress.String()
+	require.Equal(t, math.NewInt(20*ONE), bank.Balances[yieldAddress].AmountOf("uusdn"))
+
+	// ARRANGE: Alice claims her yield, while Bob leaves his unclaimed.
+	_, err := serverV1.ClaimYield(ctx, &types.MsgClaimYield{Signer: alice.Address})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(110*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	require.Equal(t, math.NewInt(10*ONE), bank.Balances[yieldAddress].AmountOf("uusdn"))
+
+	// ACT: Attempt to decrease the index without haircut mode.
+	err = k.UpdateIndex(ctx, 1e12)
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrDecreasingIndex)
+
+	// ACT: Attempt to enable haircut mode with an invalid authority.
+	_, err = server.SetHaircutMode(ctx, &v2.MsgSetHaircutMode{Signer: alice.Address, Enabled: true})
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ARRANGE: Enable haircut mode.
+	_, err = server.SetHaircutMode(ctx, &v2.MsgSetHaircutMode{Signer: "authority", Enabled: true})
+	require.NoError(t, err)
+
+	// ACT: Attempt to decrease the index during Season One of the vaults.
+	err = k.UpdateIndex(ctx, 1e12)
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidIndex)
+
+	// ARRANGE: End Season One of the vaults.
+	require.NoError(t, k.VaultsSeasonOneEnded.Set(ctx, true))
+
+	// ACT: The index decreases by 5%, a shortfall of 11 USDN.
+	require.NoError(t, k.UpdateIndex(ctx, 1.045e12))
+	// ASSERT: The unclaimed yield was burned first, lowering the index by the burned yield per unit of principal.
+	require.True(t, bank.Balances[yieldAddress].AmountOf("uusdn").IsZero())
+	stats, err := queryServer.Stats(ctx, &v2.QueryStats{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10*ONE), stats.TotalHaircutBurned)
+	index, err := k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.05e12), index)
+	// ASSERT: Only the remaining shortfall is socialized through the haircut factor.
+	factor := 0.95 * 220 / 210
+	res, err := queryServer.Haircut(ctx, &v2.QueryHaircut{})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+	require.InDelta(t, factor, res.Factor.MustFloat64(), 1e-12)
+	// ASSERT: Bob's yield is no longer claimable, while Alice's excess balance is netted against her future yield.
+	yield, _, err := k.GetAttestedYield(ctx, bob.Address)
+	require.NoError(t, err)
+	require.True(t, yield.IsZero())
+	_, stop := keeper.AllInvariants(k)(ctx)
+	require.False(t, stop)
+
+	// ACT: Alice transfers her full balance to Bob.
+	require.NoError(t, bank.SendCoins(ctx, alice.Bytes, bob.Bytes, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(110*ONE)))))
+	// ASSERT: Alice's principal was fully transferred, without going negative.
+	principal, err := k.Principal.Get(ctx, alice.Bytes)
+	require.NoError(t, err)
+	require.True(t, principal.IsZero())
+	// ASSERT: Bob's effective balance is reduced by the haircut factor.
+	balance, err := queryServer.EffectiveBalance(ctx, &v2.QueryEffectiveBalance{Account: bob.Address})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(210*ONE), balance.Balance)
+	require.InDelta(t, 210*ONE*factor, float64(balance.EffectiveBalance.Int64()), 1)
+
+	// ACT: The index decreases by another 10%.
+	require.NoError(t, k.UpdateIndex(ctx, 0.9405e12))
+	// ASSERT: Without unclaimed yield left to burn, the haircut factor decreases pro-rata, while the index is unchanged.
+	factor *= 0.9
+	require.InDelta(t, factor, k.GetHaircutFactor(ctx).MustFloat64(), 1e-12)
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1.05e12), index)
+
+	// ACT: Carol mints 9 $M.
+	require.NoError(t, k.Mint(ctx, carol.Bytes, math.NewInt(9*ONE), nil))
+	// ASSERT: Carol received the amount of $USDN backed by it, leaving the haircut factor unchanged.
+	require.InDelta(t, 9*ONE/factor, float64(bank.Balances[carol.Address].AmountOf("uusdn").Int64()), 1)
+	require.InDelta(t, factor, k.GetHaircutFactor(ctx).MustFloat64(), 1e-12)
+
+	// ACT: The index partially recovers.
+	require.NoError(t, k.UpdateIndex(ctx, 1.03455e12))
+	// ASSERT: The haircut factor partially recovers, and no yield was minted.
+	factor *= 1.1
+	require.InDelta(t, factor, k.GetHaircutFactor(ctx).MustFloat64(), 1e-8)
+	require.True(t, bank.Balances[yieldAddress].AmountOf("uusdn").IsZero())
+	_, stop = keeper.AllInvariants(k)(ctx)
+	require.False(t, stop)
+
+	// ACT: The index fully recovers, and grows beyond it.
+	require.NoError(t, k.UpdateIndex(ctx, 1.2e12))
+	// ASSERT: The haircut factor fully recovers, and only the excess is minted as yield.
+	require.Equal(t, math.LegacyOneDec(), k.GetHaircutFactor(ctx))
+	index, err = k.Index.Get(ctx)
+	require.NoError(t, err)
+	require.InDelta(t, 1.05e12*factor*1.2/1.03455, float64(index), 1e3)
+	require.True(t, bank.Balances[yieldAddress].AmountOf("uusdn").IsPositive())
+	_, stop = keeper.AllInvariants(k)(ctx)
+	require.False(t, stop)
+}
+
+func TestYieldDistributions(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewMsgServerV2(k)
+	queryServer := keeper.NewQueryServerV2(k)
+	alice, bob, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ARRANGE: Alice and Bob mint 100 USDN at an index of 1.0.
+	require.NoError(t, k.Mint(ctx, alice.Bytes, math.NewInt(100*ONE), nil))
+	require.NoError(t, k.Mint(ctx, bob.Bytes, math.NewInt(100*ONE), nil))
+	// ARRANGE: Set the treasury, and mark Alice as non-earning.
+	_, err := server.SetTreasury(ctx, &v2.MsgSetTreasury{Signer: "authority", Treasury: treasury.Address})
+	require.NoError(t, err)
+	_, err = server.SetNonEarningAccount(ctx, &v2.MsgSetNonEarningAccount{
+		Signer:     "authority",
+		Account:    alice.Address,
+		NonEarning: true,
+	})
+	require.NoError(t, err)
+
+	// ACT: The index grows by 10%.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.UpdateIndex(ctx, 1.1e12))
+	// ACT: A day later, the index grows by 10% again.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(24 * time.Hour)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.21e12))
+
+	// ASSERT: A distribution was recorded for both index updates.
+	res, err := queryServer.YieldDistributions(ctx, &v2.QueryYieldDistributions{})
+	require.NoError(t, err)
+	require.Len(t, res.Distributions, 2)
+
+	// ASSERT: The yield of Alice was swept to the treasury, and the yield of
+	// Bob remains in the user pool.
+	distribution := res.Distributions[0]
+	require.Equal(t, int64(1.1e12), distribution.Index)
+	require.Equal(t, int64(1), distribution.Height)
+	require.Equal(t, start, distribution.Time)
+	require.Equal(t, math.NewInt(20*ONE), distribution.TotalYield)
+	require.True(t, distribution.Fees.IsZero())
+	require.Equal(t, math.NewInt(10*ONE), distribution.Treasury)
+	require.Equal(t, math.NewInt(10*ONE), distribution.UserPool)
+	require.Empty(t, distribution.ExternalYield)
+
+	// ACT: Query the distributions from an hour after the first update.
+	res, err = queryServer.YieldDistributions(ctx, &v2.QueryYieldDistributions{StartTime: start.Add(time.Hour)})
+	// ASSERT: Only the second distribution was returned.
+	require.NoError(t, err)
+	require.Len(t, res.Distributions, 1)
+	require.Equal(t, int64(2), res.Distributions[0].Height)
+	distribution = res.Distributions[0]
+	require.Equal(t, distribution.TotalYield, distribution.Treasury.Add(distribution.UserPool))
+
+	// ACT: Query the distributions with a page size of one.
+	res, err = queryServer.YieldDistributions(ctx, &v2.QueryYieldDistributions{Pagination: &query.PageRequest{Limit: 1}})
+	// ASSERT: Only the first distribution was returned, with a next key.
+	require.NoError(t, err)
+	require.Len(t, res.Distributions, 1)
+	require.Equal(t, int64(1), res.Distributions[0].Height)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// ACT: The index grows again twice within the same block.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(48 * time.Hour)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.3e12))
+	require.NoError(t, k.UpdateIndex(ctx, 1.4e12))
+	// ASSERT: A distribution was recorded for both index updates.
+	res, err = queryServer.YieldDistributions(ctx, &v2.QueryYieldDistributions{StartTime: start.Add(48 * time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, res.Distributions, 2)
+	require.Equal(t, int64(1.3e12), res.Distributions[0].Index)
+	require.Equal(t, uint64(0), res.Distributions[0].Sequence)
+	require.Equal(t, int64(1.4e12), res.Distributions[1].Index)
+	require.Equal(t, uint64(1), res.Distributions[1].Sequence)
+
+	// ACT: Attempt to query with a nil request.
+	_, err = queryServer.YieldDistributions(ctx, nil)
+	// ASSERT: The query should've failed.
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
@@ -21,11 +21,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +36,7 @@ import (
 
 	"dollar.noble.xyz/v2/types"
 	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
 )
 
 var _ v2.MsgServer = &msgServerV2{}
@@ -46,8 +50,8 @@ func NewMsgServerV2(keeper *Keeper) v2.MsgServer {
 }
 
 func (k msgServerV2) SetYieldRecipient(ctx context.Context, msg *v2.MsgSetYieldRecipient) (*v2.MsgSetYieldRecipientResponse, error) {
-	if msg.Signer != k.authority {
-		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	if !k.IsAuthorized(ctx, msg.Signer, v2.Role_YIELD_RECIPIENT_MANAGER) {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s or a %s, got %s", k.authority, v2.Role_YIELD_RECIPIENT_MANAGER, msg.Signer)
 	}
 
 	switch msg.Provider {
@@ -74,8 +78,16 @@ func (k msgServerV2) SetYieldRecipient(ctx context.Context, msg *v2.MsgSetYieldR
 		}
 	}
 
+	queued, err := k.timelock(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &v2.MsgSetYieldRecipientResponse{}, nil
+	}
+
 	key := collections.Join(int32(msg.Provider), msg.Identifier)
-	err := k.YieldRecipients.Set(ctx, key, msg.Recipient)
+	err = k.YieldRecipients.Set(ctx, key, msg.Recipient)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to set yield recipient in state")
 	}
@@ -87,6 +99,646 @@ func (k msgServerV2) SetYieldRecipient(ctx context.Context, msg *v2.MsgSetYieldR
 	})
 }
 
+func (k msgServerV2) SetYieldClaimRecipient(ctx context.Context, msg *v2.MsgSetYieldClaimRecipient) (*v2.MsgSetYieldClaimRecipientResponse, error) {
+	signer, err := k.address.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Recipient == "" {
+		err = k.YieldClaimRecipients.Remove(ctx, signer)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to remove yield claim recipient from state")
+		}
+	} else {
+		recipient, err := k.address.StringToBytes(msg.Recipient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode recipient address %s", msg.Recipient)
+		}
+		if bytes.Equal(signer, recipient) || bytes.Equal(recipient, types.YieldAddress) {
+			return nil, errors.Wrapf(types.ErrInvalidRecipient, "yield cannot be forwarded to %s", msg.Recipient)
+		}
+		if has, _ := k.YieldSplits.Has(ctx, signer); has {
+			return nil, errors.Wrap(types.ErrInvalidRecipient, "yield split must be removed before setting a yield claim recipient")
+		}
+
+		err = k.YieldClaimRecipients.Set(ctx, signer, recipient)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to set yield claim recipient in state")
+		}
+	}
+
+	return &v2.MsgSetYieldClaimRecipientResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.YieldClaimRecipientSet{
+		Account:   msg.Signer,
+		Recipient: msg.Recipient,
+	})
+}
+
+func (k msgServerV2) SetYieldSplit(ctx context.Context, msg *v2.MsgSetYieldSplit) (*v2.MsgSetYieldSplitResponse, error) {
+	signer, err := k.address.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if len(msg.Shares) == 0 {
+		err = k.YieldSplits.Remove(ctx, signer)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to remove yield split from state")
+		}
+	} else {
+		if has, _ := k.YieldClaimRecipients.Has(ctx, signer); has {
+			return nil, errors.Wrap(types.ErrInvalidRecipient, "yield claim recipient must be removed before setting a yield split")
+		}
+
+		if err := v2.ValidateYieldShares(msg.Shares); err != nil {
+			return nil, errors.Wrap(types.ErrInvalidRecipient, err.Error())
+		}
+		for _, share := range msg.Shares {
+			recipient, err := k.address.StringToBytes(share.Recipient)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to decode recipient address %s", share.Recipient)
+			}
+			if bytes.Equal(recipient, types.YieldAddress) {
+				return nil, errors.Wrapf(types.ErrInvalidRecipient, "yield cannot be forwarded to %s", share.Recipient)
+			}
+		}
+
+		v2.SortYieldShares(msg.Shares)
+		err = k.YieldSplits.Set(ctx, signer, v2.YieldSplit{Shares: msg.Shares})
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to set yield split in state")
+		}
+	}
+
+	return &v2.MsgSetYieldSplitResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.YieldSplitSet{
+		Account: msg.Signer,
+		Shares:  msg.Shares,
+	})
+}
+
+func (k msgServerV2) SetAutoClaim(ctx context.Context, msg *v2.MsgSetAutoClaim) (*v2.MsgSetAutoClaimResponse, error) {
+	signer, err := k.address.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	if msg.Enabled {
+		err = k.AutoClaimAccounts.Set(ctx, signer)
+	} else {
+		err = k.AutoClaimAccounts.Remove(ctx, signer)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set auto claim in state")
+	}
+
+	return &v2.MsgSetAutoClaimResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.AutoClaimSet{
+		Account: msg.Signer,
+		Enabled: msg.Enabled,
+	})
+}
+
+func (k msgServerV2) ClaimYieldFor(ctx context.Context, msg *v2.MsgClaimYieldFor) (*v2.MsgClaimYieldForResponse, error) {
+	if k.IsPaused(ctx, v2.PausedType_CLAIM) {
+		return nil, types.ErrPaused
+	}
+
+	signer, err := k.address.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode signer address %s", msg.Signer)
+	}
+
+	totalYield, totalTip := math.ZeroInt(), math.ZeroInt()
+	for _, account := range msg.Accounts {
+		// NOTE: A failed claim only skips the account, so that it doesn't
+		// fail the claims of all other accounts.
+		yield, tip, err := k.claimYieldFor(ctx, account, signer)
+		if err != nil {
+			err = k.event.EventManager(ctx).Emit(ctx, &v2.YieldClaimForFailed{
+				Account: account,
+				Claimer: msg.Signer,
+				Reason:  err.Error(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !yield.IsPositive() {
+			continue
+		}
+
+		totalYield = totalYield.Add(yield)
+		totalTip = totalTip.Add(tip)
+
+		err = k.event.EventManager(ctx).Emit(ctx, &v2.YieldClaimedFor{
+			Account: account,
+			Claimer: msg.Signer,
+			Amount:  yield,
+			Tip:     tip,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &v2.MsgClaimYieldForResponse{
+		TotalYield: totalYield,
+		TotalTip:   totalTip,
+	}, nil
+}
+
+func (k msgServerV2) SetNonEarningAccount(ctx context.Context, msg *v2.MsgSetNonEarningAccount) (*v2.MsgSetNonEarningAccountResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	account, err := k.address.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", msg.Account)
+	}
+
+	if msg.NonEarning {
+		treasury, _ := k.Treasury.Get(ctx)
+		if bytes.Equal(account, treasury) || bytes.Equal(account, types.YieldAddress) || bytes.Equal(account, types.ModuleAddress) {
+			return nil, errors.Wrapf(types.ErrNonEarning, "%s can't be non-earning", msg.Account)
+		}
+
+		err = k.NonEarningAccounts.Set(ctx, account)
+	} else {
+		err = k.NonEarningAccounts.Remove(ctx, account)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set non-earning account in state")
+	}
+
+	return &v2.MsgSetNonEarningAccountResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.NonEarningAccountSet{
+		Account:    msg.Account,
+		NonEarning: msg.NonEarning,
+	})
+}
+
+func (k msgServerV2) SetTreasury(ctx context.Context, msg *v2.MsgSetTreasury) (*v2.MsgSetTreasuryResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	treasury, err := k.address.StringToBytes(msg.Treasury)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode treasury address %s", msg.Treasury)
+	}
+	if has, _ := k.NonEarningAccounts.Has(ctx, treasury); has || k.IsFrozen(ctx, treasury) || bytes.Equal(treasury, types.YieldAddress) {
+		return nil, errors.Wrapf(types.ErrInvalidRecipient, "yield cannot be swept to %s", msg.Treasury)
+	}
+
+	oldTreasury := k.GetTreasury(ctx)
+	err = k.Treasury.Set(ctx, treasury)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set treasury in state")
+	}
+
+	return &v2.MsgSetTreasuryResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.TreasurySet{
+		OldTreasury: oldTreasury,
+		NewTreasury: msg.Treasury,
+	})
+}
+
+func (k msgServerV2) ReconcileStats(ctx context.Context, msg *v2.MsgReconcileStats) (*v2.MsgReconcileStatsResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	return &v2.MsgReconcileStatsResponse{}, k.reconcileStats(ctx)
+}
+
+func (k msgServerV2) SetClaimTip(ctx context.Context, msg *v2.MsgSetClaimTip) (*v2.MsgSetClaimTipResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Tip > v2.MaxClaimTip {
+		return nil, errors.Wrapf(types.ErrInvalidClaimTip, "maximum is %d, got %d", v2.MaxClaimTip, msg.Tip)
+	}
+
+	oldTip := k.GetClaimTip(ctx)
+	err := k.ClaimTip.Set(ctx, msg.Tip)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set claim tip in state")
+	}
+
+	return &v2.MsgSetClaimTipResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.ClaimTipSet{
+		OldTip: oldTip,
+		NewTip: msg.Tip,
+	})
+}
+
+func (k msgServerV2) SetFee(ctx context.Context, msg *v2.MsgSetFee) (*v2.MsgSetFeeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Fee > v2.MaxFee {
+		return nil, errors.Wrapf(types.ErrInvalidFee, "maximum is %d, got %d", v2.MaxFee, msg.Fee)
+	}
+
+	oldFee, oldCollector := k.GetFeeRate(ctx), k.GetFeeCollector(ctx)
+
+	if msg.Collector != "" {
+		collector, err := k.address.StringToBytes(msg.Collector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode fee collector address %s", msg.Collector)
+		}
+		if k.IsFrozen(ctx, collector) || bytes.Equal(collector, types.YieldAddress) || bytes.Equal(collector, types.ModuleAddress) {
+			return nil, errors.Wrapf(types.ErrInvalidRecipient, "fees cannot be collected by %s", msg.Collector)
+		}
+
+		err = k.FeeCollector.Set(ctx, collector)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to set fee collector in state")
+		}
+	} else {
+		if msg.Fee > 0 {
+			return nil, errors.Wrapf(types.ErrInvalidFee, "collector is required for a fee of %d", msg.Fee)
+		}
+
+		err := k.FeeCollector.Remove(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to remove fee collector from state")
+		}
+	}
+
+	err := k.FeeRate.Set(ctx, msg.Fee)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set fee in state")
+	}
+
+	return &v2.MsgSetFeeResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.FeeSet{
+		OldFee:       oldFee,
+		NewFee:       msg.Fee,
+		OldCollector: oldCollector,
+		NewCollector: msg.Collector,
+	})
+}
+
+func (k msgServerV2) SetFrozenAccount(ctx context.Context, msg *v2.MsgSetFrozenAccount) (*v2.MsgSetFrozenAccountResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	account, err := k.address.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", msg.Account)
+	}
+
+	if msg.Frozen {
+		// NOTE: Module accounts, the treasury and the fee collector can't be
+		// frozen, as they are required for accruing and distributing yield.
+		treasury, _ := k.Treasury.Get(ctx)
+		collector, _ := k.FeeCollector.Get(ctx)
+		for _, reserved := range [][]byte{types.ModuleAddress, types.YieldAddress, vaults.StakedVaultAddress, vaults.FlexibleVaultAddress, treasury, collector} {
+			if bytes.Equal(account, reserved) {
+				return nil, errors.Wrapf(types.ErrFrozen, "%s can't be frozen", msg.Account)
+			}
+		}
+
+		err = k.FrozenAccounts.Set(ctx, account)
+	} else {
+		err = k.FrozenAccounts.Remove(ctx, account)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set frozen account in state")
+	}
+
+	return &v2.MsgSetFrozenAccountResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.FrozenAccountSet{
+		Account: msg.Account,
+		Frozen:  msg.Frozen,
+	})
+}
+
+func (k msgServerV2) ClaimFrozenYield(ctx context.Context, msg *v2.MsgClaimFrozenYield) (*v2.MsgClaimFrozenYieldResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	yield, account, err := k.GetAttestedYield(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !k.IsFrozen(ctx, account) {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "%s is not frozen", msg.Account)
+	}
+
+	// NOTE: The yield is claimed to the balance of the frozen account, and is
+	// not forwarded to any yield claim recipient or split.
+	if yield.IsPositive() {
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.YieldName, account, sdk.NewCoins(sdk.NewCoin(k.denom, yield)))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to distribute yield to user")
+		}
+	}
+
+	return &v2.MsgClaimFrozenYieldResponse{Amount: yield}, k.event.EventManager(ctx).Emit(ctx, &types.YieldClaimed{
+		Account: msg.Account,
+		Amount:  yield,
+	})
+}
+
+func (k msgServerV2) SetPausedType(ctx context.Context, msg *v2.MsgSetPausedType) (*v2.MsgSetPausedTypeResponse, error) {
+	if !k.IsAuthorized(ctx, msg.Signer, v2.Role_PAUSER) {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s or a %s, got %s", k.authority, v2.Role_PAUSER, msg.Signer)
+	}
+
+	if msg.Paused&^v2.PausedType_ALL != 0 {
+		return nil, errors.Wrapf(types.ErrInvalidPausedType, "paused type %d is not a combination of known actions", msg.Paused)
+	}
+
+	oldPaused := k.GetPaused(ctx)
+	err := k.setPauseInfo(ctx, types.ModuleName, int32(oldPaused), int32(msg.Paused), msg.Expiry, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Paused.Set(ctx, int32(msg.Paused))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set paused type in state")
+	}
+
+	return &v2.MsgSetPausedTypeResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.PausedTypeSet{
+		OldPaused: oldPaused,
+		NewPaused: msg.Paused,
+	})
+}
+
+func (k msgServerV2) GrantRole(ctx context.Context, msg *v2.MsgGrantRole) (*v2.MsgGrantRoleResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	account, err := k.address.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", msg.Account)
+	}
+	if _, found := v2.Role_name[int32(msg.Role)]; !found || msg.Role == v2.Role_ROLE_UNSPECIFIED {
+		return nil, errors.Wrapf(types.ErrInvalidRole, "role %d does not exist", msg.Role)
+	}
+
+	err = k.Roles.Set(ctx, collections.Join(account, int32(msg.Role)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set role in state")
+	}
+
+	return &v2.MsgGrantRoleResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.RoleGranted{
+		Account: msg.Account,
+		Role:    msg.Role,
+	})
+}
+
+func (k msgServerV2) RevokeRole(ctx context.Context, msg *v2.MsgRevokeRole) (*v2.MsgRevokeRoleResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	account, err := k.address.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", msg.Account)
+	}
+	if !k.HasRole(ctx, account, msg.Role) {
+		return nil, errors.Wrapf(types.ErrInvalidRole, "%s does not have role %s", msg.Account, msg.Role)
+	}
+
+	err = k.Roles.Remove(ctx, collections.Join(account, int32(msg.Role)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to remove role from state")
+	}
+
+	return &v2.MsgRevokeRoleResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.RoleRevoked{
+		Account: msg.Account,
+		Role:    msg.Role,
+	})
+}
+
+func (k msgServerV2) SetTimelockDelay(ctx context.Context, msg *v2.MsgSetTimelockDelay) (*v2.MsgSetTimelockDelayResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Delay < 0 {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "timelock delay %s must not be negative", msg.Delay)
+	}
+
+	oldDelay := k.GetTimelockDelay(ctx)
+	err := k.Keeper.TimelockDelay.Set(ctx, int64(msg.Delay))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set timelock delay in state")
+	}
+
+	return &v2.MsgSetTimelockDelayResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.TimelockDelaySet{
+		OldDelay: oldDelay,
+		NewDelay: msg.Delay,
+	})
+}
+
+func (k msgServerV2) CancelTimelockedMessage(ctx context.Context, msg *v2.MsgCancelTimelockedMessage) (*v2.MsgCancelTimelockedMessageResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	has, _ := k.TimelockedMessages.Has(ctx, msg.Id)
+	if !has {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "timelocked message %d does not exist", msg.Id)
+	}
+
+	err := k.TimelockedMessages.Remove(ctx, msg.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to remove timelocked message from state")
+	}
+
+	return &v2.MsgCancelTimelockedMessageResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.TimelockedMessageCancelled{
+		Id: msg.Id,
+	})
+}
+
+func (k msgServerV2) SetIndex(ctx context.Context, msg *v2.MsgSetIndex) (*v2.MsgSetIndexResponse, error) {
+	if !k.IsAuthorized(ctx, msg.Signer, v2.Role_INDEX_OPERATOR) {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s or a %s, got %s", k.authority, v2.Role_INDEX_OPERATOR, msg.Signer)
+	}
+
+	if strings.TrimSpace(msg.Reason) == "" {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "reason is required")
+	}
+	// NOTE: While index updates are paused, e.g. during an incident, only the
+	// authority itself can still set the index.
+	if msg.Signer != k.authority && k.IsPaused(ctx, v2.PausedType_INDEX) {
+		return nil, errors.Wrap(types.ErrPaused, "index updates are paused")
+	}
+
+	oldMIndex, err := k.GetMIndex(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get m index from state")
+	}
+	if msg.Index <= 0 || msg.Index == oldMIndex {
+		return nil, errors.Wrapf(types.ErrInvalidIndex, "expected a positive index different from %d, got %d", oldMIndex, msg.Index)
+	}
+	// NOTE: As with regular index updates, a decreasing index is only accepted
+	// in haircut mode, in which case the loss is socialized across all holders.
+	if msg.Index < oldMIndex && !k.IsHaircutMode(ctx) {
+		return nil, errors.Wrapf(types.ErrDecreasingIndex, "expected an index above %d outside of haircut mode, got %d", oldMIndex, msg.Index)
+	}
+
+	// Ensure that the change is within the maximum bounds, so that emergency
+	// updates can't arbitrarily inflate or deflate the supply. The change is
+	// measured from the last index attested by the portal, so that repeated
+	// emergency updates can't compound beyond the bounds.
+	attestedMIndex, err := k.GetAttestedMIndex(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get attested m index from state")
+	}
+	change := math.NewInt(msg.Index - attestedMIndex).Abs().MulRaw(v2.MaxFee)
+	if change.GT(math.NewInt(attestedMIndex).MulRaw(v2.MaxIndexChange)) {
+		return nil, errors.Wrapf(types.ErrInvalidIndex, "change from attested %d to %d exceeds the maximum of %d basis points", attestedMIndex, msg.Index, v2.MaxIndexChange)
+	}
+	// NOTE: If no index has been attested yet, the current index of $M is
+	// persisted as the attested index, so that it remains the reference.
+	err = k.setAttestedMIndex(ctx, attestedMIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.applyIndex(ctx, oldMIndex, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2.MsgSetIndexResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.IndexSet{
+		Signer:    msg.Signer,
+		OldMIndex: oldMIndex,
+		NewMIndex: msg.Index,
+		Reason:    msg.Reason,
+	})
+}
+
+func (k msgServerV2) SetMaxYieldRate(ctx context.Context, msg *v2.MsgSetMaxYieldRate) (*v2.MsgSetMaxYieldRateResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Rate.IsNil() || msg.Rate.IsNegative() {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "maximum yield rate must not be negative, got %s", msg.Rate)
+	}
+
+	oldRate := k.GetMaxYieldRate(ctx)
+	err := k.MaxYieldRate.Set(ctx, msg.Rate)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set maximum yield rate in state")
+	}
+
+	return &v2.MsgSetMaxYieldRateResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.MaxYieldRateSet{
+		OldRate: oldRate,
+		NewRate: msg.Rate,
+	})
+}
+
+func (k msgServerV2) ApprovePendingIndex(ctx context.Context, msg *v2.MsgApprovePendingIndex) (*v2.MsgApprovePendingIndexResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	// NOTE: Index updates have to be unpaused before a quarantined index can
+	// be approved, so that it isn't applied in the middle of an incident.
+	if k.IsPaused(ctx, v2.PausedType_INDEX) {
+		return nil, errors.Wrap(types.ErrPaused, "index updates are paused")
+	}
+
+	pending := k.GetPendingIndex(ctx)
+	if pending == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "no pending index")
+	}
+
+	oldMIndex, err := k.GetMIndex(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get m index from state")
+	}
+	// NOTE: The pending index can be outdated by a later index update, in
+	// which case it has to be rejected instead.
+	if pending.MIndex <= oldMIndex {
+		return nil, types.ErrDecreasingIndex
+	}
+
+	err = k.PendingIndex.Remove(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to remove pending index from state")
+	}
+	err = k.applyIndex(ctx, oldMIndex, pending.MIndex)
+	if err != nil {
+		return nil, err
+	}
+	err = k.setAttestedMIndex(ctx, pending.MIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2.MsgApprovePendingIndexResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.PendingIndexApproved{
+		MIndex: pending.MIndex,
+	})
+}
+
+func (k msgServerV2) RejectPendingIndex(ctx context.Context, msg *v2.MsgRejectPendingIndex) (*v2.MsgRejectPendingIndexResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	pending := k.GetPendingIndex(ctx)
+	if pending == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "no pending index")
+	}
+
+	err := k.PendingIndex.Remove(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to remove pending index from state")
+	}
+
+	return &v2.MsgRejectPendingIndexResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.PendingIndexRejected{
+		MIndex: pending.MIndex,
+	})
+}
+
+func (k msgServerV2) SetIndexStaleness(ctx context.Context, msg *v2.MsgSetIndexStaleness) (*v2.MsgSetIndexStalenessResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Threshold < 0 {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "staleness threshold must not be negative, got %s", msg.Threshold)
+	}
+
+	err := k.IndexStaleness.Set(ctx, v2.IndexStaleness{
+		Threshold:       msg.Threshold,
+		BlockVaultLocks: msg.BlockVaultLocks,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set index staleness in state")
+	}
+
+	return &v2.MsgSetIndexStalenessResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.IndexStalenessSet{
+		Threshold:       msg.Threshold,
+		BlockVaultLocks: msg.BlockVaultLocks,
+	})
+}
+
+func (k msgServerV2) SetHaircutMode(ctx context.Context, msg *v2.MsgSetHaircutMode) (*v2.MsgSetHaircutModeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	err := k.HaircutMode.Set(ctx, msg.Enabled)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set haircut mode in state")
+	}
+
+	return &v2.MsgSetHaircutModeResponse{}, k.event.EventManager(ctx).Emit(ctx, &v2.HaircutModeSet{
+		Enabled: msg.Enabled,
+	})
+}
+
 // getHyperlaneRouter returns the remote router enrolled for a Hyperlane Warp
 // route. The function errors if it can't find any routers or if there are
 // multiple routers. This is because, to correctly distribute yield, we need
@@ -32,6 +32,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
 	"dollar.noble.xyz/v2/types/vaults"
 )
 
@@ -52,6 +54,9 @@ func (k vaultsMsgServer) Lock(ctx context.Context, msg *vaults.MsgLock) (*vaults
 	if paused := k.GetVaultsPaused(ctx); paused == vaults.ALL || paused == vaults.LOCK {
 		return nil, errors.Wrapf(vaults.ErrActionPaused, "lock is paused")
 	}
+	if k.GetIndexStaleness(ctx).BlockVaultLocks && k.IsIndexStale(ctx) {
+		return nil, errors.Wrapf(vaults.ErrActionPaused, "lock is blocked because the index is stale")
+	}
 
 	// Ensure that the signer is a valid address.
 	addr, err := k.address.StringToBytes(msg.Signer)
@@ -59,6 +64,11 @@ func (k vaultsMsgServer) Lock(ctx context.Context, msg *vaults.MsgLock) (*vaults
 		return nil, fmt.Errorf("unable to decode user address: %s", msg.Signer)
 	}
 
+	// Ensure that the signer is not frozen.
+	if k.IsFrozen(ctx, addr) {
+		return nil, errors.Wrapf(types.ErrFrozen, "user %s is frozen", msg.Signer)
+	}
+
 	// Ensure that the amount is at least the `vaultsMinimumLock`.
 	if msg.Amount.LT(math.NewInt(k.vaultsMinimumLock)) {
 		return nil, errors.Wrapf(
@@ -171,6 +181,11 @@ func (k *Keeper) unlock(ctx context.Context, msg *vaults.MsgUnlock) (*vaults.Msg
 		return nil, fmt.Errorf("unable to decode user address: %s", msg.Signer)
 	}
 
+	// Ensure that the signer is not frozen.
+	if k.IsFrozen(ctx, addr) {
+		return nil, errors.Wrapf(types.ErrFrozen, "user %s is frozen", msg.Signer)
+	}
+
 	// Ensure that the amount is valid.
 	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
 		return nil, errors.Wrapf(vaults.ErrInvalidAmount, "amount is zero")
@@ -340,8 +355,8 @@ func (k *Keeper) unlock(ctx context.Context, msg *vaults.MsgUnlock) (*vaults.Msg
 
 func (k vaultsMsgServer) SetPausedState(ctx context.Context, msg *vaults.MsgSetPausedState) (*vaults.MsgSetPausedStateResponse, error) {
 	// Ensure that the signer has the required authority.
-	if msg.Signer != k.authority {
-		return nil, errors.Wrapf(vaults.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	if !k.IsAuthorized(ctx, msg.Signer, v2.Role_PAUSER) {
+		return nil, errors.Wrapf(vaults.ErrInvalidAuthority, "expected %s or a %s, got %s", k.authority, v2.Role_PAUSER, msg.Signer)
 	}
 
 	// Ensure that the Pause type does exist.
@@ -350,6 +365,11 @@ func (k vaultsMsgServer) SetPausedState(ctx context.Context, msg *vaults.MsgSetP
 		return nil, errors.Wrapf(vaults.ErrInvalidPauseType, "vaults pause type %s does not exist", msg.Paused)
 	}
 
+	// Record the expiry and reason of the pause, if any.
+	if err := k.setPauseInfo(ctx, vaults.SubmoduleName, int32(k.GetVaultsPaused(ctx)), int32(msg.Paused), msg.Expiry, msg.Reason); err != nil {
+		return nil, err
+	}
+
 	// Set the new Paused status.
 	if err := k.VaultsPaused.Set(ctx, int32(msg.Paused)); err != nil {
 		return nil, err
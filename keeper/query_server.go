@@ -27,6 +27,7 @@ import (
 	"cosmossdk.io/math"
 
 	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/v2"
 )
 
 var _ types.QueryServer = &queryServer{}
@@ -60,7 +61,7 @@ func (k queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types
 	}
 
 	return &types.QueryPausedResponse{
-		Paused: k.GetPaused(ctx),
+		Paused: k.IsPaused(ctx, v2.PausedType_CLAIM),
 	}, nil
 }
 
@@ -85,8 +86,13 @@ func (k queryServer) Yield(ctx context.Context, req *types.QueryYield) (*types.Q
 	}
 
 	yield, _, err := k.GetYield(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	attested, _, err := k.GetAttestedYield(ctx, req.Account)
 
-	return &types.QueryYieldResponse{ClaimableAmount: yield}, err
+	return &types.QueryYieldResponse{ClaimableAmount: yield, AttestedAmount: attested}, err
 }
 
 func (k queryServer) Stats(ctx context.Context, req *types.QueryStats) (*types.QueryStatsResponse, error) {
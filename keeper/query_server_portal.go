@@ -49,6 +49,14 @@ func (k portalQueryServer) Owner(ctx context.Context, req *portal.QueryOwner) (*
 	return &portal.QueryOwnerResponse{Owner: owner}, err
 }
 
+func (k portalQueryServer) PendingOwner(ctx context.Context, req *portal.QueryPendingOwner) (*portal.QueryPendingOwnerResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &portal.QueryPendingOwnerResponse{PendingOwner: k.GetPortalPendingOwner(ctx)}, nil
+}
+
 func (k portalQueryServer) Paused(ctx context.Context, req *portal.QueryPaused) (*portal.QueryPausedResponse, error) {
 	if req == nil {
 		return nil, types.ErrInvalidRequest
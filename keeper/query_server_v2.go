@@ -30,6 +30,7 @@ import (
 	"cosmossdk.io/math"
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	tendermint "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -48,6 +49,24 @@ func NewQueryServerV2(keeper *Keeper) v2.QueryServer {
 	return &queryServerV2{Keeper: keeper}
 }
 
+func (k queryServerV2) Paused(ctx context.Context, req *v2.QueryPaused) (*v2.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryPausedResponse{Paused: k.GetPaused(ctx)}, nil
+}
+
+func (k queryServerV2) Pauses(ctx context.Context, req *v2.QueryPauses) (*v2.QueryPausesResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	pauses, err := k.GetActivePauses(ctx)
+
+	return &v2.QueryPausesResponse{Pauses: pauses}, err
+}
+
 func (k queryServerV2) Stats(ctx context.Context, req *v2.QueryStats) (*v2.QueryStatsResponse, error) {
 	if req == nil {
 		return nil, types.ErrInvalidRequest
@@ -83,11 +102,22 @@ func (k queryServerV2) Stats(ctx context.Context, req *v2.QueryStats) (*v2.Query
 		}
 	}
 
+	totalFeesAccrued := stats.TotalFeesAccrued
+	if totalFeesAccrued.IsNil() {
+		totalFeesAccrued = math.ZeroInt()
+	}
+	totalHaircutBurned := stats.TotalHaircutBurned
+	if totalHaircutBurned.IsNil() {
+		totalHaircutBurned = math.ZeroInt()
+	}
+
 	return &v2.QueryStatsResponse{
 		TotalHolders:       stats.TotalHolders,
 		TotalPrincipal:     stats.TotalPrincipal,
 		TotalYieldAccrued:  stats.TotalYieldAccrued,
 		TotalExternalYield: totalExternalYield,
+		TotalFeesAccrued:   totalFeesAccrued,
+		TotalHaircutBurned: totalHaircutBurned,
 	}, nil
 }
 
@@ -135,6 +165,465 @@ func (k queryServerV2) RetryAmount(ctx context.Context, req *v2.QueryRetryAmount
 	return &v2.QueryRetryAmountResponse{RetryAmount: retryAmount}, nil
 }
 
+func (k queryServerV2) YieldSplit(ctx context.Context, req *v2.QueryYieldSplit) (*v2.QueryYieldSplitResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	account, err := k.address.StringToBytes(req.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", req.Account)
+	}
+
+	split, err := k.Keeper.YieldSplits.Get(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find yield split for account %s", req.Account)
+	}
+
+	return &v2.QueryYieldSplitResponse{Shares: split.Shares}, nil
+}
+
+func (k queryServerV2) AutoClaim(ctx context.Context, req *v2.QueryAutoClaim) (*v2.QueryAutoClaimResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	account, err := k.address.StringToBytes(req.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", req.Account)
+	}
+
+	enabled, err := k.Keeper.AutoClaimAccounts.Has(ctx, account)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get auto claim from state")
+	}
+
+	return &v2.QueryAutoClaimResponse{Enabled: enabled}, nil
+}
+
+func (k queryServerV2) NonEarningAccounts(ctx context.Context, req *v2.QueryNonEarningAccounts) (*v2.QueryNonEarningAccountsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	accounts, err := k.GetNonEarningAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get non-earning accounts from state")
+	}
+
+	return &v2.QueryNonEarningAccountsResponse{Accounts: accounts}, nil
+}
+
+func (k queryServerV2) FrozenAccounts(ctx context.Context, req *v2.QueryFrozenAccounts) (*v2.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	accounts, err := k.GetFrozenAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get frozen accounts from state")
+	}
+
+	return &v2.QueryFrozenAccountsResponse{Accounts: accounts}, nil
+}
+
+func (k queryServerV2) Roles(ctx context.Context, req *v2.QueryRoles) (*v2.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	roles, err := k.GetRoles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get roles from state")
+	}
+
+	return &v2.QueryRolesResponse{Roles: roles}, nil
+}
+
+func (k queryServerV2) AccountRoles(ctx context.Context, req *v2.QueryAccountRoles) (*v2.QueryAccountRolesResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	account, err := k.address.StringToBytes(req.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", req.Account)
+	}
+
+	roles, err := k.GetAccountRoles(ctx, account)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get roles from state")
+	}
+
+	return &v2.QueryAccountRolesResponse{Roles: roles}, nil
+}
+
+func (k queryServerV2) IndexCircuitBreaker(ctx context.Context, req *v2.QueryIndexCircuitBreaker) (*v2.QueryIndexCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryIndexCircuitBreakerResponse{
+		MaxYieldRate: k.GetMaxYieldRate(ctx),
+		PendingIndex: k.GetPendingIndex(ctx),
+	}, nil
+}
+
+func (k queryServerV2) IndexStatus(ctx context.Context, req *v2.QueryIndexStatus) (*v2.QueryIndexStatusResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	res := &v2.QueryIndexStatusResponse{
+		Staleness: k.GetIndexStaleness(ctx),
+		Stale:     k.IsIndexStale(ctx),
+	}
+	if lastUpdate, found := k.GetLastIndexUpdate(ctx); found {
+		res.LastUpdate = &lastUpdate
+		res.Elapsed = k.header.GetHeaderInfo(ctx).Time.Sub(lastUpdate)
+	}
+
+	return res, nil
+}
+
+func (k queryServerV2) Haircut(ctx context.Context, req *v2.QueryHaircut) (*v2.QueryHaircutResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryHaircutResponse{
+		Enabled: k.IsHaircutMode(ctx),
+		Factor:  k.GetHaircutFactor(ctx),
+	}, nil
+}
+
+func (k queryServerV2) EffectiveBalance(ctx context.Context, req *v2.QueryEffectiveBalance) (*v2.QueryEffectiveBalanceResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	account, err := k.address.StringToBytes(req.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", req.Account)
+	}
+
+	balance := k.bank.GetBalance(ctx, account, k.denom).Amount
+
+	return &v2.QueryEffectiveBalanceResponse{
+		Balance:          balance,
+		EffectiveBalance: k.GetEffectiveAmount(ctx, balance),
+	}, nil
+}
+
+func (k queryServerV2) TimelockDelay(ctx context.Context, req *v2.QueryTimelockDelay) (*v2.QueryTimelockDelayResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryTimelockDelayResponse{Delay: k.GetTimelockDelay(ctx)}, nil
+}
+
+func (k queryServerV2) TimelockedMessages(ctx context.Context, req *v2.QueryTimelockedMessages) (*v2.QueryTimelockedMessagesResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	messages, err := k.GetTimelockedMessages(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get timelocked messages from state")
+	}
+
+	return &v2.QueryTimelockedMessagesResponse{Messages: messages}, nil
+}
+
+func (k queryServerV2) Treasury(ctx context.Context, req *v2.QueryTreasury) (*v2.QueryTreasuryResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryTreasuryResponse{Treasury: k.GetTreasury(ctx)}, nil
+}
+
+func (k queryServerV2) Fee(ctx context.Context, req *v2.QueryFee) (*v2.QueryFeeResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryFeeResponse{
+		Fee:       k.GetFeeRate(ctx),
+		Collector: k.GetFeeCollector(ctx),
+	}, nil
+}
+
+func (k queryServerV2) ClaimTip(ctx context.Context, req *v2.QueryClaimTip) (*v2.QueryClaimTipResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	return &v2.QueryClaimTipResponse{Tip: k.GetClaimTip(ctx)}, nil
+}
+
+func (k queryServerV2) YieldClaimRecipient(ctx context.Context, req *v2.QueryYieldClaimRecipient) (*v2.QueryYieldClaimRecipientResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	account, err := k.address.StringToBytes(req.Account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode account %s", req.Account)
+	}
+
+	recipient, err := k.Keeper.YieldClaimRecipients.Get(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find yield claim recipient for account %s", req.Account)
+	}
+
+	recipientAddress, err := k.address.BytesToString(recipient)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode yield claim recipient")
+	}
+
+	return &v2.QueryYieldClaimRecipientResponse{Recipient: recipientAddress}, nil
+}
+
+func (k queryServerV2) Invariants(ctx context.Context, req *v2.QueryInvariants) (*v2.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	// NOTE: As the invariants iterate over all accounts and positions, they
+	// are only checked here if the state is small enough. Otherwise, they can
+	// only be checked via the crisis module.
+	exceeds, err := k.ExceedsInvariantsQueryEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exceeds {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "more than %d accounts and positions to check, use the crisis module instead", MaxQueryEntries)
+	}
+
+	return &v2.QueryInvariantsResponse{Invariants: k.CheckInvariants(ctx)}, nil
+}
+
+func (k queryServerV2) YieldLiability(ctx context.Context, req *v2.QueryYieldLiability) (*v2.QueryYieldLiabilityResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	// NOTE: As the yield liability iterates over all accounts, it is only
+	// computed here if the state is small enough.
+	exceeds, err := k.ExceedsYieldLiabilityQueryEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exceeds {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "more than %d accounts to sum, use an export of state instead", MaxQueryEntries)
+	}
+
+	index, err := k.Index.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get index from state")
+	}
+	res, err := k.ComputeYieldLiability(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	reserve := k.bank.GetBalance(ctx, types.YieldAddress, k.denom).Amount
+
+	return &v2.QueryYieldLiabilityResponse{
+		Liability: res.Liability,
+		Reserve:   reserve,
+		Surplus:   reserve.Sub(res.Liability),
+		Rounding:  res.Rounding,
+		Accounts:  res.Accounts,
+		Solvent:   reserve.GTE(res.Liability),
+		Excess:    res.Excess,
+	}, nil
+}
+
+func (k queryServerV2) IndexAt(ctx context.Context, req *v2.QueryIndexAt) (*v2.QueryIndexAtResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	record, err := k.GetIndexRecordAt(ctx, req.Time)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to find index at %s", req.Time)
+	}
+
+	return &v2.QueryIndexAtResponse{Record: record}, nil
+}
+
+func (k queryServerV2) IndexHistory(ctx context.Context, req *v2.QueryIndexHistory) (*v2.QueryIndexHistoryResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	history, pagination, err := paginateRange(ctx, k.Keeper.IndexHistory, timeRange(req.StartTime, req.EndTime), req.Pagination)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to paginate index history")
+	}
+
+	return &v2.QueryIndexHistoryResponse{
+		History:    history,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k queryServerV2) YieldDistributions(ctx context.Context, req *v2.QueryYieldDistributions) (*v2.QueryYieldDistributionsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	distributions, pagination, err := paginateRange(ctx, k.Keeper.YieldDistributions, timeRange(req.StartTime, req.EndTime), req.Pagination)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to paginate yield distributions")
+	}
+
+	return &v2.QueryYieldDistributionsResponse{
+		Distributions: distributions,
+		Pagination:    pagination,
+	}, nil
+}
+
+func (k queryServerV2) CurrentIndex(ctx context.Context, req *v2.QueryCurrentIndex) (*v2.QueryCurrentIndexResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	index, err := k.Index.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get index from state")
+	}
+
+	currentIndex, err := k.GetCurrentIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The last updated time is left empty if the index was never updated.
+	record, _ := k.GetLatestIndexRecord(ctx)
+
+	return &v2.QueryCurrentIndexResponse{
+		Index:        index,
+		CurrentIndex: currentIndex,
+		EarnerRate:   k.GetEarnerRate(ctx),
+		LastUpdated:  record.Time,
+	}, nil
+}
+
+func (k queryServerV2) YieldRate(ctx context.Context, req *v2.QueryYieldRate) (*v2.QueryYieldRateResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	end, err := k.GetLatestIndexRecord(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get latest index record")
+	}
+
+	// NOTE: If the index history doesn't cover the entire requested window,
+	// we fall back to the earliest available record. The returned start
+	// record reflects the window that was actually used.
+	start, err := k.GetEarliestIndexRecord(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get earliest index record")
+	}
+	if req.Window > 0 {
+		record, err := k.GetIndexRecordAt(ctx, end.Time.Add(-req.Window))
+		if err == nil {
+			start = record
+		}
+	}
+
+	apr, apy, err := k.GetYieldRate(start.Index, end.Index, end.Time.Unix()-start.Time.Unix())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to calculate yield rate")
+	}
+
+	return &v2.QueryYieldRateResponse{
+		Start: start,
+		End:   end,
+		Apr:   apr,
+		Apy:   apy,
+	}, nil
+}
+
+// paginateRange is an internal helper function that paginates over the
+// records of a collection within the provided range, so that only the
+// requested window is iterated instead of the full collection.
+func paginateRange[K, V any](ctx context.Context, records collections.Map[K, V], rng *collections.Range[K], pageReq *query.PageRequest) ([]V, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.Wrap(types.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	if len(pageReq.Key) > 0 {
+		_, key, err := records.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, errors.Wrap(types.ErrInvalidRequest, "unable to decode pagination key")
+		}
+		if pageReq.Reverse {
+			rng.EndInclusive(key)
+		} else {
+			rng.StartInclusive(key)
+		}
+	}
+	if pageReq.Reverse {
+		rng.Descending()
+	}
+
+	iterator, err := records.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	var values []V
+	pageRes := &query.PageResponse{}
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count += 1
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(len(values)) == limit {
+			if pageRes.NextKey == nil {
+				key, err := iterator.Key()
+				if err != nil {
+					return nil, nil, err
+				}
+				pageRes.NextKey, err = collections.EncodeKeyWithPrefix(nil, records.KeyCodec(), key)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+
+		value, err := iterator.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+
+	return values, pageRes, nil
+}
+
 func (k *Keeper) getIBCChainId(ctx context.Context, channelId string) string {
 	_, rawClientState, _ := k.channel.GetChannelClientState(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channelId)
 
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"dollar.noble.xyz/v2/keeper"
+	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/utils/mocks"
+)
+
+func TestIndexHistory(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewQueryServerV2(k)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ARRANGE: Update the index three times, one day apart.
+	for i, index := range []int64{1.1e12, 1.2e12, 1.3e12} {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(i + 1), Time: start.AddDate(0, 0, i)})
+		require.NoError(t, k.UpdateIndex(ctx, index))
+	}
+
+	// ACT: Query the index before the first update.
+	_, err := server.IndexAt(ctx, &v2.QueryIndexAt{Time: start.Add(-time.Second)})
+	// ASSERT: The query should've failed.
+	require.Error(t, err)
+
+	// ACT: Query the index in between the second and third update.
+	res, err := server.IndexAt(ctx, &v2.QueryIndexAt{Time: start.Add(36 * time.Hour)})
+	// ASSERT: The query should've returned the second update.
+	require.NoError(t, err)
+	require.Equal(t, int64(1.2e12), res.Record.Index)
+	require.Equal(t, int64(2), res.Record.Height)
+
+	// ACT: Query the index exactly at the third update.
+	res, err = server.IndexAt(ctx, &v2.QueryIndexAt{Time: start.AddDate(0, 0, 2)})
+	// ASSERT: The query should've returned the third update.
+	require.NoError(t, err)
+	require.Equal(t, int64(1.3e12), res.Record.Index)
+
+	// ACT: Query the index history between the second and third update.
+	history, err := server.IndexHistory(ctx, &v2.QueryIndexHistory{
+		StartTime: start.AddDate(0, 0, 1),
+		EndTime:   start.AddDate(0, 0, 2),
+	})
+	// ASSERT: The query should've returned the last two updates.
+	require.NoError(t, err)
+	require.Len(t, history.History, 2)
+	require.Equal(t, int64(1.2e12), history.History[0].Index)
+	require.Equal(t, int64(1.3e12), history.History[1].Index)
+
+	// ACT: Query the full index history.
+	history, err = server.IndexHistory(ctx, &v2.QueryIndexHistory{})
+	// ASSERT: The query should've returned all updates.
+	require.NoError(t, err)
+	require.Len(t, history.History, 3)
+
+	// ACT: Update the index twice within the same block.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: start.AddDate(0, 0, 3)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.4e12))
+	require.NoError(t, k.UpdateIndex(ctx, 1.5e12))
+	// ASSERT: Both updates were recorded, ordered by their sequence.
+	history, err = server.IndexHistory(ctx, &v2.QueryIndexHistory{StartTime: start.AddDate(0, 0, 3)})
+	require.NoError(t, err)
+	require.Len(t, history.History, 2)
+	require.Equal(t, int64(1.4e12), history.History[0].Index)
+	require.Equal(t, uint64(0), history.History[0].Sequence)
+	require.Equal(t, int64(1.5e12), history.History[1].Index)
+	require.Equal(t, uint64(1), history.History[1].Sequence)
+	// ASSERT: The index at the time of the block is the latest update.
+	res, err = server.IndexAt(ctx, &v2.QueryIndexAt{Time: start.AddDate(0, 0, 3)})
+	require.NoError(t, err)
+	require.Equal(t, int64(1.5e12), res.Record.Index)
+
+	// ACT: Page through the index history after the first update, two records at a time.
+	history, err = server.IndexHistory(ctx, &v2.QueryIndexHistory{
+		StartTime:  start.AddDate(0, 0, 1),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	// ASSERT: The first page contains the second and third update.
+	require.NoError(t, err)
+	require.Len(t, history.History, 2)
+	require.Equal(t, int64(1.2e12), history.History[0].Index)
+	require.Equal(t, uint64(4), history.Pagination.Total)
+	require.NotNil(t, history.Pagination.NextKey)
+
+	// ACT: Query the next page.
+	history, err = server.IndexHistory(ctx, &v2.QueryIndexHistory{
+		StartTime:  start.AddDate(0, 0, 1),
+		Pagination: &query.PageRequest{Key: history.Pagination.NextKey, Limit: 2},
+	})
+	// ASSERT: The second page contains both updates within the same block.
+	require.NoError(t, err)
+	require.Len(t, history.History, 2)
+	require.Equal(t, int64(1.4e12), history.History[0].Index)
+	require.Equal(t, int64(1.5e12), history.History[1].Index)
+	require.Nil(t, history.Pagination.NextKey)
+}
+
+func TestYieldRate(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances: make(map[string]sdk.Coins),
+	}
+	k, _, ctx := mocks.DollarKeeperWithKeepers(t, bank, account)
+	bank.Restriction = k.SendRestrictionFn
+	k.SetBankKeeper(bank)
+
+	server := keeper.NewQueryServerV2(k)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ACT: Query the yield rate without any index history.
+	_, err := server.YieldRate(ctx, &v2.QueryYieldRate{})
+	// ASSERT: The query should've failed.
+	require.Error(t, err)
+
+	// ARRANGE: Update the index to 1.0 at the start, and grow it by 1% over the following year.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	require.NoError(t, k.UpdateIndex(ctx, 1.0e12+1))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(keeper.SecondsPerYear * time.Second / 2)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.005e12))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(keeper.SecondsPerYear * time.Second)})
+	require.NoError(t, k.UpdateIndex(ctx, 1.01e12))
+
+	// ACT: Query the yield rate since genesis.
+	res, err := server.YieldRate(ctx, &v2.QueryYieldRate{})
+	// ASSERT: The yield rate should be roughly 1%.
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Start.Height)
+	require.Equal(t, int64(3), res.End.Height)
+	require.True(t, res.Apr.Sub(math.LegacyNewDecWithPrec(1, 2)).Abs().LT(math.LegacyNewDecWithPrec(1, 9)))
+	require.True(t, res.Apy.Sub(math.LegacyNewDecWithPrec(1, 2)).Abs().LT(math.LegacyNewDecWithPrec(1, 9)))
+
+	// ACT: Query the yield rate over the last 30 days.
+	res, err = server.YieldRate(ctx, &v2.QueryYieldRate{Window: 30 * 24 * time.Hour})
+	// ASSERT: The window should start at the second update, where the index grew slower.
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Start.Height)
+	require.True(t, res.Apr.GT(math.LegacyNewDecWithPrec(99, 4)))
+	require.True(t, res.Apy.GT(res.Apr))
+}
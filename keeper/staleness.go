@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// GetIndexStaleness is a utility that returns the configuration of when the
+// index is considered stale.
+func (k *Keeper) GetIndexStaleness(ctx context.Context) v2.IndexStaleness {
+	staleness, _ := k.IndexStaleness.Get(ctx)
+	return staleness
+}
+
+// GetLastIndexUpdate is a utility that returns the time of the last index
+// update, derived from the latest index record. Without index records, e.g.
+// before the first index update since the index history started being
+// recorded, it falls back to the start of the index history. It returns false
+// if neither is set.
+func (k *Keeper) GetLastIndexUpdate(ctx context.Context) (time.Time, bool) {
+	record, err := k.GetLatestIndexRecord(ctx)
+	if err == nil {
+		return record.Time, true
+	}
+
+	start, err := k.IndexHistoryStart.Get(ctx)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(start, 0).UTC(), true
+}
+
+// IsIndexStale is a utility that returns whether the time since the last
+// index update exceeds the staleness threshold.
+func (k *Keeper) IsIndexStale(ctx context.Context) bool {
+	threshold := k.GetIndexStaleness(ctx).Threshold
+	lastUpdate, found := k.GetLastIndexUpdate(ctx)
+	if threshold <= 0 || !found {
+		return false
+	}
+
+	return k.header.GetHeaderInfo(ctx).Time.Sub(lastUpdate) > threshold
+}
+
+// processIndexStaleness is an internal helper function that reports the
+// index as stale once the staleness threshold is exceeded.
+func (k *Keeper) processIndexStaleness(ctx context.Context) error {
+	if reported, _ := k.IndexStale.Get(ctx); reported || !k.IsIndexStale(ctx) {
+		return nil
+	}
+
+	if err := k.IndexStale.Set(ctx, true); err != nil {
+		return errors.Wrap(err, "unable to set index stale in state")
+	}
+
+	lastUpdate, _ := k.GetLastIndexUpdate(ctx)
+	return k.event.EventManager(ctx).Emit(ctx, &v2.IndexStale{
+		LastUpdate: lastUpdate,
+		Threshold:  k.GetIndexStaleness(ctx).Threshold,
+	})
+}
+
+// refreshIndexStaleness is an internal helper function that clears the stale
+// report of the index once it has been updated.
+func (k *Keeper) refreshIndexStaleness(ctx context.Context) error {
+	if reported, _ := k.IndexStale.Get(ctx); !reported {
+		return nil
+	}
+
+	if err := k.IndexStale.Remove(ctx); err != nil {
+		return errors.Wrap(err, "unable to remove index stale from state")
+	}
+
+	return k.event.EventManager(ctx).Emit(ctx, &v2.IndexRefreshed{
+		LastUpdate: k.header.GetHeaderInfo(ctx).Time,
+	})
+}
@@ -23,17 +23,368 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/portal"
 	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/types/vaults"
 )
 
-// GetPaused is a utility that returns the current paused state.
-func (k *Keeper) GetPaused(ctx context.Context) bool {
+// GetPaused is a utility that returns the current bitmask of paused actions.
+func (k *Keeper) GetPaused(ctx context.Context) v2.PausedType {
 	paused, _ := k.Paused.Get(ctx)
-	return paused
+	return v2.PausedType(paused)
+}
+
+// IsPaused is a utility that returns if all of the provided actions are paused.
+func (k *Keeper) IsPaused(ctx context.Context, paused v2.PausedType) bool {
+	return k.GetPaused(ctx)&paused == paused
+}
+
+// GetPauseInfos is a utility that returns the expiry and reason of all
+// paused actions from state.
+func (k *Keeper) GetPauseInfos(ctx context.Context) ([]v2.PauseInfoEntry, error) {
+	var pauseInfos []v2.PauseInfoEntry
+
+	err := k.PauseInfos.Walk(ctx, nil, func(key collections.Pair[string, int32], info v2.PauseInfo) (stop bool, err error) {
+		pauseInfos = append(pauseInfos, v2.PauseInfoEntry{Module: key.K1(), Action: key.K2(), Info: info})
+		return false, nil
+	})
+
+	return pauseInfos, err
+}
+
+// GetActivePauses is a utility that returns the active pauses of the Noble
+// Dollar and its submodules, one per paused action, alongside their expiry
+// and reason.
+func (k *Keeper) GetActivePauses(ctx context.Context) ([]v2.ActivePause, error) {
+	var pauses []v2.ActivePause
+
+	for _, module := range []string{types.ModuleName, portal.SubmoduleName, vaults.SubmoduleName} {
+		for _, action := range pausedActions(k.getModulePaused(ctx, module)) {
+			info, err := k.PauseInfos.Get(ctx, collections.Join(module, action))
+			if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+				return nil, errors.Wrapf(err, "unable to get pause info of %s from state", module)
+			}
+
+			pauses = append(pauses, v2.ActivePause{Module: module, Paused: pausedActionName(module, action), Info: info})
+		}
+	}
+
+	return pauses, nil
+}
+
+// setPauseInfo is an internal helper function that records the expiry and
+// reason of the newly paused actions of a module, and removes those of its
+// unpaused actions. Actions that were already paused keep their expiry and
+// reason, so that a timed pause never lifts an indefinite one.
+func (k *Keeper) setPauseInfo(ctx context.Context, module string, oldPaused int32, newPaused int32, expiry *time.Time, reason string) error {
+	if newPaused != 0 && expiry != nil && !expiry.After(k.header.GetHeaderInfo(ctx).Time) {
+		return errors.Wrapf(types.ErrInvalidRequest, "pause expiry %s must be in the future", expiry)
+	}
+
+	for _, action := range pausedActions(oldPaused &^ newPaused) {
+		err := k.PauseInfos.Remove(ctx, collections.Join(module, action))
+		if err != nil {
+			return errors.Wrap(err, "unable to remove pause info from state")
+		}
+	}
+
+	for _, action := range pausedActions(newPaused &^ oldPaused) {
+		err := k.PauseInfos.Set(ctx, collections.Join(module, action), v2.PauseInfo{Expiry: expiry, Reason: reason})
+		if err != nil {
+			return errors.Wrap(err, "unable to set pause info in state")
+		}
+
+		err = k.event.EventManager(ctx).Emit(ctx, &v2.PauseInfoSet{
+			Module: module,
+			Expiry: expiry,
+			Reason: reason,
+			Paused: pausedActionName(module, action),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// liftPause is an internal helper function that unpauses a single action of
+// the provided module, leaving its other paused actions untouched.
+func (k *Keeper) liftPause(ctx context.Context, module string, action int32) error {
+	switch module {
+	case types.ModuleName:
+		oldPaused := k.GetPaused(ctx)
+		newPaused := oldPaused &^ v2.PausedType(action)
+		if err := k.Paused.Set(ctx, int32(newPaused)); err != nil {
+			return errors.Wrap(err, "unable to set paused type in state")
+		}
+
+		return k.event.EventManager(ctx).Emit(ctx, &v2.PausedTypeSet{
+			OldPaused: oldPaused,
+			NewPaused: newPaused,
+		})
+	case portal.SubmoduleName:
+		if err := k.PortalPaused.Set(ctx, false); err != nil {
+			return errors.Wrap(err, "unable to set portal paused state in state")
+		}
+
+		return k.event.EventManager(ctx).Emit(ctx, &portal.Unpaused{})
+	case vaults.SubmoduleName:
+		newPaused := k.GetVaultsPaused(ctx) &^ vaults.PausedType(action)
+		if err := k.VaultsPaused.Set(ctx, int32(newPaused)); err != nil {
+			return errors.Wrap(err, "unable to set vaults paused state in state")
+		}
+
+		return k.event.EventManager(ctx).Emit(ctx, &vaults.PausedStateUpdated{
+			Paused: newPaused.String(),
+		})
+	default:
+		return nil
+	}
+}
+
+// getModulePaused is an internal helper function that returns the paused
+// actions of the provided module as a bitmask. As the portal can only be
+// paused as a whole, its paused state is represented as a single action.
+func (k *Keeper) getModulePaused(ctx context.Context, module string) int32 {
+	switch module {
+	case types.ModuleName:
+		return int32(k.GetPaused(ctx))
+	case portal.SubmoduleName:
+		return portalPausedType(k.GetPortalPaused(ctx))
+	case vaults.SubmoduleName:
+		return int32(k.GetVaultsPaused(ctx))
+	default:
+		return 0
+	}
+}
+
+// portalPausedType is an internal helper function that represents the
+// paused state of the portal as a bitmask with a single action.
+func portalPausedType(paused bool) int32 {
+	if paused {
+		return 1
+	}
+
+	return 0
+}
+
+// pausedActions is an internal helper function that splits a bitmask of
+// paused actions into its individual actions.
+func pausedActions(paused int32) []int32 {
+	var actions []int32
+	for action := int32(1); action > 0 && action <= paused; action <<= 1 {
+		if paused&action == action {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// pausedActionName is an internal helper function that returns the name of
+// a single paused action of the provided module.
+func pausedActionName(module string, action int32) string {
+	switch module {
+	case types.ModuleName:
+		return v2.PausedType(action).String()
+	case vaults.SubmoduleName:
+		return vaults.PausedType(action).String()
+	default:
+		return "true"
+	}
+}
+
+// GetEarnerRate is a utility that returns the latest earner rate.
+func (k *Keeper) GetEarnerRate(ctx context.Context) math.LegacyDec {
+	rate, err := k.EarnerRate.Get(ctx)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+
+	return rate
+}
+
+// GetClaimTip is a utility that returns the current claim tip, in basis points.
+func (k *Keeper) GetClaimTip(ctx context.Context) uint32 {
+	tip, _ := k.ClaimTip.Get(ctx)
+	return tip
+}
+
+// GetMIndex is a utility that returns the last attested index of the
+// underlying $M. If not set, the index of $USDN is returned, as both are equal
+// until a fee is taken.
+func (k *Keeper) GetMIndex(ctx context.Context) (int64, error) {
+	index, err := k.MIndex.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return k.Index.Get(ctx)
+	}
+
+	return index, err
+}
+
+// GetAttestedMIndex is a utility that returns the last index of $M that was
+// attested by the portal. If none has been attested yet, the current index of
+// $M is returned instead.
+func (k *Keeper) GetAttestedMIndex(ctx context.Context) (int64, error) {
+	index, err := k.AttestedMIndex.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return k.GetMIndex(ctx)
+	}
+
+	return index, err
+}
+
+// GetFeeRate is a utility that returns the current fee rate, in basis points.
+func (k *Keeper) GetFeeRate(ctx context.Context) uint32 {
+	fee, _ := k.FeeRate.Get(ctx)
+	return fee
+}
+
+// GetFeeCollector is a utility that returns the collector of fees, or an
+// empty value if not set.
+func (k *Keeper) GetFeeCollector(ctx context.Context) string {
+	collector, err := k.FeeCollector.Get(ctx)
+	if err != nil {
+		return ""
+	}
+
+	address, _ := k.address.BytesToString(collector)
+	return address
+}
+
+// SetIndexRecord is a utility that records the provided index in the index
+// history, keyed by the current block time and height, and a sequence that
+// orders multiple index updates within the same block.
+func (k *Keeper) SetIndexRecord(ctx context.Context, index int64) error {
+	headerInfo := k.header.GetHeaderInfo(ctx)
+	sequence, err := nextSequence(ctx, k.IndexHistory, headerInfo.Time.Unix(), headerInfo.Height)
+	if err != nil {
+		return err
+	}
+
+	record := v2.IndexRecord{
+		Index:    index,
+		Height:   headerInfo.Height,
+		Time:     headerInfo.Time,
+		Sequence: sequence,
+	}
+	return k.IndexHistory.Set(ctx, collections.Join3(record.Time.Unix(), record.Height, record.Sequence), record)
+}
+
+// GetIndexRecordAt is a utility that returns the index record that was in
+// effect at the provided time, i.e. the latest record at or before it.
+func (k *Keeper) GetIndexRecordAt(ctx context.Context, at time.Time) (v2.IndexRecord, error) {
+	return k.getFirstIndexRecord(ctx, timeRange(time.Time{}, at).Descending())
+}
+
+// getFirstIndexRecord returns the first index record in the provided range.
+func (k *Keeper) getFirstIndexRecord(ctx context.Context, rng collections.Ranger[collections.Triple[int64, int64, uint64]]) (v2.IndexRecord, error) {
+	iterator, err := k.IndexHistory.Iterate(ctx, rng)
+	if err != nil {
+		return v2.IndexRecord{}, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return v2.IndexRecord{}, collections.ErrNotFound
+	}
+
+	return iterator.Value()
+}
+
+// GetLatestIndexRecord is a utility that returns the most recent index record.
+func (k *Keeper) GetLatestIndexRecord(ctx context.Context) (v2.IndexRecord, error) {
+	return k.getFirstIndexRecord(ctx, new(collections.Range[collections.Triple[int64, int64, uint64]]).Descending())
+}
+
+// GetEarliestIndexRecord is a utility that returns the oldest index record.
+func (k *Keeper) GetEarliestIndexRecord(ctx context.Context) (v2.IndexRecord, error) {
+	return k.getFirstIndexRecord(ctx, nil)
+}
+
+// timeRange is an internal helper function that returns the range of records
+// keyed by a block time between the provided inclusive bounds, each unbounded
+// if not set.
+func timeRange(start time.Time, end time.Time) *collections.Range[collections.Triple[int64, int64, uint64]] {
+	rng := new(collections.Range[collections.Triple[int64, int64, uint64]])
+	if !start.IsZero() {
+		rng.StartInclusive(collections.TriplePrefix[int64, int64, uint64](start.Unix()))
+	}
+	if !end.IsZero() {
+		rng.EndExclusive(collections.TriplePrefix[int64, int64, uint64](end.Unix() + 1))
+	}
+
+	return rng
+}
+
+// nextSequence is an internal helper function that returns the sequence of
+// the next record keyed by the provided block time and height.
+func nextSequence[V any](ctx context.Context, records collections.Map[collections.Triple[int64, int64, uint64], V], time int64, height int64) (uint64, error) {
+	rng := new(collections.Range[collections.Triple[int64, int64, uint64]]).
+		Prefix(collections.TripleSuperPrefix[int64, int64, uint64](time, height)).
+		Descending()
+
+	iterator, err := records.Iterate(ctx, rng)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to iterate records")
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, nil
+	}
+	key, err := iterator.Key()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get record key")
+	}
+
+	return key.K3() + 1, nil
+}
+
+// GetIndexHistory is a utility that returns all index records from state.
+func (k *Keeper) GetIndexHistory(ctx context.Context) ([]v2.IndexRecord, error) {
+	var history []v2.IndexRecord
+
+	err := k.IndexHistory.Walk(ctx, nil, func(_ collections.Triple[int64, int64, uint64], record v2.IndexRecord) (stop bool, err error) {
+		history = append(history, record)
+		return false, nil
+	})
+
+	return history, err
+}
+
+// SetYieldDistribution is a utility that records the provided yield
+// distribution, keyed by its time and height, and a sequence that orders
+// multiple index updates within the same block.
+func (k *Keeper) SetYieldDistribution(ctx context.Context, distribution v2.YieldDistribution) error {
+	sequence, err := nextSequence(ctx, k.YieldDistributions, distribution.Time.Unix(), distribution.Height)
+	if err != nil {
+		return err
+	}
+	distribution.Sequence = sequence
+	key := collections.Join3(distribution.Time.Unix(), distribution.Height, distribution.Sequence)
+
+	return k.YieldDistributions.Set(ctx, key, distribution)
+}
+
+// GetYieldDistributions is a utility that returns all yield distributions from state.
+func (k *Keeper) GetYieldDistributions(ctx context.Context) ([]v2.YieldDistribution, error) {
+	var distributions []v2.YieldDistribution
+
+	err := k.YieldDistributions.Walk(ctx, nil, func(_ collections.Triple[int64, int64, uint64], distribution v2.YieldDistribution) (stop bool, err error) {
+		distributions = append(distributions, distribution)
+		return false, nil
+	})
+
+	return distributions, err
 }
 
 // GetPrincipal is a utility that returns all principal entries from state.
@@ -53,6 +404,132 @@ func (k *Keeper) GetPrincipal(ctx context.Context) (map[string]string, error) {
 	return principal, err
 }
 
+// SetPrincipal is a utility that updates the principal of an account. As an
+// account is considered a holder while its principal is positive, the total
+// holders stat is updated when it crosses zero in either direction.
+func (k *Keeper) SetPrincipal(ctx context.Context, account []byte, oldPrincipal math.Int, newPrincipal math.Int) error {
+	err := k.Principal.Set(ctx, account, newPrincipal)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !oldPrincipal.IsPositive() && newPrincipal.IsPositive():
+		return k.IncrementTotalHolders(ctx)
+	case oldPrincipal.IsPositive() && !newPrincipal.IsPositive():
+		return k.DecrementTotalHolders(ctx)
+	default:
+		return nil
+	}
+}
+
+// ComputeStats is a utility that recomputes the total holders and total
+// principal stats from the principal of all accounts. As the total principal
+// is recomputed exactly, the tracked rounding of issuances is reset. As the
+// total yield accrued stat can't be recomputed, it is carried over from state.
+func (k *Keeper) ComputeStats(ctx context.Context) (v2.Stats, error) {
+	stats, err := k.Stats.Get(ctx)
+	if err != nil {
+		return v2.Stats{}, err
+	}
+
+	stats.TotalHolders = 0
+	stats.TotalPrincipal = math.ZeroInt()
+	stats.TotalPrincipalRounding = math.ZeroInt()
+	err = k.Principal.Walk(ctx, nil, func(_ []byte, principal math.Int) (stop bool, err error) {
+		if principal.IsPositive() {
+			stats.TotalHolders += 1
+		}
+		stats.TotalPrincipal = stats.TotalPrincipal.Add(principal)
+		return false, nil
+	})
+
+	return stats, err
+}
+
+// YieldLiability is the outstanding yield of all accounts at an index.
+type YieldLiability struct {
+	// Liability is the claimable yield of all accounts.
+	Liability math.Int
+	// Rounding is the yield that isn't claimable, as GetYield excludes a
+	// single unit of yield.
+	Rounding math.Int
+	// Excess is the sum of balances in excess of the present amount of their
+	// principal, which is netted against future yield.
+	Excess math.Int
+	// Accounts is the amount of accounts with principal.
+	Accounts int64
+}
+
+// ComputeYieldLiability is a utility that sums the outstanding yield of all
+// accounts at the provided index, in the same way as GetYield. As a single
+// unit of yield isn't claimable, it is excluded from the liability and summed
+// separately as rounding.
+func (k *Keeper) ComputeYieldLiability(ctx context.Context, index int64) (YieldLiability, error) {
+	res := YieldLiability{
+		Liability: math.ZeroInt(),
+		Rounding:  math.ZeroInt(),
+		Excess:    math.ZeroInt(),
+	}
+	err := k.Principal.Walk(ctx, nil, func(account []byte, principal math.Int) (stop bool, err error) {
+		balance := k.bank.GetBalance(ctx, account, k.denom).Amount
+		yield := k.GetPresentAmount(principal, index).Sub(balance)
+		switch {
+		case yield.Equal(math.OneInt()):
+			res.Rounding = res.Rounding.Add(yield)
+		case yield.IsPositive():
+			res.Liability = res.Liability.Add(yield)
+		default:
+			res.Excess = res.Excess.Sub(yield)
+		}
+		res.Accounts += 1
+		return false, nil
+	})
+	if err != nil {
+		return YieldLiability{}, errors.Wrap(err, "unable to get principal from state")
+	}
+
+	return res, nil
+}
+
+// reconcileStats is an internal helper function that overwrites the core and
+// vaults stats with values recomputed from state, emitting the difference.
+func (k *Keeper) reconcileStats(ctx context.Context) error {
+	oldStats, err := k.Stats.Get(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get stats from state")
+	}
+	newStats, err := k.ComputeStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to compute stats")
+	}
+
+	oldVaultsStats, err := k.GetVaultsStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get vaults stats from state")
+	}
+	newVaultsStats, err := k.ComputeVaultsStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to compute vaults stats")
+	}
+
+	err = k.Stats.Set(ctx, newStats)
+	if err != nil {
+		return errors.Wrap(err, "unable to set stats in state")
+	}
+	err = k.VaultsStats.Set(ctx, newVaultsStats)
+	if err != nil {
+		return errors.Wrap(err, "unable to set vaults stats in state")
+	}
+
+	return k.event.EventManager(ctx).Emit(ctx, &v2.StatsReconciled{
+		OldStats:       oldStats,
+		NewStats:       newStats,
+		OldVaultsStats: oldVaultsStats,
+		NewVaultsStats: newVaultsStats,
+	})
+}
+
 // DecrementTotalHolders is a utility that decrements the total holders stat.
 func (k *Keeper) DecrementTotalHolders(ctx context.Context) error {
 	stats, err := k.Stats.Get(ctx)
@@ -119,6 +596,25 @@ func (k *Keeper) IncrementTotalPrincipal(ctx context.Context, amount math.Int) e
 	return k.Stats.Set(ctx, stats)
 }
 
+// IncrementTotalPrincipalRounding is a utility that increments the total principal rounding stat.
+func (k *Keeper) IncrementTotalPrincipalRounding(ctx context.Context, amount math.Int) error {
+	stats, err := k.Stats.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !stats.TotalPrincipalRounding.IsNil() {
+		stats.TotalPrincipalRounding, err = stats.TotalPrincipalRounding.SafeAdd(amount)
+		if err != nil {
+			return err
+		}
+	} else {
+		stats.TotalPrincipalRounding = amount
+	}
+
+	return k.Stats.Set(ctx, stats)
+}
+
 // IncrementTotalYieldAccrued is a utility that increments the total yield accrued stat.
 func (k *Keeper) IncrementTotalYieldAccrued(ctx context.Context, amount math.Int) error {
 	stats, err := k.Stats.Get(ctx)
@@ -134,6 +630,44 @@ func (k *Keeper) IncrementTotalYieldAccrued(ctx context.Context, amount math.Int
 	return k.Stats.Set(ctx, stats)
 }
 
+// IncrementTotalFeesAccrued is a utility that increments the total fees accrued stat.
+func (k *Keeper) IncrementTotalFeesAccrued(ctx context.Context, amount math.Int) error {
+	stats, err := k.Stats.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !stats.TotalFeesAccrued.IsNil() {
+		stats.TotalFeesAccrued, err = stats.TotalFeesAccrued.SafeAdd(amount)
+		if err != nil {
+			return err
+		}
+	} else {
+		stats.TotalFeesAccrued = amount
+	}
+
+	return k.Stats.Set(ctx, stats)
+}
+
+// IncrementTotalHaircutBurned is a utility that increments the total haircut burned stat.
+func (k *Keeper) IncrementTotalHaircutBurned(ctx context.Context, amount math.Int) error {
+	stats, err := k.Stats.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !stats.TotalHaircutBurned.IsNil() {
+		stats.TotalHaircutBurned, err = stats.TotalHaircutBurned.SafeAdd(amount)
+		if err != nil {
+			return err
+		}
+	} else {
+		stats.TotalHaircutBurned = amount
+	}
+
+	return k.Stats.Set(ctx, stats)
+}
+
 // IncrementTotalExternalYield is a utility that increments the total external yield stat.
 func (k *Keeper) IncrementTotalExternalYield(ctx context.Context, provider v2.Provider, identifier string, amount math.Int) error {
 	key := collections.Join(int32(provider), identifier)
@@ -200,6 +734,111 @@ func (k *Keeper) HasYieldRecipient(ctx context.Context, provider v2.Provider, id
 	return has
 }
 
+// IsFrozen is a utility that returns if an account is frozen.
+func (k *Keeper) IsFrozen(ctx context.Context, account []byte) bool {
+	has, _ := k.FrozenAccounts.Has(ctx, account)
+	return has
+}
+
+// GetYieldClaimRecipients is a utility that returns all yield claim recipients from state.
+func (k *Keeper) GetYieldClaimRecipients(ctx context.Context) (map[string]string, error) {
+	yieldClaimRecipients := make(map[string]string)
+
+	err := k.YieldClaimRecipients.Walk(ctx, nil, func(key []byte, value []byte) (stop bool, err error) {
+		account, err := k.address.BytesToString(key)
+		if err != nil {
+			return false, err
+		}
+		recipient, err := k.address.BytesToString(value)
+		if err != nil {
+			return false, err
+		}
+
+		yieldClaimRecipients[account] = recipient
+		return false, nil
+	})
+
+	return yieldClaimRecipients, err
+}
+
+// GetYieldSplits is a utility that returns all yield splits from state.
+func (k *Keeper) GetYieldSplits(ctx context.Context) (map[string]v2.YieldSplit, error) {
+	yieldSplits := make(map[string]v2.YieldSplit)
+
+	err := k.YieldSplits.Walk(ctx, nil, func(key []byte, value v2.YieldSplit) (stop bool, err error) {
+		account, err := k.address.BytesToString(key)
+		if err != nil {
+			return false, err
+		}
+
+		yieldSplits[account] = value
+		return false, nil
+	})
+
+	return yieldSplits, err
+}
+
+// GetAutoClaimAccounts is a utility that returns all auto claim accounts from state.
+func (k *Keeper) GetAutoClaimAccounts(ctx context.Context) ([]string, error) {
+	var autoClaimAccounts []string
+
+	err := k.AutoClaimAccounts.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
+		account, err := k.address.BytesToString(key)
+		if err != nil {
+			return false, err
+		}
+
+		autoClaimAccounts = append(autoClaimAccounts, account)
+		return false, nil
+	})
+
+	return autoClaimAccounts, err
+}
+
+// GetAutoClaimCursor is a utility that returns whether an auto claim pass is
+// in progress, and the last account processed in it.
+func (k *Keeper) GetAutoClaimCursor(ctx context.Context) (bool, string) {
+	cursor, err := k.AutoClaimCursor.Get(ctx)
+	if err != nil {
+		return false, ""
+	}
+	if len(cursor) == 0 {
+		return true, ""
+	}
+
+	account, _ := k.address.BytesToString(cursor)
+	return true, account
+}
+
+// GetNonEarningAccounts is a utility that returns all non-earning accounts from state.
+func (k *Keeper) GetNonEarningAccounts(ctx context.Context) ([]string, error) {
+	var nonEarningAccounts []string
+
+	err := k.NonEarningAccounts.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
+		account, err := k.address.BytesToString(key)
+		if err != nil {
+			return false, err
+		}
+
+		nonEarningAccounts = append(nonEarningAccounts, account)
+		return false, nil
+	})
+
+	return nonEarningAccounts, err
+}
+
+// GetTreasury is a utility that returns the treasury that the yield of
+// non-earning accounts is swept to, or an empty value if not set.
+func (k *Keeper) GetTreasury(ctx context.Context) string {
+	treasury, err := k.Treasury.Get(ctx)
+	if err != nil {
+		return ""
+	}
+
+	address, _ := k.address.BytesToString(treasury)
+	return address
+}
+
 // GetRetryAmounts is a utility that returns all retry amounts from state.
 func (k *Keeper) GetRetryAmounts(ctx context.Context) (map[string]string, error) {
 	retryAmounts := make(map[string]string)
@@ -246,3 +885,76 @@ func (k *Keeper) IncrementRetryAmount(ctx context.Context, provider v2.Provider,
 	key := collections.Join(int32(provider), identifier)
 	return k.RetryAmounts.Set(ctx, key, retryAmount)
 }
+
+// GetFrozenAccounts is a utility that returns all frozen accounts from state.
+func (k *Keeper) GetFrozenAccounts(ctx context.Context) ([]string, error) {
+	var frozenAccounts []string
+
+	err := k.FrozenAccounts.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
+		account, err := k.address.BytesToString(key)
+		if err != nil {
+			return false, err
+		}
+
+		frozenAccounts = append(frozenAccounts, account)
+		return false, nil
+	})
+
+	return frozenAccounts, err
+}
+
+// HasRole is a utility that returns if an account has been granted a role.
+func (k *Keeper) HasRole(ctx context.Context, account []byte, role v2.Role) bool {
+	has, _ := k.Roles.Has(ctx, collections.Join(account, int32(role)))
+	return has
+}
+
+// IsAuthorized is a utility that returns if a signer is either the authority,
+// or an account that has been granted one of the provided roles.
+func (k *Keeper) IsAuthorized(ctx context.Context, signer string, roles ...v2.Role) bool {
+	if signer == k.authority {
+		return true
+	}
+
+	account, err := k.address.StringToBytes(signer)
+	if err != nil {
+		return false
+	}
+	for _, role := range roles {
+		if k.HasRole(ctx, account, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRoles is a utility that returns all roles granted to accounts from state.
+func (k *Keeper) GetRoles(ctx context.Context) ([]v2.RoleGrant, error) {
+	var roles []v2.RoleGrant
+
+	err := k.Roles.Walk(ctx, nil, func(key collections.Pair[[]byte, int32]) (stop bool, err error) {
+		account, err := k.address.BytesToString(key.K1())
+		if err != nil {
+			return false, err
+		}
+
+		roles = append(roles, v2.RoleGrant{Account: account, Role: v2.Role(key.K2())})
+		return false, nil
+	})
+
+	return roles, err
+}
+
+// GetAccountRoles is a utility that returns all roles granted to an account.
+func (k *Keeper) GetAccountRoles(ctx context.Context, account []byte) ([]v2.Role, error) {
+	var roles []v2.Role
+
+	rng := collections.NewPrefixedPairRange[[]byte, int32](account)
+	err := k.Roles.Walk(ctx, rng, func(key collections.Pair[[]byte, int32]) (stop bool, err error) {
+		roles = append(roles, v2.Role(key.K2()))
+		return false, nil
+	})
+
+	return roles, err
+}
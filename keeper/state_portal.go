@@ -29,6 +29,12 @@ import (
 	"dollar.noble.xyz/v2/types/portal"
 )
 
+// GetPortalPendingOwner is a utility that returns the current pending owner.
+func (k *Keeper) GetPortalPendingOwner(ctx context.Context) string {
+	pendingOwner, _ := k.PortalPendingOwner.Get(ctx)
+	return pendingOwner
+}
+
 // GetPortalPaused is a utility that returns the current paused state.
 func (k *Keeper) GetPortalPaused(ctx context.Context) bool {
 	paused, _ := k.PortalPaused.Get(ctx)
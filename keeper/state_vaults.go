@@ -22,6 +22,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -195,6 +196,43 @@ func (k *Keeper) GetVaultsStats(ctx context.Context) (vaults.Stats, error) {
 	return stats, nil
 }
 
+// ComputeVaultsStats is a utility that recomputes the total users and total
+// principal stats of each vault from all positions. As the total distributed
+// rewards stat can't be recomputed, it is carried over from state.
+func (k *Keeper) ComputeVaultsStats(ctx context.Context) (vaults.Stats, error) {
+	stats, err := k.GetVaultsStats(ctx)
+	if err != nil {
+		return vaults.Stats{}, err
+	}
+
+	stats.FlexibleTotalUsers, stats.StakedTotalUsers = 0, 0
+	stats.FlexibleTotalPrincipal, stats.StakedTotalPrincipal = math.ZeroInt(), math.ZeroInt()
+
+	users := make(map[string]bool)
+	err = k.VaultsPositions.Walk(ctx, nil, func(key collections.Triple[[]byte, int32, int64], position vaults.Position) (stop bool, err error) {
+		user := fmt.Sprintf("%x/%d", key.K1(), key.K2())
+		isNewUser := !users[user]
+		users[user] = true
+
+		switch vaults.VaultType(key.K2()) {
+		case vaults.STAKED:
+			stats.StakedTotalPrincipal = stats.StakedTotalPrincipal.Add(position.Principal)
+			if isNewUser {
+				stats.StakedTotalUsers += 1
+			}
+		case vaults.FLEXIBLE:
+			stats.FlexibleTotalPrincipal = stats.FlexibleTotalPrincipal.Add(position.Principal)
+			if isNewUser {
+				stats.FlexibleTotalUsers += 1
+			}
+		}
+
+		return false, nil
+	})
+
+	return stats, err
+}
+
 // IncrementVaultUsers is a utility that increments the total vault users stat.
 func (k *Keeper) IncrementVaultUsers(ctx context.Context, vault vaults.VaultType) error {
 	stats, err := k.GetVaultsStats(ctx)
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"dollar.noble.xyz/v2/types"
+	"dollar.noble.xyz/v2/types/portal"
+	"dollar.noble.xyz/v2/types/v2"
+)
+
+// TimelockedMessagesIndexes contains the indexes of the timelocked messages.
+type TimelockedMessagesIndexes struct {
+	ByExecutionTime *indexes.Multi[int64, uint64, v2.TimelockedMessage]
+}
+
+func (i TimelockedMessagesIndexes) IndexesList() []collections.Index[uint64, v2.TimelockedMessage] {
+	return []collections.Index[uint64, v2.TimelockedMessage]{
+		i.ByExecutionTime,
+	}
+}
+
+func NewTimelockedMessagesIndexes(builder *collections.SchemaBuilder) TimelockedMessagesIndexes {
+	return TimelockedMessagesIndexes{
+		ByExecutionTime: indexes.NewMulti(
+			builder, types.TimelockedMessageByExecutionTimePrefix, "timelocked_messages_by_execution_time",
+			collections.Int64Key,
+			collections.Uint64Key,
+			func(_ uint64, message v2.TimelockedMessage) (int64, error) {
+				return message.ExecutionTime.UnixNano(), nil
+			},
+		),
+	}
+}
+
+// timelockExecutionKey is the context key that marks a timelocked message as
+// being executed from the queue, so that it isn't queued again.
+type timelockExecutionKey struct{}
+
+// GetTimelockDelay is a utility that returns the delay after which timelocked
+// admin messages are executed.
+func (k *Keeper) GetTimelockDelay(ctx context.Context) time.Duration {
+	delay, _ := k.TimelockDelay.Get(ctx)
+	return time.Duration(delay)
+}
+
+// GetTimelockedMessages is a utility that returns all pending timelocked
+// admin messages from state.
+func (k *Keeper) GetTimelockedMessages(ctx context.Context) ([]v2.TimelockedMessage, error) {
+	var messages []v2.TimelockedMessage
+
+	err := k.TimelockedMessages.Walk(ctx, nil, func(_ uint64, message v2.TimelockedMessage) (stop bool, err error) {
+		messages = append(messages, message)
+		return false, nil
+	})
+
+	return messages, err
+}
+
+// timelock is an internal helper function that queues an admin message for
+// execution once the timelock delay has passed. It returns false if the
+// message should instead be executed immediately, either because no delay is
+// configured or because the message is being executed from the queue.
+func (k *Keeper) timelock(ctx context.Context, msg sdk.Msg) (bool, error) {
+	delay := k.GetTimelockDelay(ctx)
+	if delay == 0 || ctx.Value(timelockExecutionKey{}) != nil {
+		return false, nil
+	}
+
+	id, err := k.TimelockNonce.Next(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get next timelock nonce")
+	}
+	packed, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to pack timelocked message")
+	}
+	executionTime := k.header.GetHeaderInfo(ctx).Time.Add(delay)
+
+	err = k.TimelockedMessages.Set(ctx, id, v2.TimelockedMessage{
+		Id:            id,
+		Msg:           packed,
+		ExecutionTime: executionTime,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set timelocked message in state")
+	}
+
+	return true, k.event.EventManager(ctx).Emit(ctx, &v2.MessageTimelocked{
+		Id:            id,
+		TypeUrl:       packed.TypeUrl,
+		ExecutionTime: executionTime,
+	})
+}
+
+// processTimelockedMessages is an internal helper function that executes all
+// timelocked admin messages whose execution time has been reached. Messages
+// that fail to execute, e.g. because the signer is no longer authorized, are
+// discarded. Only mature messages are iterated, by ranging over the execution
+// time index.
+func (k *Keeper) processTimelockedMessages(ctx context.Context) error {
+	now := k.header.GetHeaderInfo(ctx).Time
+
+	itr, err := k.TimelockedMessages.Indexes.ByExecutionTime.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, uint64](now.UnixNano()))
+	if err != nil {
+		return errors.Wrap(err, "unable to iterate timelocked messages")
+	}
+	ids, err := itr.PrimaryKeys()
+	if err != nil {
+		return errors.Wrap(err, "unable to get mature timelocked messages")
+	}
+
+	for _, id := range ids {
+		message, err := k.TimelockedMessages.Get(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "unable to get timelocked message %d from state", id)
+		}
+		if err := k.TimelockedMessages.Remove(ctx, id); err != nil {
+			return errors.Wrapf(err, "unable to remove timelocked message %d from state", id)
+		}
+
+		event := protoiface.MessageV1(&v2.TimelockedMessageExecuted{Id: message.Id})
+		if err := k.executeTimelockedMessage(ctx, message); err != nil {
+			event = &v2.TimelockedMessageFailed{Id: message.Id, Error: err.Error()}
+		}
+		if err := k.event.EventManager(ctx).Emit(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeTimelockedMessage is an internal helper function that executes a
+// timelocked admin message in a cached context, only persisting its state
+// changes and events if it succeeds. The message is fully revalidated on
+// execution.
+func (k *Keeper) executeTimelockedMessage(ctx context.Context, message v2.TimelockedMessage) error {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(message.Msg, &msg); err != nil {
+		return errors.Wrap(err, "unable to unpack timelocked message")
+	}
+
+	cacheCtx, writeCache := cacheContext(ctx)
+	cacheCtx = cacheCtx.WithValue(timelockExecutionKey{}, true)
+
+	var err error
+	switch msg := msg.(type) {
+	case *v2.MsgSetYieldRecipient:
+		_, err = NewMsgServerV2(k).SetYieldRecipient(cacheCtx, msg)
+	case *portal.MsgSetPeer:
+		_, err = NewPortalMsgServer(k).SetPeer(cacheCtx, msg)
+	case *portal.MsgSetBridgingPath:
+		_, err = NewPortalMsgServer(k).SetBridgingPath(cacheCtx, msg)
+	case *portal.MsgTransferOwnership:
+		_, err = NewPortalMsgServer(k).TransferOwnership(cacheCtx, msg)
+	default:
+		err = errors.Wrapf(types.ErrInvalidRequest, "unsupported timelocked message %s", message.Msg.TypeUrl)
+	}
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
@@ -57,7 +57,7 @@ import (
 )
 
 // ConsensusVersion defines the current Noble Dollar module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -66,6 +66,8 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasGenesisBasics    = AppModuleBasic{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
+	_ module.HasInvariants       = AppModule{}
 )
 
 //
@@ -139,6 +141,14 @@ func (AppModule) IsAppModule() {}
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+func (m AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, m.keeper)
+}
+
+func (m AppModule) BeginBlock(ctx context.Context) error {
+	return m.keeper.BeginBlocker(ctx)
+}
+
 func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
 	var genesis v2.GenesisState
 	cdc.MustUnmarshalJSON(bz, &genesis)
@@ -167,6 +177,9 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate Noble Dollar from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate Noble Dollar from version 2 to 3: %v", err))
+	}
 }
 
 //
@@ -238,6 +251,19 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Use:            "transfer-ownership [new-owner]",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_owner"}},
 						},
+						{
+							RpcMethod: "AcceptOwnership",
+							Use:       "accept-ownership",
+						},
+						{
+							RpcMethod: "CancelOwnershipTransfer",
+							Use:       "cancel-ownership-transfer",
+						},
+						{
+							RpcMethod:      "SetOwner",
+							Use:            "set-owner [owner]",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+						},
 					},
 				},
 				"vaults": {
@@ -302,6 +328,10 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							RpcMethod: "Owner",
 							Use:       "owner",
 						},
+						{
+							RpcMethod: "PendingOwner",
+							Use:       "pending-owner",
+						},
 						{
 							RpcMethod: "Paused",
 							Use:       "paused",
@@ -23,8 +23,16 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrInvalidRequest   = errors.Register(ModuleName, 0, "invalid request")
-	ErrInvalidAuthority = errors.Register(ModuleName, 1, "invalid authority")
-	ErrPaused           = errors.Register(ModuleName, 2, "module is paused")
-	ErrDecreasingIndex  = errors.Register(ModuleName, 3, "decreasing index")
+	ErrInvalidRequest    = errors.Register(ModuleName, 0, "invalid request")
+	ErrInvalidAuthority  = errors.Register(ModuleName, 1, "invalid authority")
+	ErrPaused            = errors.Register(ModuleName, 2, "module is paused")
+	ErrDecreasingIndex   = errors.Register(ModuleName, 3, "decreasing index")
+	ErrInvalidRecipient  = errors.Register(ModuleName, 4, "invalid recipient")
+	ErrInvalidClaimTip   = errors.Register(ModuleName, 5, "invalid claim tip")
+	ErrNonEarning        = errors.Register(ModuleName, 6, "account is non-earning")
+	ErrInvalidFee        = errors.Register(ModuleName, 7, "invalid fee")
+	ErrFrozen            = errors.Register(ModuleName, 8, "account is frozen")
+	ErrInvalidPausedType = errors.Register(ModuleName, 9, "invalid paused type")
+	ErrInvalidRole       = errors.Register(ModuleName, 10, "invalid role")
+	ErrInvalidIndex      = errors.Register(ModuleName, 11, "invalid index")
 )
@@ -30,6 +30,7 @@ import (
 )
 
 type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetModuleAccount(ctx context.Context, macc sdk.ModuleAccountI)
 	NewAccount(ctx context.Context, acc sdk.AccountI) sdk.AccountI
 }
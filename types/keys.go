@@ -36,11 +36,39 @@ var (
 )
 
 var (
-	PausedKey                = []byte("paused")
-	IndexKey                 = []byte("index")
-	PrincipalPrefix          = []byte("principal/")
-	StatsKey                 = []byte("stats")
-	TotalExternalYieldPrefix = []byte("total_external_yield/")
-	YieldRecipientPrefix     = []byte("yield_recipient/")
-	RetryAmountPrefix        = []byte("retry_amount/")
+	PausedKey                              = []byte("paused")
+	IndexKey                               = []byte("index")
+	PrincipalPrefix                        = []byte("principal/")
+	StatsKey                               = []byte("stats")
+	TotalExternalYieldPrefix               = []byte("total_external_yield/")
+	YieldRecipientPrefix                   = []byte("yield_recipient/")
+	RetryAmountPrefix                      = []byte("retry_amount/")
+	IndexHistoryPrefix                     = []byte("history/")
+	EarnerRateKey                          = []byte("earner_rate")
+	YieldClaimRecipientPrefix              = []byte("yield_claim_recipient/")
+	ClaimTipKey                            = []byte("claim_tip")
+	YieldSplitPrefix                       = []byte("yield_split/")
+	AutoClaimAccountPrefix                 = []byte("auto_claim/")
+	AutoClaimCursorKey                     = []byte("auto_claim_cursor")
+	NonEarningAccountPrefix                = []byte("non_earning/")
+	TreasuryKey                            = []byte("treasury")
+	MIndexKey                              = []byte("m_index")
+	AttestedMIndexKey                      = []byte("attested_m_index")
+	FeeRateKey                             = []byte("fee_rate")
+	FeeCollectorKey                        = []byte("fee_collector")
+	FrozenAccountPrefix                    = []byte("frozen/")
+	PauseInfoPrefix                        = []byte("pause_info/")
+	RolePrefix                             = []byte("role/")
+	TimelockDelayKey                       = []byte("timelock_delay")
+	TimelockNonceKey                       = []byte("timelock_nonce")
+	TimelockedMessagePrefix                = []byte("timelock/")
+	TimelockedMessageByExecutionTimePrefix = []byte("timelock_by_execution_time/")
+	MaxYieldRateKey                        = []byte("max_yield_rate")
+	PendingIndexKey                        = []byte("pending_index")
+	IndexStalenessKey                      = []byte("staleness")
+	IndexStaleKey                          = []byte("stale_flag")
+	IndexHistoryStartKey                   = []byte("history_start")
+	HaircutModeKey                         = []byte("haircut_mode")
+	HaircutFactorKey                       = []byte("haircut_factor")
+	YieldDistributionPrefix                = []byte("yield_distribution/")
 )
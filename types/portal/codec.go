@@ -34,6 +34,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetPeer{}, "dollar/portal/SetPeer", nil)
 	cdc.RegisterConcrete(&MsgSetBridgingPath{}, "dollar/portal/SetBridgingPath", nil)
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "dollar/portal/TransferOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "dollar/portal/AcceptOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelOwnershipTransfer{}, "dollar/portal/CancelOwnershipTransfer", nil)
+	cdc.RegisterConcrete(&MsgSetOwner{}, "dollar/portal/SetOwner", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -45,6 +48,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSetPeer{},
 		&MsgSetBridgingPath{},
 		&MsgTransferOwnership{},
+		&MsgAcceptOwnership{},
+		&MsgCancelOwnershipTransfer{},
+		&MsgSetOwner{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
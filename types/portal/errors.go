@@ -31,4 +31,6 @@ var (
 	ErrInvalidBridgePath = errors.Register(SubmoduleName, 6, "invalid bridge path")
 	ErrInvalidMessage    = errors.Register(SubmoduleName, 7, "invalid message")
 	ErrInvalidRecipient  = errors.Register(SubmoduleName, 8, "invalid recipient")
+	ErrNoPendingOwner    = errors.Register(SubmoduleName, 9, "there is no pending owner")
+	ErrNotPendingOwner   = errors.Register(SubmoduleName, 10, "signer is not pending owner")
 )
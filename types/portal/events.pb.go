@@ -426,6 +426,218 @@ func (m *BridgingPathSet) GetSupported() bool {
 	return false
 }
 
+// OwnershipTransferStarted is an event emitted whenever the owner starts an ownership transfer.
+type OwnershipTransferStarted struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+}
+
+func (m *OwnershipTransferStarted) Reset()         { *m = OwnershipTransferStarted{} }
+func (m *OwnershipTransferStarted) String() string { return proto.CompactTextString(m) }
+func (*OwnershipTransferStarted) ProtoMessage()    {}
+func (*OwnershipTransferStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_878c0cf9b5833b22, []int{6}
+}
+func (m *OwnershipTransferStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipTransferStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipTransferStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipTransferStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipTransferStarted.Merge(m, src)
+}
+func (m *OwnershipTransferStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipTransferStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipTransferStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipTransferStarted proto.InternalMessageInfo
+
+func (m *OwnershipTransferStarted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnershipTransferStarted) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+// OwnershipTransferCancelled is an event emitted whenever the owner cancels a pending ownership transfer.
+type OwnershipTransferCancelled struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner string `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+}
+
+func (m *OwnershipTransferCancelled) Reset()         { *m = OwnershipTransferCancelled{} }
+func (m *OwnershipTransferCancelled) String() string { return proto.CompactTextString(m) }
+func (*OwnershipTransferCancelled) ProtoMessage()    {}
+func (*OwnershipTransferCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_878c0cf9b5833b22, []int{7}
+}
+func (m *OwnershipTransferCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipTransferCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipTransferCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipTransferCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipTransferCancelled.Merge(m, src)
+}
+func (m *OwnershipTransferCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipTransferCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipTransferCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipTransferCancelled proto.InternalMessageInfo
+
+func (m *OwnershipTransferCancelled) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnershipTransferCancelled) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+// OwnershipReassigned is an event emitted whenever the authority forcibly reassigns the ownership.
+type OwnershipReassigned struct {
+	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *OwnershipReassigned) Reset()         { *m = OwnershipReassigned{} }
+func (m *OwnershipReassigned) String() string { return proto.CompactTextString(m) }
+func (*OwnershipReassigned) ProtoMessage()    {}
+func (*OwnershipReassigned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_878c0cf9b5833b22, []int{8}
+}
+func (m *OwnershipReassigned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipReassigned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipReassigned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipReassigned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipReassigned.Merge(m, src)
+}
+func (m *OwnershipReassigned) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipReassigned) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipReassigned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipReassigned proto.InternalMessageInfo
+
+func (m *OwnershipReassigned) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *OwnershipReassigned) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// GovernanceOverride is an event emitted whenever the authority executes an owner-gated message in place of the owner.
+type GovernanceOverride struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *GovernanceOverride) Reset()         { *m = GovernanceOverride{} }
+func (m *GovernanceOverride) String() string { return proto.CompactTextString(m) }
+func (*GovernanceOverride) ProtoMessage()    {}
+func (*GovernanceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_878c0cf9b5833b22, []int{9}
+}
+func (m *GovernanceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceOverride.Merge(m, src)
+}
+func (m *GovernanceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceOverride proto.InternalMessageInfo
+
+func (m *GovernanceOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *GovernanceOverride) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // OwnershipTransferred is an event emitted whenever an ownership transfer occurs.
 type OwnershipTransferred struct {
 	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
@@ -436,7 +648,7 @@ func (m *OwnershipTransferred) Reset()         { *m = OwnershipTransferred{} }
 func (m *OwnershipTransferred) String() string { return proto.CompactTextString(m) }
 func (*OwnershipTransferred) ProtoMessage()    {}
 func (*OwnershipTransferred) Descriptor() ([]byte, []int) {
-	return fileDescriptor_878c0cf9b5833b22, []int{6}
+	return fileDescriptor_878c0cf9b5833b22, []int{10}
 }
 func (m *OwnershipTransferred) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +700,7 @@ func (m *Paused) Reset()         { *m = Paused{} }
 func (m *Paused) String() string { return proto.CompactTextString(m) }
 func (*Paused) ProtoMessage()    {}
 func (*Paused) Descriptor() ([]byte, []int) {
-	return fileDescriptor_878c0cf9b5833b22, []int{7}
+	return fileDescriptor_878c0cf9b5833b22, []int{11}
 }
 func (m *Paused) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +738,7 @@ func (m *Unpaused) Reset()         { *m = Unpaused{} }
 func (m *Unpaused) String() string { return proto.CompactTextString(m) }
 func (*Unpaused) ProtoMessage()    {}
 func (*Unpaused) Descriptor() ([]byte, []int) {
-	return fileDescriptor_878c0cf9b5833b22, []int{8}
+	return fileDescriptor_878c0cf9b5833b22, []int{12}
 }
 func (m *Unpaused) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,6 +774,10 @@ func init() {
 	proto.RegisterType((*USDNTokenSent)(nil), "noble.dollar.portal.v1.USDNTokenSent")
 	proto.RegisterType((*PeerUpdated)(nil), "noble.dollar.portal.v1.PeerUpdated")
 	proto.RegisterType((*BridgingPathSet)(nil), "noble.dollar.portal.v1.BridgingPathSet")
+	proto.RegisterType((*OwnershipTransferStarted)(nil), "noble.dollar.portal.v1.OwnershipTransferStarted")
+	proto.RegisterType((*OwnershipTransferCancelled)(nil), "noble.dollar.portal.v1.OwnershipTransferCancelled")
+	proto.RegisterType((*OwnershipReassigned)(nil), "noble.dollar.portal.v1.OwnershipReassigned")
+	proto.RegisterType((*GovernanceOverride)(nil), "noble.dollar.portal.v1.GovernanceOverride")
 	proto.RegisterType((*OwnershipTransferred)(nil), "noble.dollar.portal.v1.OwnershipTransferred")
 	proto.RegisterType((*Paused)(nil), "noble.dollar.portal.v1.Paused")
 	proto.RegisterType((*Unpaused)(nil), "noble.dollar.portal.v1.Unpaused")
@@ -572,51 +788,58 @@ func init() {
 }
 
 var fileDescriptor_878c0cf9b5833b22 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x4f, 0x53, 0x4b,
-	0x14, 0xee, 0x6d, 0x5f, 0x4b, 0x7b, 0xa0, 0x05, 0x6e, 0xfa, 0x48, 0x1f, 0x0f, 0x4a, 0xdf, 0x7d,
-	0xef, 0x29, 0xc1, 0xd8, 0x2b, 0x9a, 0xb8, 0x30, 0x2e, 0x4c, 0x65, 0x61, 0x17, 0x28, 0xb9, 0xc0,
-	0x86, 0x4d, 0x33, 0x74, 0x8e, 0xed, 0x84, 0xdb, 0x99, 0x9b, 0x3b, 0xd3, 0x5b, 0xf0, 0x57, 0xb8,
-	0xe6, 0x17, 0xb8, 0x74, 0xe1, 0x7f, 0x90, 0x25, 0x71, 0x61, 0x8c, 0x0b, 0x62, 0x60, 0xe1, 0x7f,
-	0x70, 0x65, 0x66, 0xe6, 0x22, 0x55, 0x41, 0x13, 0xe3, 0xa6, 0x99, 0xf9, 0xce, 0x37, 0x27, 0xf7,
-	0xfb, 0xbe, 0xd3, 0x03, 0xff, 0x72, 0xb1, 0x1b, 0xa2, 0x4f, 0x45, 0x18, 0x92, 0xd8, 0x8f, 0x44,
-	0xac, 0x48, 0xe8, 0x27, 0xab, 0x3e, 0x26, 0xc8, 0x95, 0x6c, 0x46, 0xb1, 0x50, 0xc2, 0x9d, 0x33,
-	0xa4, 0xa6, 0x25, 0x35, 0x2d, 0xa9, 0x99, 0xac, 0xce, 0xcf, 0x92, 0x01, 0xe3, 0xc2, 0x37, 0xbf,
-	0x96, 0x3a, 0xff, 0x57, 0x57, 0xc8, 0x81, 0x90, 0x1d, 0x73, 0xf3, 0xed, 0x25, 0x2d, 0x55, 0x7b,
-	0xa2, 0x27, 0x2c, 0xae, 0x4f, 0x16, 0xf5, 0x16, 0xa1, 0xb4, 0x86, 0x21, 0x4b, 0x30, 0x46, 0xea,
-	0xce, 0x40, 0x2e, 0x21, 0xa4, 0xe6, 0x34, 0x9c, 0xe5, 0xa9, 0x40, 0x1f, 0xbd, 0xc3, 0x2c, 0x54,
-	0xd6, 0xb7, 0xc4, 0x1e, 0xf2, 0x00, 0xbb, 0xc8, 0x12, 0xa4, 0xee, 0x35, 0x98, 0x96, 0x62, 0x18,
-	0x77, 0xb1, 0xd3, 0xed, 0x13, 0xc6, 0x3b, 0x8c, 0x9a, 0x07, 0xe5, 0xa0, 0x6c, 0xe1, 0x87, 0x1a,
-	0x6d, 0x53, 0xf7, 0x06, 0xcc, 0x52, 0x94, 0x8a, 0x71, 0xa2, 0x98, 0xe0, 0x1d, 0xa5, 0x9b, 0xd4,
-	0xb2, 0xa6, 0xf5, 0xcc, 0x58, 0xc1, 0x34, 0x77, 0xe7, 0xa0, 0x20, 0x91, 0x53, 0x8c, 0x6b, 0x39,
-	0xc3, 0x48, 0x6f, 0xee, 0x02, 0x94, 0x62, 0xec, 0xb2, 0x88, 0x21, 0x57, 0xb5, 0x3f, 0x1a, 0xce,
-	0x72, 0x29, 0xb8, 0x00, 0xdc, 0x47, 0x50, 0x20, 0x03, 0x31, 0xe4, 0xaa, 0x96, 0xd7, 0xa5, 0xd6,
-	0xad, 0xa3, 0x93, 0xa5, 0xcc, 0xfb, 0x93, 0xa5, 0x3f, 0xad, 0x70, 0x49, 0xf7, 0x9a, 0x4c, 0xf8,
-	0x03, 0xa2, 0xfa, 0xcd, 0x36, 0x57, 0x6f, 0x5e, 0xdd, 0x84, 0xd4, 0x91, 0x36, 0x57, 0x2f, 0x3e,
-	0xbe, 0x5c, 0x71, 0x82, 0xf4, 0xbd, 0x5b, 0x85, 0x3c, 0xe3, 0x14, 0xf7, 0x6b, 0x85, 0x86, 0xb3,
-	0x9c, 0x0b, 0xec, 0xc5, 0x5d, 0x04, 0x18, 0xa0, 0x94, 0xa4, 0x87, 0x5a, 0xe5, 0x84, 0xf9, 0xb2,
-	0x52, 0x8a, 0xb4, 0xa9, 0xb7, 0x02, 0x33, 0x5b, 0x31, 0xe1, 0xf2, 0x29, 0xc6, 0x01, 0x52, 0xc4,
-	0x01, 0x52, 0x2d, 0x84, 0xb2, 0x1e, 0x4a, 0x95, 0xba, 0x98, 0xde, 0xbc, 0xb7, 0x59, 0x28, 0x6f,
-	0x6f, 0xae, 0x3d, 0x36, 0x72, 0x37, 0xf5, 0xc7, 0xff, 0x03, 0x53, 0xa9, 0x8f, 0xd6, 0x1a, 0xc7,
-	0xa8, 0x9b, 0xb4, 0x98, 0x75, 0xe5, 0x01, 0x54, 0xc7, 0x2d, 0xfc, 0xe2, 0xb7, 0x76, 0xb1, 0xdc,
-	0xaa, 0xa4, 0x6a, 0x0b, 0x43, 0xc6, 0xd5, 0xea, 0xdd, 0xc0, 0x1d, 0xe3, 0xfe, 0x30, 0x84, 0xdc,
-	0x4f, 0x43, 0xb0, 0x4e, 0x5f, 0x1a, 0x42, 0xde, 0xba, 0x70, 0x59, 0x08, 0x85, 0xdf, 0x15, 0xc2,
-	0xc4, 0xd5, 0x21, 0x14, 0xbf, 0x0d, 0xe1, 0xb5, 0x03, 0x93, 0x1b, 0x88, 0xf1, 0x76, 0x44, 0x89,
-	0x42, 0xea, 0xfe, 0x07, 0x79, 0xe3, 0x93, 0x1d, 0xca, 0xef, 0x4c, 0xb2, 0x45, 0xf7, 0x3a, 0x4c,
-	0x8b, 0x90, 0x76, 0x94, 0x8e, 0xcf, 0xcc, 0x75, 0x9c, 0x8e, 0x66, 0x45, 0x84, 0x74, 0xeb, 0x02,
-	0xd5, 0x44, 0x8e, 0xa3, 0xaf, 0x88, 0xd6, 0xbe, 0x0a, 0xc7, 0xd1, 0x38, 0x71, 0x09, 0x26, 0x75,
-	0xc7, 0x01, 0xe1, 0xa4, 0x97, 0x3a, 0x38, 0x15, 0x80, 0x08, 0xe9, 0xba, 0x45, 0x34, 0x41, 0x77,
-	0x3a, 0x27, 0x58, 0x1f, 0x81, 0xe3, 0x28, 0x25, 0x78, 0x87, 0x0e, 0x4c, 0xb7, 0x62, 0x46, 0x7b,
-	0x8c, 0xf7, 0x36, 0x88, 0xea, 0x6f, 0xa2, 0x72, 0xef, 0x5f, 0x31, 0x01, 0x56, 0x1c, 0x7c, 0xfa,
-	0x85, 0xf4, 0xaf, 0xfa, 0x0b, 0x2e, 0x40, 0x49, 0x0e, 0x23, 0xbd, 0x5d, 0x90, 0x1a, 0x8d, 0xc5,
-	0xe0, 0x02, 0xf0, 0x76, 0xa0, 0xfa, 0x64, 0xc4, 0x31, 0x96, 0x7d, 0x16, 0x9d, 0x0f, 0xbd, 0x5e,
-	0x19, 0xff, 0x43, 0x25, 0x8a, 0x31, 0x61, 0x62, 0x28, 0x3b, 0x42, 0x13, 0xd2, 0x39, 0x2e, 0x9f,
-	0xa3, 0xe6, 0x95, 0xfb, 0x37, 0x94, 0xb4, 0x78, 0xcb, 0xc8, 0x1a, 0x46, 0x91, 0xe3, 0xc8, 0x14,
-	0xbd, 0x22, 0x14, 0x36, 0xc8, 0x50, 0x22, 0xf5, 0x00, 0x8a, 0xdb, 0x3c, 0x32, 0xe7, 0xd6, 0xbd,
-	0xa3, 0xd3, 0xba, 0x73, 0x7c, 0x5a, 0x77, 0x3e, 0x9c, 0xd6, 0x9d, 0xe7, 0x67, 0xf5, 0xcc, 0xf1,
-	0x59, 0x3d, 0xf3, 0xee, 0xac, 0x9e, 0xd9, 0x69, 0xa4, 0x9b, 0xd0, 0xae, 0xc5, 0xfd, 0x83, 0x67,
-	0x7e, 0x72, 0xdb, 0x57, 0x07, 0x11, 0xca, 0x74, 0x83, 0xee, 0x16, 0xcc, 0x72, 0xbb, 0xf3, 0x39,
-	0x00, 0x00, 0xff, 0xff, 0x99, 0x22, 0xbb, 0x5e, 0x5f, 0x05, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x26, 0xc4, 0xb5, 0xdf, 0xd8, 0x4e, 0x3a, 0x84, 0xca, 0x84, 0xd6, 0x31, 0x5b, 0x3e,
+	0xa2, 0x22, 0xbc, 0x04, 0x24, 0x0e, 0x88, 0x03, 0x4a, 0x2b, 0x41, 0x0e, 0xa5, 0xd1, 0xc6, 0x16,
+	0xa2, 0x97, 0xd5, 0xd4, 0xf3, 0xb2, 0x1e, 0x75, 0x77, 0x66, 0x35, 0x33, 0x5e, 0x37, 0xfc, 0x0a,
+	0xce, 0xfd, 0x05, 0x1c, 0x39, 0xf0, 0x1f, 0xe8, 0xb1, 0xe2, 0x80, 0x10, 0x87, 0x0a, 0x25, 0x07,
+	0xfe, 0x03, 0x27, 0x34, 0x1f, 0x69, 0x0c, 0x4d, 0x40, 0xa2, 0xb9, 0x58, 0xf3, 0x3e, 0xef, 0x33,
+	0x8f, 0xe6, 0x7d, 0x9e, 0xf1, 0x0e, 0xdc, 0x14, 0xf2, 0x41, 0x81, 0x09, 0x93, 0x45, 0x41, 0x55,
+	0x52, 0x49, 0x65, 0x68, 0x91, 0xd4, 0xbb, 0x09, 0xd6, 0x28, 0x8c, 0x1e, 0x56, 0x4a, 0x1a, 0x49,
+	0xae, 0x39, 0xd2, 0xd0, 0x93, 0x86, 0x9e, 0x34, 0xac, 0x77, 0xb7, 0xae, 0xd2, 0x92, 0x0b, 0x99,
+	0xb8, 0x5f, 0x4f, 0xdd, 0x7a, 0x7d, 0x22, 0x75, 0x29, 0x75, 0xe6, 0xaa, 0xc4, 0x17, 0xa1, 0xb5,
+	0x99, 0xcb, 0x5c, 0x7a, 0xdc, 0xae, 0x3c, 0x1a, 0xdf, 0x80, 0xd6, 0x1d, 0x2c, 0x78, 0x8d, 0x0a,
+	0x19, 0xd9, 0x80, 0x95, 0x9a, 0xd2, 0x5e, 0x34, 0x88, 0x76, 0xda, 0xa9, 0x5d, 0xc6, 0x8f, 0x97,
+	0xa1, 0x7b, 0x77, 0x24, 0x1f, 0xa2, 0x48, 0x71, 0x82, 0xbc, 0x46, 0x46, 0xde, 0x81, 0x75, 0x2d,
+	0x67, 0x6a, 0x82, 0xd9, 0x64, 0x4a, 0xb9, 0xc8, 0x38, 0x73, 0x1b, 0x3a, 0x69, 0xc7, 0xc3, 0xb7,
+	0x2d, 0xba, 0xcf, 0xc8, 0x7b, 0x70, 0x95, 0xa1, 0x36, 0x5c, 0x50, 0xc3, 0xa5, 0xc8, 0x8c, 0x15,
+	0xe9, 0x2d, 0x3b, 0xe9, 0x8d, 0x85, 0x86, 0x13, 0x27, 0xd7, 0xa0, 0xa1, 0x51, 0x30, 0x54, 0xbd,
+	0x15, 0xc7, 0x08, 0x15, 0xb9, 0x0e, 0x2d, 0x85, 0x13, 0x5e, 0x71, 0x14, 0xa6, 0xf7, 0xca, 0x20,
+	0xda, 0x69, 0xa5, 0x67, 0x00, 0xf9, 0x02, 0x1a, 0xb4, 0x94, 0x33, 0x61, 0x7a, 0xab, 0xb6, 0xb5,
+	0xf7, 0xc1, 0x93, 0x67, 0xdb, 0x4b, 0xbf, 0x3d, 0xdb, 0x7e, 0xcd, 0x0f, 0xae, 0xd9, 0xc3, 0x21,
+	0x97, 0x49, 0x49, 0xcd, 0x74, 0xb8, 0x2f, 0xcc, 0xcf, 0x3f, 0xbe, 0x0f, 0xc1, 0x91, 0x7d, 0x61,
+	0xbe, 0xff, 0xe3, 0x87, 0x5b, 0x51, 0x1a, 0xf6, 0x93, 0x4d, 0x58, 0xe5, 0x82, 0xe1, 0xa3, 0x5e,
+	0x63, 0x10, 0xed, 0xac, 0xa4, 0xbe, 0x20, 0x37, 0x00, 0x4a, 0xd4, 0x9a, 0xe6, 0x68, 0xa7, 0xbc,
+	0xe2, 0x4e, 0xd6, 0x0a, 0xc8, 0x3e, 0x8b, 0x6f, 0xc1, 0xc6, 0x48, 0x51, 0xa1, 0xbf, 0x41, 0x95,
+	0x22, 0x43, 0x2c, 0x91, 0xd9, 0x41, 0x18, 0xcf, 0x51, 0x9b, 0xe0, 0x62, 0xa8, 0xe2, 0x5f, 0x96,
+	0xa1, 0x33, 0x3e, 0xbc, 0xf3, 0xa5, 0x1b, 0xf7, 0xd0, 0x1e, 0xfe, 0x4d, 0x68, 0x07, 0x1f, 0xbd,
+	0x35, 0x91, 0x9b, 0x6e, 0xcd, 0x63, 0xde, 0x95, 0xcf, 0x60, 0x73, 0xd1, 0xc2, 0xe7, 0x7e, 0x5b,
+	0x17, 0x3b, 0x7b, 0xdd, 0x30, 0x6d, 0x63, 0xc6, 0x85, 0xd9, 0xfd, 0x38, 0x25, 0x0b, 0xdc, 0x7f,
+	0x0d, 0x61, 0xe5, 0x3f, 0x43, 0xf0, 0x4e, 0x9f, 0x1b, 0xc2, 0xaa, 0x77, 0xe1, 0xbc, 0x10, 0x1a,
+	0x97, 0x15, 0xc2, 0x95, 0x8b, 0x43, 0x68, 0xfe, 0x33, 0x84, 0x9f, 0x22, 0x58, 0x3b, 0x40, 0x54,
+	0xe3, 0x8a, 0x51, 0x83, 0x8c, 0xbc, 0x05, 0xab, 0xce, 0xa7, 0x5e, 0x74, 0xae, 0x49, 0xbe, 0x49,
+	0xde, 0x85, 0x75, 0x59, 0xb0, 0xcc, 0xd8, 0xf8, 0xdc, 0xbd, 0x56, 0xe1, 0x6a, 0x76, 0x65, 0xc1,
+	0x46, 0x67, 0xa8, 0x25, 0x0a, 0x9c, 0xff, 0x8d, 0xe8, 0xed, 0xeb, 0x0a, 0x9c, 0x2f, 0x12, 0xb7,
+	0x61, 0xcd, 0x2a, 0x96, 0x54, 0xd0, 0x3c, 0x38, 0xd8, 0x4e, 0x41, 0x16, 0xec, 0xae, 0x47, 0x2c,
+	0xc1, 0x2a, 0x9d, 0x12, 0xbc, 0x8f, 0x20, 0x70, 0x1e, 0x08, 0xf1, 0xe3, 0x08, 0xd6, 0xf7, 0x14,
+	0x67, 0x39, 0x17, 0xf9, 0x01, 0x35, 0xd3, 0x43, 0x34, 0xe4, 0xd3, 0x0b, 0x6e, 0x80, 0x1f, 0x0e,
+	0xfe, 0xfc, 0x1f, 0xe9, 0x5f, 0xf4, 0x17, 0xbc, 0x0e, 0x2d, 0x3d, 0xab, 0xec, 0xd7, 0x05, 0x99,
+	0x9b, 0xb1, 0x99, 0x9e, 0x01, 0xf1, 0x18, 0x7a, 0xf7, 0xe6, 0x02, 0x95, 0x9e, 0xf2, 0xea, 0xf4,
+	0xd2, 0x1f, 0x1a, 0x6a, 0x7b, 0x36, 0x37, 0x69, 0x7b, 0xe1, 0x0a, 0xfb, 0x82, 0xdc, 0x84, 0x4e,
+	0x85, 0x82, 0x71, 0x91, 0x67, 0xbe, 0xbb, 0xec, 0xba, 0xed, 0x00, 0x3a, 0xb5, 0xf8, 0x2b, 0xd8,
+	0x7a, 0x41, 0xf6, 0x36, 0x15, 0x13, 0x2c, 0x8a, 0x97, 0x13, 0xfe, 0x1a, 0x5e, 0x7d, 0x2e, 0x9c,
+	0x22, 0xd5, 0x9a, 0xe7, 0x02, 0x19, 0x79, 0x1b, 0xba, 0x95, 0xc2, 0x9a, 0xcb, 0x99, 0xce, 0x16,
+	0xa5, 0x3b, 0xa7, 0xa8, 0xdb, 0x44, 0xde, 0x80, 0x96, 0xcd, 0x6a, 0x51, 0xbe, 0x29, 0x70, 0xee,
+	0xa5, 0x47, 0x40, 0x3e, 0x97, 0x35, 0x2a, 0x61, 0xcf, 0x79, 0xaf, 0x46, 0xa5, 0x38, 0x43, 0x6b,
+	0x1f, 0x9d, 0x99, 0xa9, 0x54, 0xdc, 0x1c, 0x05, 0xd1, 0x33, 0x80, 0x0c, 0xa0, 0x5d, 0xea, 0x3c,
+	0x33, 0x47, 0x15, 0x66, 0x33, 0x55, 0x04, 0x4d, 0x28, 0x75, 0x3e, 0x3a, 0xaa, 0x70, 0xac, 0x8a,
+	0xf8, 0x3e, 0x6c, 0xbe, 0xe0, 0x84, 0xba, 0xa4, 0x13, 0x37, 0xa1, 0x71, 0x40, 0x67, 0x1a, 0x59,
+	0x0c, 0xd0, 0x1c, 0x8b, 0xca, 0xad, 0xf7, 0x3e, 0x79, 0x72, 0xdc, 0x8f, 0x9e, 0x1e, 0xf7, 0xa3,
+	0xdf, 0x8f, 0xfb, 0xd1, 0x77, 0x27, 0xfd, 0xa5, 0xa7, 0x27, 0xfd, 0xa5, 0x5f, 0x4f, 0xfa, 0x4b,
+	0xf7, 0x07, 0xe1, 0xa9, 0xf1, 0xef, 0xce, 0xa3, 0xa3, 0x6f, 0x93, 0xfa, 0xc3, 0xc4, 0x9e, 0x5d,
+	0x87, 0x27, 0xea, 0x41, 0xc3, 0xbd, 0x1e, 0x1f, 0xfd, 0x35, 0x00, 0x04, 0x50, 0xcc, 0x6c, 0xc0,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Delivered) Marshal() (dAtA []byte, err error) {
@@ -929,6 +1152,154 @@ func (m *BridgingPathSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OwnershipTransferStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipTransferStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipTransferCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipTransferCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipTransferCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipReassigned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipReassigned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipReassigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OwnershipTransferred) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1166,24 +1537,92 @@ func (m *BridgingPathSet) Size() (n int) {
 	return n
 }
 
-func (m *OwnershipTransferred) Size() (n int) {
+func (m *OwnershipTransferStarted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PreviousOwner)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.NewOwner)
+	l = len(m.PendingOwner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *Paused) Size() (n int) {
+func (m *OwnershipTransferCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipReassigned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *Paused) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2247,6 +2686,462 @@ func (m *BridgingPathSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipTransferStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipTransferCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipTransferCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipTransferCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipReassigned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipReassigned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipReassigned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OwnershipTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
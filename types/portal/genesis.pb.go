@@ -36,6 +36,8 @@ type GenesisState struct {
 	BridgingPaths []BridgingPath `protobuf:"bytes,4,rep,name=bridging_paths,json=bridgingPaths,proto3" json:"bridging_paths"`
 	// nonce contains the next available nonce used for transfers out of the Noble Dollar Portal.
 	Nonce uint32 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// pending_owner is the account that must accept the ownership of the Noble Dollar Portal.
+	PendingOwner string `protobuf:"bytes,6,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +108,13 @@ func (m *GenesisState) GetNonce() uint32 {
 	return 0
 }
 
+func (m *GenesisState) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.dollar.portal.v1.GenesisState")
 	proto.RegisterMapType((map[uint16]Peer)(nil), "noble.dollar.portal.v1.GenesisState.PeersEntry")
@@ -116,32 +125,33 @@ func init() {
 }
 
 var fileDescriptor_aeebf6cda203b8c6 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x8b, 0xda, 0x40,
-	0x14, 0xc7, 0x33, 0x6a, 0xa4, 0x1d, 0xab, 0x94, 0x41, 0x24, 0x95, 0x12, 0x43, 0xeb, 0x21, 0x97,
-	0xce, 0x60, 0x0a, 0xa5, 0x78, 0x6b, 0xa0, 0xf4, 0x6a, 0x23, 0xed, 0xa1, 0x17, 0x49, 0xcc, 0x10,
-	0x43, 0xd3, 0x99, 0x30, 0x33, 0xa6, 0x4d, 0x8f, 0xfb, 0x09, 0xf6, 0xc3, 0xec, 0x87, 0xf0, 0x28,
-	0x7b, 0xda, 0xd3, 0xee, 0xa2, 0xf7, 0xfd, 0x0c, 0x4b, 0x32, 0x81, 0xf5, 0xb0, 0xde, 0xe6, 0x3d,
-	0x7e, 0xef, 0xf7, 0xcf, 0xcb, 0x83, 0x53, 0xc6, 0xa3, 0x8c, 0x92, 0x98, 0x67, 0x59, 0x28, 0x48,
-	0xce, 0x85, 0x0a, 0x33, 0x52, 0xcc, 0x48, 0x42, 0x19, 0x95, 0xa9, 0xc4, 0xb9, 0xe0, 0x8a, 0xa3,
-	0x51, 0x4d, 0x61, 0x4d, 0x61, 0x4d, 0xe1, 0x62, 0x36, 0x7e, 0xb3, 0xe6, 0xf2, 0x0f, 0x97, 0xab,
-	0x9a, 0x22, 0xba, 0xd0, 0x23, 0xe3, 0x61, 0xc2, 0x13, 0xae, 0xfb, 0xd5, 0xab, 0xe9, 0xbe, 0x3f,
-	0x13, 0xd7, 0x28, 0x6b, 0xe8, 0xdd, 0x43, 0x0b, 0xbe, 0xfa, 0xa6, 0xf3, 0x97, 0x2a, 0x54, 0x14,
-	0x61, 0x68, 0xf2, 0xbf, 0x8c, 0x0a, 0x0b, 0x38, 0xc0, 0x7d, 0xe9, 0x5b, 0xd7, 0x57, 0x1f, 0x86,
-	0x4d, 0xd8, 0x97, 0x38, 0x16, 0x54, 0xca, 0xa5, 0x12, 0x29, 0x4b, 0x02, 0x8d, 0xa1, 0x11, 0xec,
-	0xe6, 0xe1, 0x56, 0xd2, 0xd8, 0x6a, 0x39, 0xc0, 0x7d, 0x11, 0x34, 0x15, 0xfa, 0x01, 0xcd, 0x9c,
-	0x52, 0x21, 0xad, 0xb6, 0xd3, 0x76, 0x7b, 0x1e, 0xc1, 0xcf, 0xaf, 0x85, 0x4f, 0xc3, 0xf1, 0xa2,
-	0x9a, 0xf8, 0xca, 0x94, 0x28, 0xfd, 0xc1, 0xee, 0x76, 0x62, 0x5c, 0xdc, 0x4d, 0xba, 0xdb, 0x94,
-	0xa9, 0xd9, 0xa7, 0x40, 0xdb, 0xd0, 0x77, 0x38, 0x88, 0x44, 0x1a, 0x27, 0x29, 0x4b, 0x56, 0x79,
-	0xa8, 0x36, 0xd2, 0xea, 0xd4, 0xfe, 0xe9, 0x39, 0xbf, 0xdf, 0xd0, 0x8b, 0x50, 0x6d, 0xfc, 0x4e,
-	0x25, 0x0d, 0xfa, 0xd1, 0x49, 0x4f, 0xa2, 0x21, 0x34, 0x19, 0x67, 0x6b, 0x6a, 0x99, 0x0e, 0x70,
-	0xfb, 0x81, 0x2e, 0xc6, 0x3f, 0x21, 0x7c, 0xfa, 0x1a, 0xf4, 0x1a, 0xb6, 0x7f, 0xd3, 0xb2, 0xfe,
-	0x27, 0xfd, 0xa0, 0x7a, 0x22, 0x0f, 0x9a, 0x45, 0x98, 0x6d, 0x69, 0xbd, 0x76, 0xcf, 0x7b, 0x7b,
-	0x2e, 0xbf, 0x92, 0x04, 0x1a, 0x9d, 0xb7, 0x3e, 0x03, 0x7f, 0xbe, 0x3b, 0xd8, 0x60, 0x7f, 0xb0,
-	0xc1, 0xfd, 0xc1, 0x06, 0x97, 0x47, 0xdb, 0xd8, 0x1f, 0x6d, 0xe3, 0xe6, 0x68, 0x1b, 0xbf, 0x9c,
-	0x66, 0x56, 0x8b, 0xfe, 0x95, 0xff, 0x49, 0xe1, 0x11, 0x55, 0xe6, 0x54, 0x36, 0x27, 0x8b, 0xba,
-	0xf5, 0xcd, 0x3e, 0x3e, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x42, 0xcb, 0x5a, 0x49, 0x02, 0x00,
-	0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0x37, 0x37, 0x41, 0xe7, 0xde, 0x5c, 0x64, 0x28, 0x97, 0x58, 0x24, 0x37, 0x68,
+	0x17, 0xd9, 0x38, 0x43, 0x23, 0x88, 0x14, 0x5c, 0x18, 0x10, 0x97, 0xd6, 0x14, 0x5d, 0xb8, 0x29,
+	0x49, 0x33, 0xa4, 0xc1, 0x38, 0x13, 0x66, 0xa6, 0xd1, 0xb8, 0xf4, 0x09, 0x7c, 0x11, 0x77, 0x3e,
+	0x44, 0x97, 0xc5, 0x95, 0x2b, 0x95, 0xf6, 0x45, 0x24, 0x99, 0x01, 0xbb, 0xb0, 0xb8, 0x9b, 0x73,
+	0xf8, 0xce, 0xff, 0xcf, 0xf9, 0x0f, 0x9c, 0x30, 0x9e, 0xd7, 0x94, 0x14, 0xbc, 0xae, 0x33, 0x41,
+	0x1a, 0x2e, 0x54, 0x56, 0x93, 0x76, 0x4a, 0x4a, 0xca, 0xa8, 0xac, 0x24, 0x6e, 0x04, 0x57, 0x1c,
+	0x5d, 0x0f, 0x14, 0xd6, 0x14, 0xd6, 0x14, 0x6e, 0xa7, 0xe3, 0xbb, 0x2b, 0x2e, 0xdf, 0x73, 0xb9,
+	0x1c, 0x28, 0xa2, 0x0b, 0x3d, 0x32, 0x1e, 0x95, 0xbc, 0xe4, 0xba, 0xdf, 0xbf, 0x4c, 0xf7, 0xc1,
+	0x09, 0x3b, 0x23, 0x39, 0x40, 0xf7, 0xbf, 0xda, 0xf0, 0xf2, 0x85, 0xf6, 0x5f, 0xa8, 0x4c, 0x51,
+	0x84, 0xa1, 0xc3, 0x3f, 0x30, 0x2a, 0x7c, 0x10, 0x82, 0xe8, 0x76, 0xe2, 0x7f, 0xff, 0xf6, 0x70,
+	0x64, 0xcc, 0x9e, 0x15, 0x85, 0xa0, 0x52, 0x2e, 0x94, 0xa8, 0x58, 0x99, 0x6a, 0x0c, 0x5d, 0x43,
+	0xb7, 0xc9, 0x36, 0x92, 0x16, 0xfe, 0x59, 0x08, 0xa2, 0x5b, 0xa9, 0xa9, 0xd0, 0x6b, 0xe8, 0x34,
+	0x94, 0x0a, 0xe9, 0xdb, 0xa1, 0x1d, 0x5d, 0xc4, 0x04, 0xff, 0x7b, 0x2d, 0x7c, 0x6c, 0x8e, 0xe7,
+	0xfd, 0xc4, 0x73, 0xa6, 0x44, 0x97, 0x5c, 0x6d, 0x7f, 0xde, 0x58, 0x9f, 0x7f, 0xdd, 0xb8, 0x9b,
+	0x8a, 0xa9, 0xe9, 0xe3, 0x54, 0xab, 0xa1, 0x57, 0xf0, 0x2a, 0x17, 0x55, 0x51, 0x56, 0xac, 0x5c,
+	0x36, 0x99, 0x5a, 0x4b, 0xff, 0x7c, 0xd0, 0x9f, 0x9c, 0xd2, 0x4f, 0x0c, 0x3d, 0xcf, 0xd4, 0x3a,
+	0x39, 0xef, 0x45, 0x53, 0x2f, 0x3f, 0xea, 0x49, 0x34, 0x82, 0x0e, 0xe3, 0x6c, 0x45, 0x7d, 0x27,
+	0x04, 0x91, 0x97, 0xea, 0x02, 0x3d, 0x85, 0x5e, 0x43, 0x59, 0xd1, 0xfb, 0xe8, 0x3c, 0xdc, 0xff,
+	0xe4, 0x71, 0x69, 0xf0, 0x97, 0x3d, 0x3d, 0x7e, 0x03, 0xe1, 0xdf, 0x65, 0xd0, 0x1d, 0x68, 0xbf,
+	0xa3, 0xdd, 0x10, 0xa9, 0x97, 0xf6, 0x4f, 0x14, 0x43, 0xa7, 0xcd, 0xea, 0x0d, 0x1d, 0x52, 0xbb,
+	0x88, 0xef, 0x9d, 0xfa, 0x7e, 0x2f, 0x92, 0x6a, 0x74, 0x76, 0xf6, 0x04, 0x24, 0xb3, 0xed, 0x3e,
+	0x00, 0xbb, 0x7d, 0x00, 0x7e, 0xef, 0x03, 0xf0, 0xe5, 0x10, 0x58, 0xbb, 0x43, 0x60, 0xfd, 0x38,
+	0x04, 0xd6, 0xdb, 0xd0, 0xcc, 0x6a, 0xa1, 0x8f, 0xdd, 0x27, 0xd2, 0xc6, 0x44, 0x75, 0x0d, 0x95,
+	0xe6, 0xe2, 0xb9, 0x3b, 0x9c, 0xfc, 0xd1, 0x9f, 0x01, 0x00, 0x5b, 0xd0, 0x44, 0xea, 0x88, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +174,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Nonce != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
 		i--
@@ -267,6 +284,10 @@ func (m *GenesisState) Size() (n int) {
 	if m.Nonce != 0 {
 		n += 1 + sovGenesis(uint64(m.Nonce))
 	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -525,6 +546,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
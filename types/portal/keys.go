@@ -33,6 +33,7 @@ var (
 
 var (
 	OwnerKey           = []byte("portal/owner")
+	PendingOwnerKey    = []byte("portal/pending_owner")
 	PausedKey          = []byte("portal/paused")
 	PeerPrefix         = []byte("portal/peer/")
 	BridgingPathPrefix = []byte("portal/bridging_path/")
@@ -111,6 +111,86 @@ func (m *QueryOwnerResponse) GetOwner() string {
 	return ""
 }
 
+type QueryPendingOwner struct {
+}
+
+func (m *QueryPendingOwner) Reset()         { *m = QueryPendingOwner{} }
+func (m *QueryPendingOwner) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwner) ProtoMessage()    {}
+func (*QueryPendingOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd302ad343e561ab, []int{2}
+}
+func (m *QueryPendingOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwner.Merge(m, src)
+}
+func (m *QueryPendingOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingOwner proto.InternalMessageInfo
+
+type QueryPendingOwnerResponse struct {
+	PendingOwner string `protobuf:"bytes,1,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+}
+
+func (m *QueryPendingOwnerResponse) Reset()         { *m = QueryPendingOwnerResponse{} }
+func (m *QueryPendingOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwnerResponse) ProtoMessage()    {}
+func (*QueryPendingOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd302ad343e561ab, []int{3}
+}
+func (m *QueryPendingOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnerResponse.Merge(m, src)
+}
+func (m *QueryPendingOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryPendingOwnerResponse) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
 type QueryPaused struct {
 }
 
@@ -118,7 +198,7 @@ func (m *QueryPaused) Reset()         { *m = QueryPaused{} }
 func (m *QueryPaused) String() string { return proto.CompactTextString(m) }
 func (*QueryPaused) ProtoMessage()    {}
 func (*QueryPaused) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{2}
+	return fileDescriptor_cd302ad343e561ab, []int{4}
 }
 func (m *QueryPaused) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +235,7 @@ func (m *QueryPausedResponse) Reset()         { *m = QueryPausedResponse{} }
 func (m *QueryPausedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPausedResponse) ProtoMessage()    {}
 func (*QueryPausedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{3}
+	return fileDescriptor_cd302ad343e561ab, []int{5}
 }
 func (m *QueryPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +278,7 @@ func (m *QueryPeers) Reset()         { *m = QueryPeers{} }
 func (m *QueryPeers) String() string { return proto.CompactTextString(m) }
 func (*QueryPeers) ProtoMessage()    {}
 func (*QueryPeers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{4}
+	return fileDescriptor_cd302ad343e561ab, []int{6}
 }
 func (m *QueryPeers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +315,7 @@ func (m *QueryPeersResponse) Reset()         { *m = QueryPeersResponse{} }
 func (m *QueryPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPeersResponse) ProtoMessage()    {}
 func (*QueryPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{5}
+	return fileDescriptor_cd302ad343e561ab, []int{7}
 }
 func (m *QueryPeersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +359,7 @@ func (m *QueryDestinationTokens) Reset()         { *m = QueryDestinationTokens{}
 func (m *QueryDestinationTokens) String() string { return proto.CompactTextString(m) }
 func (*QueryDestinationTokens) ProtoMessage()    {}
 func (*QueryDestinationTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{6}
+	return fileDescriptor_cd302ad343e561ab, []int{8}
 }
 func (m *QueryDestinationTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +403,7 @@ func (m *QueryDestinationTokensResponse) Reset()         { *m = QueryDestination
 func (m *QueryDestinationTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDestinationTokensResponse) ProtoMessage()    {}
 func (*QueryDestinationTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{7}
+	return fileDescriptor_cd302ad343e561ab, []int{9}
 }
 func (m *QueryDestinationTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +446,7 @@ func (m *QueryNonce) Reset()         { *m = QueryNonce{} }
 func (m *QueryNonce) String() string { return proto.CompactTextString(m) }
 func (*QueryNonce) ProtoMessage()    {}
 func (*QueryNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{8}
+	return fileDescriptor_cd302ad343e561ab, []int{10}
 }
 func (m *QueryNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +483,7 @@ func (m *QueryNonceResponse) Reset()         { *m = QueryNonceResponse{} }
 func (m *QueryNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNonceResponse) ProtoMessage()    {}
 func (*QueryNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd302ad343e561ab, []int{9}
+	return fileDescriptor_cd302ad343e561ab, []int{11}
 }
 func (m *QueryNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +522,8 @@ func (m *QueryNonceResponse) GetNonce() uint32 {
 func init() {
 	proto.RegisterType((*QueryOwner)(nil), "noble.dollar.portal.v1.QueryOwner")
 	proto.RegisterType((*QueryOwnerResponse)(nil), "noble.dollar.portal.v1.QueryOwnerResponse")
+	proto.RegisterType((*QueryPendingOwner)(nil), "noble.dollar.portal.v1.QueryPendingOwner")
+	proto.RegisterType((*QueryPendingOwnerResponse)(nil), "noble.dollar.portal.v1.QueryPendingOwnerResponse")
 	proto.RegisterType((*QueryPaused)(nil), "noble.dollar.portal.v1.QueryPaused")
 	proto.RegisterType((*QueryPausedResponse)(nil), "noble.dollar.portal.v1.QueryPausedResponse")
 	proto.RegisterType((*QueryPeers)(nil), "noble.dollar.portal.v1.QueryPeers")
@@ -458,46 +540,50 @@ func init() {
 }
 
 var fileDescriptor_cd302ad343e561ab = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xa6, 0x72, 0x28, 0x93, 0x56, 0x22, 0x5b, 0x54, 0x05, 0xb7, 0x75, 0x2c, 0xf7, 0x12,
-	0xa5, 0x92, 0x57, 0x49, 0x3f, 0x40, 0x3d, 0x46, 0x70, 0xe0, 0x02, 0x25, 0x42, 0x3d, 0x70, 0x29,
-	0x6e, 0xbc, 0x0a, 0x56, 0xdd, 0x5d, 0x63, 0x3b, 0x81, 0x80, 0xb8, 0x94, 0x03, 0x1c, 0x91, 0xf8,
-	0x13, 0x1c, 0xf9, 0x07, 0x5c, 0x7b, 0x42, 0x95, 0xb8, 0x70, 0x02, 0x94, 0x80, 0xf8, 0x1b, 0xc8,
-	0x63, 0xc7, 0x69, 0x9b, 0xe6, 0x83, 0x8b, 0xb5, 0x33, 0xfb, 0x66, 0xe6, 0xf9, 0xcd, 0xb3, 0xc1,
-	0x10, 0xf2, 0xd0, 0xe5, 0xcc, 0x96, 0xae, 0x6b, 0xf9, 0xcc, 0x93, 0x7e, 0x68, 0xb9, 0xac, 0x53,
-	0x65, 0xcf, 0xdb, 0xdc, 0xef, 0x9a, 0x9e, 0x2f, 0x43, 0x49, 0x97, 0x11, 0x63, 0xc6, 0x18, 0x33,
-	0xc6, 0x98, 0x9d, 0xaa, 0x5a, 0xb0, 0x8e, 0x1d, 0x21, 0x19, 0x3e, 0x63, 0xa8, 0xba, 0xd2, 0x94,
-	0xc1, 0xb1, 0x0c, 0xe2, 0xf2, 0x4b, 0x7d, 0xd4, 0x9b, 0x2d, 0xd9, 0x92, 0x78, 0x64, 0xd1, 0x29,
-	0xc9, 0xae, 0xb6, 0xa4, 0x6c, 0xb9, 0x9c, 0x59, 0x9e, 0xc3, 0x2c, 0x21, 0x64, 0x68, 0x85, 0x8e,
-	0x14, 0x41, 0x72, 0xbb, 0x3e, 0x86, 0x5f, 0xc2, 0x02, 0x41, 0xc6, 0x02, 0xc0, 0xa3, 0x68, 0xce,
-	0xc3, 0x17, 0x82, 0xfb, 0x46, 0x15, 0xe8, 0x30, 0x6a, 0xf0, 0xc0, 0x93, 0x22, 0xe0, 0x74, 0x05,
-	0x14, 0x19, 0x25, 0x8a, 0x44, 0x27, 0xe5, 0xeb, 0x75, 0xe5, 0xd3, 0xdf, 0xcf, 0x15, 0xd2, 0x88,
-	0x73, 0xc6, 0x22, 0xe4, 0xb1, 0x64, 0xcf, 0x6a, 0x07, 0xdc, 0x36, 0xb6, 0x60, 0xe9, 0x5c, 0x98,
-	0xb6, 0x58, 0x83, 0x9c, 0x87, 0x19, 0xec, 0x31, 0x3f, 0xe8, 0x91, 0x24, 0x53, 0x16, 0x7b, 0x9c,
-	0xfb, 0x81, 0xf1, 0x95, 0x24, 0x34, 0x30, 0x4c, 0x7b, 0x3c, 0x05, 0xc5, 0x8b, 0x12, 0x45, 0xa2,
-	0xcf, 0x95, 0xf3, 0xb5, 0x6d, 0xf3, 0x6a, 0x6d, 0xcd, 0xd1, 0x52, 0x13, 0xa3, 0x7b, 0x22, 0xf4,
-	0xbb, 0xf5, 0xa5, 0xd3, 0x1f, 0xa5, 0xcc, 0xc9, 0xcf, 0x52, 0xae, 0xed, 0x88, 0xb0, 0xba, 0x93,
-	0xbc, 0x0b, 0x36, 0x56, 0xf7, 0x01, 0x86, 0x48, 0x7a, 0x03, 0xe6, 0x8e, 0x78, 0x17, 0x09, 0x2f,
-	0x36, 0xa2, 0x23, 0xad, 0x81, 0xd2, 0xb1, 0xdc, 0x36, 0x2f, 0x66, 0x75, 0x52, 0xce, 0xd7, 0x56,
-	0xc7, 0x31, 0x88, 0x9a, 0x34, 0x62, 0xe8, 0x6e, 0xf6, 0x0e, 0x31, 0x36, 0x61, 0x19, 0x49, 0xdd,
-	0xe5, 0x41, 0xe8, 0x08, 0x5c, 0xd2, 0x63, 0x79, 0xc4, 0x45, 0x40, 0x6f, 0xc1, 0x7c, 0xf3, 0x99,
-	0xe5, 0x88, 0x03, 0xc7, 0x4e, 0x06, 0x5d, 0xc3, 0xf8, 0xbe, 0x6d, 0xec, 0x83, 0x76, 0x75, 0x51,
-	0x2a, 0xc8, 0x16, 0x50, 0x7b, 0x78, 0x79, 0x10, 0xe2, 0x6d, 0x31, 0xab, 0xcf, 0x95, 0x17, 0x06,
-	0x02, 0x17, 0xec, 0xcb, 0xd5, 0xa9, 0xd6, 0x0f, 0xa4, 0x68, 0xf2, 0x74, 0xe3, 0x18, 0x9d, 0xdf,
-	0xb8, 0x88, 0x12, 0x31, 0xa7, 0x74, 0xe3, 0x98, 0xab, 0xfd, 0x51, 0x40, 0xc1, 0x1a, 0xfa, 0x96,
-	0x80, 0x82, 0x56, 0xa1, 0xc6, 0xc4, 0x65, 0x20, 0x46, 0xad, 0x4c, 0xc7, 0x0c, 0x08, 0x18, 0x95,
-	0xf7, 0xd1, 0xc4, 0x93, 0x6f, 0xbf, 0x3f, 0x66, 0x4b, 0x74, 0x8d, 0x8d, 0x71, 0x32, 0x3a, 0x90,
-	0xbe, 0x23, 0x90, 0x8b, 0xed, 0x46, 0xd7, 0x27, 0x7b, 0x02, 0x41, 0xea, 0xc6, 0x0c, 0xa0, 0x94,
-	0xc8, 0xc6, 0x90, 0x88, 0x4e, 0xb5, 0x71, 0x44, 0x62, 0x1b, 0xa3, 0x1e, 0x68, 0xa0, 0x29, 0x7a,
-	0x20, 0x66, 0x8a, 0x1e, 0x17, 0x0c, 0x3c, 0xa3, 0x1e, 0xe8, 0x62, 0xfa, 0x85, 0x40, 0x61, 0xd4,
-	0x69, 0xe6, 0xc4, 0x69, 0x23, 0x78, 0x75, 0xe7, 0xff, 0xf0, 0x29, 0xd3, 0xfa, 0x90, 0xe9, 0x6d,
-	0xba, 0x3d, 0x8e, 0xe9, 0xa8, 0x6f, 0xd9, 0xeb, 0xc1, 0x87, 0xf0, 0x06, 0x75, 0x44, 0x43, 0x4e,
-	0xd1, 0x11, 0x31, 0x53, 0x74, 0xbc, 0x60, 0xec, 0x19, 0x75, 0x44, 0x9f, 0xd7, 0x77, 0x4f, 0x7b,
-	0x1a, 0x39, 0xeb, 0x69, 0xe4, 0x57, 0x4f, 0x23, 0x1f, 0xfa, 0x5a, 0xe6, 0xac, 0xaf, 0x65, 0xbe,
-	0xf7, 0xb5, 0xcc, 0x13, 0x3d, 0x19, 0x15, 0xcf, 0x7d, 0xd9, 0x7d, 0xc5, 0x3a, 0x35, 0x16, 0x76,
-	0x3d, 0x1e, 0x24, 0x4d, 0x0e, 0x73, 0xf8, 0x77, 0xdd, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x26, 0x7b, 0x2c, 0x24, 0x06, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xcd, 0xa6, 0x4a, 0xda, 0xdf, 0x34, 0x95, 0x7e, 0xd9, 0xa2, 0x2a, 0x75, 0x5b, 0x37, 0x72,
+	0x85, 0x08, 0xa9, 0xe4, 0x55, 0xd2, 0x3f, 0xa0, 0x1e, 0x23, 0x10, 0xe2, 0x02, 0x25, 0x42, 0x3d,
+	0x70, 0x29, 0x6e, 0xbd, 0x0a, 0x56, 0xd3, 0x5d, 0x63, 0x3b, 0x81, 0x80, 0xb8, 0x94, 0x03, 0x3d,
+	0x22, 0xf1, 0x05, 0x38, 0x72, 0xe4, 0x1b, 0x70, 0xed, 0x09, 0x55, 0xe2, 0xc2, 0x09, 0x50, 0x8b,
+	0xc4, 0xd7, 0x40, 0x9e, 0x75, 0xec, 0xd0, 0x34, 0x75, 0x7b, 0x89, 0x76, 0xde, 0xbe, 0x79, 0xf3,
+	0x32, 0x3b, 0x23, 0x83, 0x21, 0xe4, 0x4e, 0x9b, 0x33, 0x5b, 0xb6, 0xdb, 0x96, 0xc7, 0x5c, 0xe9,
+	0x05, 0x56, 0x9b, 0x75, 0x6b, 0xec, 0x79, 0x87, 0x7b, 0x3d, 0xd3, 0xf5, 0x64, 0x20, 0xe9, 0x0c,
+	0x72, 0x4c, 0xc5, 0x31, 0x15, 0xc7, 0xec, 0xd6, 0xb4, 0xa2, 0xb5, 0xef, 0x08, 0xc9, 0xf0, 0x57,
+	0x51, 0xb5, 0xb9, 0x5d, 0xe9, 0xef, 0x4b, 0x5f, 0xa5, 0x9f, 0xd1, 0xd1, 0xae, 0xb5, 0x64, 0x4b,
+	0xe2, 0x91, 0x85, 0xa7, 0x08, 0x9d, 0x6f, 0x49, 0xd9, 0x6a, 0x73, 0x66, 0xb9, 0x0e, 0xb3, 0x84,
+	0x90, 0x81, 0x15, 0x38, 0x52, 0xf8, 0xd1, 0xed, 0xd2, 0x08, 0x7f, 0x91, 0x0b, 0x24, 0x19, 0x05,
+	0x80, 0x47, 0x61, 0x9d, 0x87, 0x2f, 0x04, 0xf7, 0x8c, 0x1a, 0xd0, 0x24, 0x6a, 0x72, 0xdf, 0x95,
+	0xc2, 0xe7, 0x74, 0x0e, 0x72, 0x32, 0x04, 0x4a, 0xa4, 0x4c, 0x2a, 0xff, 0x35, 0x72, 0x9f, 0xfe,
+	0x7c, 0xae, 0x92, 0xa6, 0xc2, 0x8c, 0x69, 0x28, 0x62, 0xca, 0x26, 0x17, 0xb6, 0x23, 0x5a, 0x4a,
+	0xe7, 0x1e, 0xcc, 0x0e, 0x81, 0xb1, 0x5c, 0x15, 0xa6, 0x5c, 0x85, 0x6f, 0x9f, 0x23, 0x5b, 0x70,
+	0x07, 0x85, 0xa6, 0x60, 0x52, 0x09, 0x59, 0x1d, 0x9f, 0xdb, 0xc6, 0x2a, 0x4c, 0x0f, 0x84, 0xb1,
+	0xe2, 0x02, 0xe4, 0x5d, 0x44, 0x50, 0x6a, 0xa2, 0x2f, 0x15, 0x81, 0xf1, 0x7f, 0xdc, 0xe4, 0xdc,
+	0xf3, 0x8d, 0xaf, 0x04, 0x68, 0x12, 0xc6, 0x1a, 0x4f, 0x21, 0xe7, 0x86, 0x40, 0x89, 0x94, 0xc7,
+	0x2a, 0x93, 0xf5, 0x35, 0xf3, 0xfc, 0x97, 0x33, 0x87, 0x53, 0x4d, 0x8c, 0xee, 0x8a, 0xc0, 0xeb,
+	0x35, 0xa6, 0x8f, 0x7e, 0x2c, 0x66, 0x0e, 0x7e, 0x2e, 0xe6, 0x3b, 0x8e, 0x08, 0x6a, 0xeb, 0x51,
+	0xa7, 0x50, 0x58, 0xdb, 0x02, 0x48, 0x98, 0xf4, 0x7f, 0x18, 0xdb, 0xe3, 0x3d, 0x34, 0x3c, 0xd5,
+	0x0c, 0x8f, 0xb4, 0x0e, 0xb9, 0xae, 0xd5, 0xee, 0xf0, 0x52, 0xb6, 0x4c, 0x2a, 0x93, 0xf5, 0xf9,
+	0x51, 0x0e, 0x42, 0x91, 0xa6, 0xa2, 0x6e, 0x64, 0x6f, 0x13, 0x63, 0x05, 0x66, 0xd0, 0xd4, 0x1d,
+	0xee, 0x07, 0x8e, 0xc0, 0x11, 0x78, 0x2c, 0xf7, 0xb8, 0xf0, 0xe9, 0x2c, 0x4c, 0xec, 0x3e, 0xb3,
+	0x1c, 0xb1, 0xed, 0xd8, 0x51, 0xa1, 0x71, 0x8c, 0xef, 0xdb, 0xc6, 0x16, 0xe8, 0xe7, 0x27, 0xc5,
+	0x0d, 0x59, 0x05, 0x6a, 0x27, 0x97, 0xdb, 0x01, 0xde, 0x96, 0xb2, 0xe5, 0xb1, 0x4a, 0xa1, 0xdf,
+	0xe0, 0xa2, 0x7d, 0x36, 0x3b, 0xee, 0xf5, 0x03, 0x29, 0x76, 0x79, 0x3c, 0x4f, 0x18, 0x0d, 0xce,
+	0x93, 0x08, 0x01, 0xe5, 0x29, 0x9e, 0x27, 0xc4, 0xea, 0x87, 0xe3, 0x90, 0xc3, 0x1c, 0xfa, 0x96,
+	0x40, 0x0e, 0xa7, 0x80, 0x1a, 0x17, 0x3e, 0x06, 0x72, 0xb4, 0x6a, 0x3a, 0xa7, 0x6f, 0xc0, 0xa8,
+	0x1e, 0x86, 0x15, 0x0f, 0xbe, 0xfd, 0xfe, 0x90, 0x5d, 0xa4, 0x0b, 0x6c, 0xc4, 0x9e, 0xe0, 0x70,
+	0xd2, 0x8f, 0x04, 0x0a, 0x83, 0x63, 0x4c, 0x6f, 0xa6, 0x4c, 0x46, 0x42, 0xd5, 0x6a, 0x97, 0xa6,
+	0xc6, 0xd6, 0xea, 0x89, 0xb5, 0x1b, 0xf4, 0xfa, 0x28, 0x6b, 0xff, 0xec, 0x0f, 0x7d, 0x47, 0x20,
+	0xaf, 0x36, 0x82, 0x2e, 0x5d, 0x5c, 0x11, 0x49, 0xda, 0xf2, 0x25, 0x48, 0xb1, 0xa1, 0xe5, 0xc4,
+	0x50, 0x99, 0xea, 0x23, 0x0d, 0xa9, 0xf2, 0xe1, 0x93, 0xe1, 0x8c, 0xa7, 0x3c, 0x19, 0x72, 0xb4,
+	0x6a, 0x3a, 0xe7, 0x8a, 0x4f, 0x86, 0x8b, 0x46, 0xbf, 0x10, 0x28, 0x0e, 0x2f, 0x83, 0x79, 0x61,
+	0xb5, 0x21, 0xbe, 0xb6, 0x7e, 0x35, 0x7e, 0xec, 0xb4, 0x91, 0x38, 0xbd, 0x45, 0xd7, 0x46, 0x39,
+	0x1d, 0x5e, 0x2d, 0xf6, 0xba, 0xbf, 0xab, 0x6f, 0xb0, 0x8f, 0xb8, 0x33, 0x29, 0x7d, 0x44, 0x8e,
+	0x56, 0x4d, 0xe7, 0x5c, 0xb1, 0x8f, 0xb8, 0x8a, 0x8d, 0x8d, 0xa3, 0x13, 0x9d, 0x1c, 0x9f, 0xe8,
+	0xe4, 0xd7, 0x89, 0x4e, 0xde, 0x9f, 0xea, 0x99, 0xe3, 0x53, 0x3d, 0xf3, 0xfd, 0x54, 0xcf, 0x3c,
+	0x29, 0x47, 0xa5, 0x54, 0xdd, 0x97, 0xbd, 0x57, 0xac, 0x5b, 0x67, 0x41, 0xcf, 0xe5, 0x7e, 0x24,
+	0xb2, 0x93, 0xc7, 0xcf, 0xcb, 0xca, 0xdf, 0x01, 0x00, 0xbe, 0xe5, 0x1a, 0x11, 0x25, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -513,6 +599,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
+	PendingOwner(ctx context.Context, in *QueryPendingOwner, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error)
 	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
 	Peers(ctx context.Context, in *QueryPeers, opts ...grpc.CallOption) (*QueryPeersResponse, error)
 	DestinationTokens(ctx context.Context, in *QueryDestinationTokens, opts ...grpc.CallOption) (*QueryDestinationTokensResponse, error)
@@ -536,6 +623,15 @@ func (c *queryClient) Owner(ctx context.Context, in *QueryOwner, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryPendingOwner, opts ...grpc.CallOption) (*QueryPendingOwnerResponse, error) {
+	out := new(QueryPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.portal.v1.Query/PendingOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
 	out := new(QueryPausedResponse)
 	err := c.cc.Invoke(ctx, "/noble.dollar.portal.v1.Query/Paused", in, out, opts...)
@@ -575,6 +671,7 @@ func (c *queryClient) Nonce(ctx context.Context, in *QueryNonce, opts ...grpc.Ca
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
+	PendingOwner(context.Context, *QueryPendingOwner) (*QueryPendingOwnerResponse, error)
 	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
 	Peers(context.Context, *QueryPeers) (*QueryPeersResponse, error)
 	DestinationTokens(context.Context, *QueryDestinationTokens) (*QueryDestinationTokensResponse, error)
@@ -588,6 +685,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryOwner) (*QueryOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
 }
+func (*UnimplementedQueryServer) PendingOwner(ctx context.Context, req *QueryPendingOwner) (*QueryPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
 func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
@@ -623,6 +723,24 @@ func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.portal.v1.Query/PendingOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryPendingOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPaused)
 	if err := dec(in); err != nil {
@@ -704,6 +822,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Owner",
 			Handler:    _Query_Owner_Handler,
 		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
 		{
 			MethodName: "Paused",
 			Handler:    _Query_Paused_Handler,
@@ -778,6 +900,59 @@ func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1046,6 +1221,28 @@ func (m *QueryOwnerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryPaused) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1280,6 +1477,138 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
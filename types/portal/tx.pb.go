@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -193,6 +197,10 @@ var xxx_messageInfo_MsgTransferResponse proto.InternalMessageInfo
 type MsgSetPausedState struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// expiry is the time after which the pause is automatically lifted, indefinite if not set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// reason is a description of why the pause was set.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgSetPausedState) Reset()         { *m = MsgSetPausedState{} }
@@ -421,7 +429,7 @@ func (m *MsgSetBridgingPathResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBridgingPathResponse proto.InternalMessageInfo
 
-// MsgTransferOwnership allows the Noble Dollar Portal ownership to be transferred.
+// MsgTransferOwnership allows the Noble Dollar Portal owner to start an ownership transfer, which the new owner must accept.
 type MsgTransferOwnership struct {
 	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
@@ -497,6 +505,232 @@ func (m *MsgTransferOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
 
+// MsgAcceptOwnership allows the pending owner to accept the Noble Dollar Portal ownership.
+type MsgAcceptOwnership struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgAcceptOwnership) Reset()         { *m = MsgAcceptOwnership{} }
+func (m *MsgAcceptOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnership) ProtoMessage()    {}
+func (*MsgAcceptOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{12}
+}
+func (m *MsgAcceptOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnership.Merge(m, src)
+}
+func (m *MsgAcceptOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnership proto.InternalMessageInfo
+
+// MsgAcceptOwnershipResponse is the response of the AcceptOwnership message.
+type MsgAcceptOwnershipResponse struct {
+}
+
+func (m *MsgAcceptOwnershipResponse) Reset()         { *m = MsgAcceptOwnershipResponse{} }
+func (m *MsgAcceptOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{13}
+}
+func (m *MsgAcceptOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnershipResponse proto.InternalMessageInfo
+
+// MsgCancelOwnershipTransfer allows the Noble Dollar Portal owner to cancel a pending ownership transfer.
+type MsgCancelOwnershipTransfer struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelOwnershipTransfer) Reset()         { *m = MsgCancelOwnershipTransfer{} }
+func (m *MsgCancelOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{14}
+}
+func (m *MsgCancelOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgCancelOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOwnershipTransfer proto.InternalMessageInfo
+
+// MsgCancelOwnershipTransferResponse is the response of the CancelOwnershipTransfer message.
+type MsgCancelOwnershipTransferResponse struct {
+}
+
+func (m *MsgCancelOwnershipTransferResponse) Reset()         { *m = MsgCancelOwnershipTransferResponse{} }
+func (m *MsgCancelOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgCancelOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{15}
+}
+func (m *MsgCancelOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOwnershipTransferResponse proto.InternalMessageInfo
+
+// MsgSetOwner allows the authority to forcibly reassign the Noble Dollar Portal ownership, e.g. if the owner key is lost.
+type MsgSetOwner struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgSetOwner) Reset()         { *m = MsgSetOwner{} }
+func (m *MsgSetOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOwner) ProtoMessage()    {}
+func (*MsgSetOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{16}
+}
+func (m *MsgSetOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOwner.Merge(m, src)
+}
+func (m *MsgSetOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOwner proto.InternalMessageInfo
+
+// MsgSetOwnerResponse is the response of the SetOwner message.
+type MsgSetOwnerResponse struct {
+}
+
+func (m *MsgSetOwnerResponse) Reset()         { *m = MsgSetOwnerResponse{} }
+func (m *MsgSetOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOwnerResponse) ProtoMessage()    {}
+func (*MsgSetOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5414e5ec63723f0, []int{17}
+}
+func (m *MsgSetOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOwnerResponse.Merge(m, src)
+}
+func (m *MsgSetOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOwnerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeliver)(nil), "noble.dollar.portal.v1.MsgDeliver")
 	proto.RegisterType((*MsgDeliverResponse)(nil), "noble.dollar.portal.v1.MsgDeliverResponse")
@@ -510,63 +744,81 @@ func init() {
 	proto.RegisterType((*MsgSetBridgingPathResponse)(nil), "noble.dollar.portal.v1.MsgSetBridgingPathResponse")
 	proto.RegisterType((*MsgTransferOwnership)(nil), "noble.dollar.portal.v1.MsgTransferOwnership")
 	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "noble.dollar.portal.v1.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgAcceptOwnership)(nil), "noble.dollar.portal.v1.MsgAcceptOwnership")
+	proto.RegisterType((*MsgAcceptOwnershipResponse)(nil), "noble.dollar.portal.v1.MsgAcceptOwnershipResponse")
+	proto.RegisterType((*MsgCancelOwnershipTransfer)(nil), "noble.dollar.portal.v1.MsgCancelOwnershipTransfer")
+	proto.RegisterType((*MsgCancelOwnershipTransferResponse)(nil), "noble.dollar.portal.v1.MsgCancelOwnershipTransferResponse")
+	proto.RegisterType((*MsgSetOwner)(nil), "noble.dollar.portal.v1.MsgSetOwner")
+	proto.RegisterType((*MsgSetOwnerResponse)(nil), "noble.dollar.portal.v1.MsgSetOwnerResponse")
 }
 
 func init() { proto.RegisterFile("noble/dollar/portal/v1/tx.proto", fileDescriptor_f5414e5ec63723f0) }
 
 var fileDescriptor_f5414e5ec63723f0 = []byte{
-	// 812 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xbf, 0x4f, 0xdb, 0x58,
-	0x1c, 0x8f, 0x13, 0x08, 0xc9, 0xe3, 0xc7, 0x11, 0x5f, 0xe0, 0x82, 0x2f, 0x97, 0x44, 0xbe, 0x1b,
-	0xb8, 0x70, 0xd8, 0x84, 0x83, 0x3b, 0x29, 0xba, 0xe5, 0xd2, 0x0e, 0x65, 0x88, 0x8a, 0x0c, 0x0b,
-	0x55, 0xa5, 0xc8, 0xc4, 0xaf, 0x8e, 0x45, 0xf2, 0x9e, 0xeb, 0xf7, 0x92, 0x40, 0x97, 0x56, 0x1d,
-	0xaa, 0xaa, 0x53, 0xa5, 0xfe, 0x03, 0x8c, 0x1d, 0x19, 0x18, 0x3a, 0x77, 0x62, 0x2b, 0x62, 0xaa,
-	0x3a, 0xa0, 0x0a, 0x06, 0xfa, 0x37, 0x54, 0x1d, 0x2a, 0xdb, 0xcf, 0x8e, 0x43, 0x08, 0x49, 0xb3,
-	0x44, 0x7e, 0xdf, 0xef, 0xe7, 0xfb, 0xeb, 0xf3, 0x3e, 0xfe, 0x3a, 0x20, 0x8b, 0xf0, 0x6e, 0x1d,
-	0xca, 0x1a, 0xae, 0xd7, 0x55, 0x4b, 0x36, 0xb1, 0x45, 0xd5, 0xba, 0xdc, 0x2a, 0xc8, 0x74, 0x5f,
-	0x32, 0x2d, 0x4c, 0x31, 0x3f, 0xef, 0x00, 0x24, 0x17, 0x20, 0xb9, 0x00, 0xa9, 0x55, 0x10, 0x12,
-	0x6a, 0xc3, 0x40, 0x58, 0x76, 0x7e, 0x5d, 0xa8, 0xf0, 0x4b, 0x15, 0x93, 0x06, 0x26, 0x72, 0x83,
-	0xe8, 0x76, 0x8a, 0x06, 0xd1, 0x99, 0x63, 0xc1, 0x75, 0x54, 0x9c, 0x93, 0xec, 0x1e, 0x98, 0x2b,
-	0xa9, 0x63, 0x1d, 0xbb, 0x76, 0xfb, 0xc9, 0xb5, 0x8a, 0x4f, 0x01, 0x28, 0x13, 0xfd, 0x2e, 0xac,
-	0x1b, 0x2d, 0x68, 0xf1, 0x2b, 0x20, 0x4a, 0x0c, 0x1d, 0x41, 0x2b, 0xc5, 0xe5, 0xb8, 0xc5, 0x78,
-	0x29, 0x75, 0x76, 0xbc, 0x9c, 0x64, 0x59, 0xfe, 0xd7, 0x34, 0x0b, 0x12, 0xb2, 0x45, 0x2d, 0x03,
-	0xe9, 0x0a, 0xc3, 0xf1, 0xb3, 0x20, 0xd2, 0x52, 0xd5, 0x54, 0x38, 0xc7, 0x2d, 0x4e, 0x29, 0xf6,
-	0x63, 0x71, 0xf9, 0xe5, 0x61, 0x36, 0xf4, 0xe5, 0x30, 0x1b, 0x7a, 0x7e, 0x75, 0x94, 0x67, 0xb0,
-	0x57, 0x57, 0x47, 0xf9, 0xb9, 0xee, 0xc9, 0x59, 0x49, 0x31, 0x09, 0xf8, 0x4e, 0x03, 0x0a, 0x24,
-	0x26, 0x46, 0x04, 0x8a, 0xef, 0xc3, 0x60, 0xb2, 0x4c, 0xf4, 0x6d, 0x4b, 0x45, 0xe4, 0xd1, 0x48,
-	0x8d, 0xdd, 0x03, 0x51, 0xb5, 0x81, 0x9b, 0x88, 0x3a, 0xbd, 0xc5, 0x4b, 0x2b, 0x27, 0xe7, 0xd9,
-	0xd0, 0xa7, 0xf3, 0xec, 0x9c, 0x1b, 0x45, 0xb4, 0x3d, 0xc9, 0xc0, 0x72, 0x43, 0xa5, 0x35, 0x69,
-	0x03, 0xd1, 0xb3, 0xe3, 0x65, 0xc0, 0xd2, 0x6d, 0x20, 0xfa, 0xf6, 0xea, 0x28, 0xcf, 0x29, 0x2c,
-	0x9e, 0xff, 0x0f, 0x24, 0x35, 0x48, 0xa8, 0x81, 0x54, 0x6a, 0x60, 0x54, 0xa9, 0xd6, 0x54, 0x03,
-	0x55, 0x0c, 0x2d, 0x15, 0xc9, 0x71, 0x8b, 0xd3, 0x25, 0xf0, 0xf5, 0x3c, 0x1b, 0x6d, 0x1a, 0x88,
-	0x16, 0xfe, 0x51, 0xf8, 0x00, 0xee, 0x8e, 0x0d, 0xdb, 0xd0, 0xf8, 0x25, 0x90, 0x08, 0x46, 0x53,
-	0xbc, 0x07, 0x51, 0x6a, 0xcc, 0xa1, 0x6b, 0x36, 0xe0, 0xd8, 0xb6, 0xed, 0x7c, 0x1a, 0xc4, 0x2d,
-	0x58, 0x35, 0x4c, 0x03, 0x22, 0x9a, 0x1a, 0x77, 0x40, 0x1d, 0x43, 0x51, 0xea, 0xc3, 0xec, 0x7c,
-	0x37, 0xb3, 0x1e, 0x69, 0xe2, 0x1c, 0xf8, 0x39, 0xc0, 0xa1, 0xcf, 0xed, 0x1b, 0x0e, 0x24, 0xca,
-	0x44, 0xdf, 0x82, 0x74, 0x53, 0x6d, 0x12, 0xa8, 0x6d, 0x51, 0x95, 0xc2, 0x11, 0x18, 0x9e, 0x07,
-	0x51, 0xd3, 0x49, 0xe0, 0x30, 0x1c, 0x53, 0xd8, 0xa9, 0xb8, 0xd6, 0xa7, 0xcd, 0x74, 0x77, 0x9b,
-	0xdd, 0xf5, 0xc5, 0x5f, 0xc1, 0x42, 0x4f, 0x53, 0x7e, 0xcb, 0x1f, 0x38, 0x47, 0xa6, 0xb6, 0x17,
-	0x8e, 0xa4, 0x86, 0x3f, 0xc0, 0xb8, 0x73, 0x6f, 0x4e, 0xab, 0xd3, 0xa5, 0x19, 0x26, 0x06, 0xef,
-	0xe2, 0x5c, 0x27, 0x9f, 0x03, 0x93, 0xd4, 0x66, 0xab, 0x0a, 0x6d, 0x31, 0x3a, 0x17, 0x3c, 0xa5,
-	0x04, 0x4d, 0x7c, 0x0a, 0x4c, 0x34, 0x54, 0xa4, 0xea, 0xd0, 0x62, 0x77, 0xe8, 0x1d, 0x87, 0x95,
-	0x3d, 0x1b, 0x81, 0xc9, 0x9e, 0x9d, 0xfc, 0x39, 0x5f, 0x84, 0x3d, 0x73, 0xc9, 0x32, 0x34, 0xdd,
-	0x40, 0xfa, 0xa6, 0x4a, 0x6b, 0x23, 0xcc, 0xdb, 0x4f, 0xb3, 0xe1, 0xd1, 0x35, 0x1b, 0xe9, 0xaf,
-	0x59, 0xd2, 0x34, 0xed, 0xf1, 0xa0, 0xe6, 0x90, 0x12, 0x53, 0x3a, 0x86, 0xe2, 0x7a, 0x1f, 0x5a,
-	0x7e, 0xeb, 0xa1, 0x25, 0x38, 0xb1, 0x98, 0x06, 0x42, 0x2f, 0x0f, 0x3e, 0x4d, 0xef, 0x38, 0x90,
-	0x0c, 0x28, 0xfb, 0x7e, 0x1b, 0x41, 0x8b, 0xd4, 0x0c, 0x73, 0x04, 0xa2, 0xd6, 0x41, 0x1c, 0xc1,
-	0x76, 0x05, 0xdb, 0x29, 0xd8, 0xa6, 0xe8, 0x1f, 0x14, 0x43, 0xb0, 0xed, 0x14, 0x2b, 0xfe, 0xdb,
-	0x67, 0xac, 0xec, 0xcd, 0xaf, 0xa2, 0xdf, 0xa1, 0x98, 0x01, 0xe9, 0x9b, 0x3a, 0xf7, 0x46, 0x5b,
-	0xfd, 0x36, 0x06, 0x22, 0x65, 0xa2, 0xf3, 0x3b, 0x60, 0xc2, 0x5b, 0xca, 0xa2, 0x74, 0xf3, 0x87,
-	0x41, 0xea, 0xec, 0x4d, 0x21, 0x3f, 0x18, 0xe3, 0x95, 0xe0, 0x1f, 0x82, 0x98, 0xbf, 0x57, 0x7f,
-	0xbf, 0x25, 0xce, 0x03, 0x09, 0x4b, 0x43, 0x80, 0xfc, 0xec, 0x08, 0xcc, 0x5c, 0xdb, 0x2c, 0x7f,
-	0xde, 0x12, 0xde, 0x0d, 0x15, 0x0a, 0x43, 0x43, 0xfd, 0x7a, 0x3b, 0x60, 0xc2, 0x5b, 0x0b, 0xe2,
-	0x80, 0x68, 0x38, 0x80, 0xa8, 0x6b, 0x6f, 0x23, 0xff, 0x18, 0xfc, 0x74, 0xfd, 0x4d, 0x1c, 0x10,
-	0x1e, 0xc4, 0x0a, 0xab, 0xc3, 0x63, 0xfd, 0x92, 0x6d, 0x90, 0xe8, 0x55, 0xf5, 0x5f, 0x43, 0xf0,
-	0xef, 0xa3, 0x85, 0xb5, 0x1f, 0x41, 0x7b, 0x85, 0x85, 0xf1, 0x67, 0xf6, 0x37, 0xaf, 0x54, 0x3c,
-	0xb9, 0xc8, 0x70, 0xa7, 0x17, 0x19, 0xee, 0xf3, 0x45, 0x86, 0x7b, 0x7d, 0x99, 0x09, 0x9d, 0x5e,
-	0x66, 0x42, 0x1f, 0x2f, 0x33, 0xa1, 0x07, 0x39, 0x96, 0xcf, 0x4d, 0xbe, 0x7f, 0xf0, 0x44, 0x6e,
-	0xad, 0xca, 0xf4, 0xc0, 0x84, 0x84, 0x89, 0x7d, 0x37, 0xea, 0xfc, 0xa3, 0xf8, 0xfb, 0x7b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc4, 0x79, 0xb6, 0xc7, 0xe9, 0x08, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x8d, 0x13, 0xbf, 0xb4, 0xa5, 0x59, 0x9c, 0xd4, 0x5d, 0x82, 0x6d, 0x6d, 0x8b,
+	0x14, 0x12, 0xb2, 0xdb, 0x98, 0x16, 0x90, 0x05, 0x87, 0xba, 0x1c, 0xc8, 0x21, 0xa2, 0xda, 0xe4,
+	0x52, 0x84, 0x14, 0x4d, 0xbc, 0xd3, 0xcd, 0xaa, 0xf6, 0xcc, 0xb2, 0x33, 0x76, 0x12, 0x24, 0x04,
+	0xe2, 0x80, 0x00, 0x09, 0xa9, 0xff, 0x01, 0x3d, 0x72, 0xcc, 0xa1, 0x07, 0xce, 0x9c, 0x7a, 0xa3,
+	0xea, 0x09, 0x38, 0x04, 0x94, 0x08, 0x85, 0xbf, 0x81, 0x13, 0xda, 0xd9, 0xd9, 0xcd, 0xda, 0xc9,
+	0xda, 0x1b, 0x73, 0x89, 0x32, 0xf3, 0xbe, 0xf7, 0xe3, 0xfb, 0xfc, 0xde, 0x9b, 0x85, 0x2a, 0xa1,
+	0xdb, 0x6d, 0x6c, 0xda, 0xb4, 0xdd, 0x46, 0xbe, 0xe9, 0x51, 0x9f, 0xa3, 0xb6, 0xd9, 0x5b, 0x35,
+	0xf9, 0x9e, 0xe1, 0xf9, 0x94, 0x53, 0x75, 0x5e, 0x00, 0x8c, 0x10, 0x60, 0x84, 0x00, 0xa3, 0xb7,
+	0xaa, 0xcd, 0xa2, 0x8e, 0x4b, 0xa8, 0x29, 0xfe, 0x86, 0x50, 0xed, 0x7a, 0x8b, 0xb2, 0x0e, 0x65,
+	0x66, 0x87, 0x39, 0x41, 0x88, 0x0e, 0x73, 0xa4, 0xe1, 0x46, 0x68, 0xd8, 0x12, 0x27, 0x33, 0x3c,
+	0x48, 0x53, 0xc9, 0xa1, 0x0e, 0x0d, 0xef, 0x83, 0xff, 0xe4, 0x6d, 0xd5, 0xa1, 0xd4, 0x69, 0x63,
+	0x53, 0x9c, 0xb6, 0xbb, 0x8f, 0x4c, 0xee, 0x76, 0x30, 0xe3, 0xa8, 0xe3, 0x85, 0x00, 0xfd, 0x4b,
+	0x80, 0x75, 0xe6, 0x7c, 0x88, 0xdb, 0x6e, 0x0f, 0xfb, 0xea, 0x6d, 0x28, 0x30, 0xd7, 0x21, 0xd8,
+	0x2f, 0x2b, 0x35, 0x65, 0xb1, 0xd8, 0x2c, 0xbf, 0x7c, 0xb6, 0x52, 0x92, 0x69, 0xee, 0xd9, 0xb6,
+	0x8f, 0x19, 0xdb, 0xe0, 0xbe, 0x4b, 0x1c, 0x4b, 0xe2, 0xd4, 0x6b, 0x30, 0xd1, 0x43, 0xa8, 0x9c,
+	0xaf, 0x29, 0x8b, 0x97, 0xad, 0xe0, 0xdf, 0xc6, 0xca, 0xb7, 0x4f, 0xab, 0xb9, 0x7f, 0x9e, 0x56,
+	0x73, 0x5f, 0x9f, 0x1c, 0x2c, 0x49, 0xd8, 0xf7, 0x27, 0x07, 0x4b, 0x73, 0xfd, 0xd2, 0xc8, 0x94,
+	0x7a, 0x09, 0xd4, 0xd3, 0x02, 0x2c, 0xcc, 0x3c, 0x4a, 0x18, 0xd6, 0x7f, 0xc9, 0xc3, 0xcc, 0x3a,
+	0x73, 0x36, 0x7d, 0x44, 0xd8, 0xa3, 0xb1, 0x0a, 0xfb, 0x08, 0x0a, 0xa8, 0x43, 0xbb, 0x84, 0x8b,
+	0xda, 0x8a, 0xcd, 0xdb, 0xcf, 0x0f, 0xab, 0xb9, 0x3f, 0x0e, 0xab, 0x73, 0xa1, 0x17, 0xb3, 0x1f,
+	0x1b, 0x2e, 0x35, 0x3b, 0x88, 0xef, 0x18, 0x6b, 0x84, 0xbf, 0x7c, 0xb6, 0x02, 0x32, 0xdc, 0x1a,
+	0xe1, 0x3f, 0x9d, 0x1c, 0x2c, 0x29, 0x96, 0xf4, 0x57, 0xdf, 0x87, 0x92, 0x8d, 0x19, 0x77, 0x09,
+	0xe2, 0x2e, 0x25, 0x5b, 0xad, 0x1d, 0xe4, 0x92, 0x2d, 0xd7, 0x2e, 0x4f, 0xd4, 0x94, 0xc5, 0x2b,
+	0x4d, 0xf8, 0xf7, 0xb0, 0x5a, 0xe8, 0xba, 0x84, 0xaf, 0xbe, 0x63, 0xa9, 0x09, 0xdc, 0xfd, 0x00,
+	0xb6, 0x66, 0xab, 0xcb, 0x30, 0x9b, 0xf4, 0xe6, 0xf4, 0x31, 0x26, 0xe5, 0x4b, 0x42, 0xae, 0x6b,
+	0x09, 0xc3, 0x66, 0x70, 0xaf, 0x2e, 0x40, 0xd1, 0xc7, 0x2d, 0xd7, 0x73, 0x31, 0xe1, 0xe5, 0x49,
+	0x01, 0x3a, 0xbd, 0x68, 0x18, 0x29, 0xca, 0xce, 0xf7, 0x2b, 0x1b, 0x89, 0xa6, 0xcf, 0xc1, 0xab,
+	0x09, 0x0d, 0x63, 0x6d, 0xff, 0x56, 0x60, 0x76, 0x9d, 0x39, 0x1b, 0x98, 0x3f, 0x40, 0x5d, 0x86,
+	0xed, 0x0d, 0x8e, 0x38, 0x1e, 0x43, 0xe1, 0x79, 0x28, 0x78, 0x22, 0x80, 0x50, 0x78, 0xda, 0x92,
+	0x27, 0xf5, 0x3d, 0x28, 0xe0, 0x3d, 0xcf, 0xf5, 0xf7, 0x85, 0x42, 0x33, 0x75, 0xcd, 0x08, 0x9b,
+	0xd0, 0x88, 0x9a, 0xd0, 0xd8, 0x8c, 0x9a, 0xb0, 0x79, 0xe9, 0xc9, 0x9f, 0x55, 0xc5, 0x92, 0xf8,
+	0x20, 0xa2, 0x8f, 0x11, 0xa3, 0xa1, 0x40, 0x45, 0x4b, 0x9e, 0x1a, 0x77, 0x52, 0x88, 0x2f, 0xf4,
+	0x13, 0xef, 0x67, 0xa4, 0xbf, 0x06, 0x37, 0xce, 0xd0, 0x8c, 0x45, 0xf8, 0x55, 0x01, 0x90, 0x56,
+	0x3c, 0x56, 0x7f, 0xdd, 0x82, 0x49, 0xd1, 0x09, 0x82, 0xfc, 0x95, 0xe6, 0x55, 0xd9, 0x5e, 0x51,
+	0x2b, 0x84, 0x46, 0xb5, 0x06, 0x33, 0x3c, 0xd0, 0xbf, 0x85, 0x83, 0xf6, 0x16, 0x82, 0x5c, 0xb6,
+	0x92, 0x57, 0x6a, 0x19, 0xa6, 0x3a, 0x88, 0x20, 0x07, 0xfb, 0xb2, 0x2b, 0xa2, 0x63, 0xd6, 0x41,
+	0x92, 0x14, 0xe4, 0x20, 0xc9, 0x53, 0xcc, 0xf3, 0x9b, 0x7c, 0x74, 0xdd, 0xf4, 0x5d, 0xdb, 0x71,
+	0x89, 0xf3, 0x00, 0xf1, 0x9d, 0x31, 0xf8, 0xa6, 0x4d, 0x41, 0x7e, 0xfc, 0x29, 0x98, 0x48, 0x9f,
+	0x02, 0xd6, 0xf5, 0x02, 0x7a, 0xd8, 0x16, 0xa2, 0x4c, 0x5b, 0xa7, 0x17, 0x8d, 0xbb, 0x29, 0xb2,
+	0xbc, 0x7e, 0x46, 0x96, 0x24, 0x63, 0x7d, 0x01, 0xb4, 0xb3, 0x3a, 0xc4, 0x32, 0xfd, 0xac, 0x40,
+	0x29, 0x31, 0x2b, 0x1f, 0xef, 0x12, 0xec, 0xb3, 0x1d, 0xd7, 0x1b, 0x43, 0xa8, 0xbb, 0x50, 0x24,
+	0x78, 0x77, 0x8b, 0x06, 0x21, 0xca, 0xf9, 0x11, 0x4e, 0xd3, 0x04, 0xef, 0x8a, 0x64, 0x8d, 0x77,
+	0x53, 0x68, 0x55, 0xcf, 0x1f, 0xee, 0xb8, 0x42, 0xbd, 0x02, 0x0b, 0xe7, 0x55, 0x1e, 0x53, 0xfb,
+	0x42, 0x34, 0xc0, 0xbd, 0x56, 0x0b, 0x7b, 0xfc, 0x7f, 0xf0, 0xca, 0xaa, 0xfb, 0x40, 0x22, 0xa9,
+	0xfb, 0xc0, 0x6d, 0x5c, 0xdc, 0x0f, 0x8a, 0x30, 0xdf, 0x47, 0xa4, 0x85, 0xdb, 0xb1, 0x79, 0xfc,
+	0xb5, 0xdf, 0xf8, 0x20, 0xa5, 0xca, 0x37, 0xfa, 0xab, 0x4c, 0x49, 0xa8, 0xdf, 0x02, 0x3d, 0xbd,
+	0x9c, 0xb8, 0xea, 0x1f, 0x15, 0xf1, 0x3a, 0x6d, 0xe0, 0x90, 0xd1, 0x18, 0x4d, 0x62, 0xc0, 0x64,
+	0xb6, 0x06, 0x09, 0x61, 0x59, 0x57, 0x7f, 0x54, 0x91, 0x5c, 0xfd, 0xd1, 0x31, 0x2a, 0xbc, 0xfe,
+	0xfb, 0x14, 0x4c, 0xac, 0x33, 0x47, 0x7d, 0x08, 0x53, 0xd1, 0x93, 0xaf, 0x1b, 0xe7, 0x7f, 0x97,
+	0x18, 0xa7, 0xaf, 0xb2, 0xb6, 0x34, 0x1a, 0x13, 0xa5, 0x50, 0x3f, 0x85, 0xe9, 0xf8, 0xe7, 0xbb,
+	0x39, 0xc4, 0x2f, 0x02, 0x69, 0xcb, 0x19, 0x40, 0x71, 0x74, 0x02, 0x57, 0x07, 0xde, 0xad, 0x37,
+	0x87, 0xb8, 0xf7, 0x43, 0xb5, 0xd5, 0xcc, 0xd0, 0x38, 0xdf, 0x43, 0x98, 0x8a, 0x9e, 0x08, 0x7d,
+	0x84, 0x37, 0x1e, 0x21, 0xd4, 0xc0, 0x66, 0x56, 0x3f, 0x83, 0x57, 0x06, 0xb7, 0xf2, 0x08, 0xf7,
+	0x24, 0x56, 0xab, 0x67, 0xc7, 0xc6, 0x29, 0x77, 0x61, 0xf6, 0xec, 0x86, 0x7b, 0x2b, 0x83, 0xfe,
+	0x31, 0x5a, 0xbb, 0x73, 0x11, 0x74, 0x92, 0xeb, 0xe0, 0x02, 0x1a, 0xc6, 0x75, 0x00, 0xab, 0xd5,
+	0xb3, 0x63, 0xe3, 0x94, 0xdf, 0x29, 0x70, 0x3d, 0x6d, 0xad, 0x0c, 0x8b, 0x97, 0xe2, 0xa3, 0x35,
+	0x2e, 0xee, 0x93, 0x9c, 0x89, 0x78, 0x57, 0xdc, 0x1c, 0xfe, 0xbb, 0x09, 0x90, 0xb6, 0x9c, 0x01,
+	0x14, 0x45, 0xd7, 0x26, 0xbf, 0x0a, 0x3e, 0x57, 0x9b, 0x8d, 0xe7, 0x47, 0x15, 0xe5, 0xc5, 0x51,
+	0x45, 0xf9, 0xeb, 0xa8, 0xa2, 0x3c, 0x39, 0xae, 0xe4, 0x5e, 0x1c, 0x57, 0x72, 0xbf, 0x1d, 0x57,
+	0x72, 0x9f, 0xd4, 0x64, 0x98, 0x30, 0xe6, 0xde, 0xfe, 0xe7, 0x66, 0xaf, 0x6e, 0xf2, 0x7d, 0x0f,
+	0x33, 0xb9, 0x37, 0xb6, 0x0b, 0xe2, 0xd3, 0xec, 0xed, 0xff, 0x06, 0x00, 0x96, 0xb4, 0xf2, 0x3c,
+	0xc5, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,6 +839,9 @@ type MsgClient interface {
 	SetPeer(ctx context.Context, in *MsgSetPeer, opts ...grpc.CallOption) (*MsgSetPeerResponse, error)
 	SetBridgingPath(ctx context.Context, in *MsgSetBridgingPath, opts ...grpc.CallOption) (*MsgSetBridgingPathResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error)
+	SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error)
 }
 
 type msgClient struct {
@@ -651,6 +906,33 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error) {
+	out := new(MsgAcceptOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.portal.v1.Msg/AcceptOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error) {
+	out := new(MsgCancelOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.portal.v1.Msg/CancelOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOwner(ctx context.Context, in *MsgSetOwner, opts ...grpc.CallOption) (*MsgSetOwnerResponse, error) {
+	out := new(MsgSetOwnerResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.portal.v1.Msg/SetOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Deliver(context.Context, *MsgDeliver) (*MsgDeliverResponse, error)
@@ -659,6 +941,9 @@ type MsgServer interface {
 	SetPeer(context.Context, *MsgSetPeer) (*MsgSetPeerResponse, error)
 	SetBridgingPath(context.Context, *MsgSetBridgingPath) (*MsgSetBridgingPathResponse, error)
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
+	CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error)
+	SetOwner(context.Context, *MsgSetOwner) (*MsgSetOwnerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -683,6 +968,15 @@ func (*UnimplementedMsgServer) SetBridgingPath(ctx context.Context, req *MsgSetB
 func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (*UnimplementedMsgServer) AcceptOwnership(ctx context.Context, req *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelOwnershipTransfer(ctx context.Context, req *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetOwner(ctx context.Context, req *MsgSetOwner) (*MsgSetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwner not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -796,6 +1090,60 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.portal.v1.Msg/AcceptOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnership(ctx, req.(*MsgAcceptOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.portal.v1.Msg/CancelOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, req.(*MsgCancelOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.portal.v1.Msg/SetOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOwner(ctx, req.(*MsgSetOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "noble.dollar.portal.v1.Msg",
@@ -825,6 +1173,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "AcceptOwnership",
+			Handler:    _Msg_AcceptOwnership_Handler,
+		},
+		{
+			MethodName: "CancelOwnershipTransfer",
+			Handler:    _Msg_CancelOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "SetOwner",
+			Handler:    _Msg_SetOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/dollar/portal/v1/tx.proto",
@@ -992,6 +1352,23 @@ func (m *MsgSetPausedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -1242,44 +1619,210 @@ func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAcceptOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDeliver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAcceptOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeliverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTransfer) Size() (n int) {
+func (m *MsgAcceptOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeliver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransfer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1327,6 +1870,14 @@ func (m *MsgSetPausedState) Size() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1430,6 +1981,76 @@ func (m *MsgTransferOwnershipResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAcceptOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1665,7 +2286,667 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
+			}
+			m.DestinationChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationChainId |= uint16(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationToken = append(m.DestinationToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationToken == nil {
+				m.DestinationToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint16(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transceiver", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transceiver = append(m.Transceiver[:0], dAtA[iNdEx:postIndex]...)
+			if m.Transceiver == nil {
+				m.Transceiver = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = append(m.Manager[:0], dAtA[iNdEx:postIndex]...)
+			if m.Manager == nil {
+				m.Manager = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPeerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgingPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgingPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgingPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1693,11 +2974,9 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
 			}
@@ -1716,7 +2995,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationToken", wireType)
 			}
@@ -1750,11 +3029,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				m.DestinationToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supported", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1764,26 +3043,12 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
-			}
-			iNdEx = postIndex
+			m.Supported = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1805,7 +3070,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgingPathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,10 +3093,10 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgingPathResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgingPathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1855,7 +3120,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1878,10 +3143,10 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPausedState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPausedState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1917,10 +3182,10 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1930,12 +3195,24 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1957,7 +3234,7 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPausedStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,10 +3257,10 @@ func (m *MsgSetPausedStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPausedStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPausedStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2007,7 +3284,7 @@ func (m *MsgSetPausedStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPeer) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,10 +3307,10 @@ func (m *MsgSetPeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2068,93 +3345,6 @@ func (m *MsgSetPeer) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			m.Chain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Chain |= uint16(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transceiver", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transceiver = append(m.Transceiver[:0], dAtA[iNdEx:postIndex]...)
-			if m.Transceiver == nil {
-				m.Transceiver = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manager = append(m.Manager[:0], dAtA[iNdEx:postIndex]...)
-			if m.Manager == nil {
-				m.Manager = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2176,7 +3366,7 @@ func (m *MsgSetPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,10 +3389,10 @@ func (m *MsgSetPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2226,7 +3416,7 @@ func (m *MsgSetPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgingPath) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2249,10 +3439,10 @@ func (m *MsgSetBridgingPath) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgingPath: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgingPath: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2287,79 +3477,6 @@ func (m *MsgSetBridgingPath) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
-			}
-			m.DestinationChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestinationChainId |= uint16(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationToken = append(m.DestinationToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationToken == nil {
-				m.DestinationToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supported", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Supported = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2381,7 +3498,7 @@ func (m *MsgSetBridgingPath) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgingPathResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,10 +3521,10 @@ func (m *MsgSetBridgingPathResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgingPathResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgingPathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2431,7 +3548,7 @@ func (m *MsgSetBridgingPathResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2454,10 +3571,10 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2494,7 +3611,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2522,7 +3639,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2545,7 +3662,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2568,10 +3685,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
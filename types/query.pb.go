@@ -313,6 +313,7 @@ func (m *QueryYield) GetAccount() string {
 
 type QueryYieldResponse struct {
 	ClaimableAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=claimable_amount,json=claimableAmount,proto3,customtype=cosmossdk.io/math.Int" json:"claimable_amount"`
+	AttestedAmount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=attested_amount,json=attestedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"attested_amount"`
 }
 
 func (m *QueryYieldResponse) Reset()         { *m = QueryYieldResponse{} }
@@ -446,49 +447,50 @@ func init() {
 func init() { proto.RegisterFile("noble/dollar/v1/query.proto", fileDescriptor_68e513755cb588f4) }
 
 var fileDescriptor_68e513755cb588f4 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
-	0x1c, 0xdd, 0x22, 0x45, 0x19, 0xf9, 0x23, 0x03, 0xe2, 0x52, 0xb0, 0x90, 0x42, 0xd4, 0x90, 0xd0,
-	0x11, 0x30, 0x9e, 0x85, 0x70, 0x90, 0x84, 0x18, 0x85, 0x13, 0x7a, 0x58, 0x67, 0xdb, 0x49, 0x69,
-	0xec, 0xce, 0x94, 0xce, 0x2c, 0x61, 0x35, 0x5e, 0x3c, 0x71, 0x34, 0xf1, 0x4b, 0x78, 0xf4, 0xc0,
-	0x87, 0xe0, 0x48, 0xf0, 0x62, 0x3c, 0x10, 0x02, 0x26, 0x7e, 0x0a, 0x13, 0xd3, 0x99, 0x69, 0xbb,
-	0xec, 0xba, 0x10, 0xf7, 0xb2, 0xd9, 0xf9, 0xbd, 0xdf, 0xbc, 0xf7, 0xe6, 0x37, 0xf3, 0x0a, 0x26,
-	0x29, 0xab, 0x46, 0x04, 0xf9, 0x2c, 0x8a, 0x70, 0x82, 0xf6, 0x16, 0xd1, 0x6e, 0x9d, 0x24, 0x0d,
-	0x37, 0x4e, 0x98, 0x60, 0x70, 0x58, 0x82, 0xae, 0x02, 0xdd, 0xbd, 0x45, 0x6b, 0x04, 0xd7, 0x42,
-	0xca, 0x90, 0xfc, 0x55, 0x3d, 0xd6, 0xa4, 0xc7, 0x78, 0x8d, 0x71, 0xb5, 0xaf, 0x85, 0xc0, 0x9a,
-	0x50, 0x60, 0x45, 0xae, 0x90, 0x5a, 0x68, 0x68, 0x2c, 0x60, 0x01, 0x53, 0xf5, 0xf4, 0x9f, 0xae,
-	0x4e, 0x05, 0x8c, 0x05, 0x11, 0x41, 0x38, 0x0e, 0x11, 0xa6, 0x94, 0x09, 0x2c, 0x42, 0x46, 0xf5,
-	0x1e, 0x67, 0x00, 0x80, 0x57, 0x29, 0xfb, 0x3a, 0xf5, 0xc9, 0xbe, 0x53, 0x05, 0xb0, 0x58, 0x6d,
-	0x12, 0x1e, 0x33, 0xca, 0x09, 0xdc, 0x00, 0x66, 0x98, 0x16, 0xca, 0xc6, 0x8c, 0xf1, 0xa8, 0x7f,
-	0xf5, 0xe9, 0xd1, 0xe9, 0x74, 0xe9, 0xe7, 0xe9, 0xb4, 0xb6, 0xc9, 0xfd, 0x77, 0x6e, 0xc8, 0x50,
-	0x0d, 0x8b, 0x1d, 0x77, 0x83, 0x04, 0xd8, 0x6b, 0xac, 0x11, 0xef, 0xe4, 0x70, 0x01, 0x68, 0x6f,
-	0x6b, 0xc4, 0xfb, 0xfa, 0xfb, 0xdb, 0xbc, 0xb1, 0xa9, 0x48, 0x9c, 0x41, 0x70, 0x5b, 0x6a, 0xbc,
-	0xc4, 0x75, 0x4e, 0x7c, 0xe7, 0x09, 0x18, 0x6d, 0x5a, 0xe6, 0x9a, 0xf7, 0x41, 0x5f, 0x2c, 0x2b,
-	0x52, 0xf4, 0xd6, 0xaa, 0xa9, 0x38, 0x74, 0xd1, 0x59, 0x03, 0x43, 0x6a, 0x57, 0x12, 0x52, 0x2f,
-	0x8c, 0x71, 0x04, 0x97, 0xc0, 0x4d, 0xec, 0x79, 0xac, 0x4e, 0x85, 0xb6, 0x59, 0x3e, 0x39, 0x5c,
-	0x18, 0xd3, 0x1e, 0x56, 0x7c, 0x3f, 0x21, 0x9c, 0x6f, 0x89, 0x24, 0xa4, 0xc1, 0x66, 0xd6, 0xe8,
-	0xec, 0x80, 0xf1, 0xcb, 0x2c, 0xb9, 0xfc, 0x0b, 0xd0, 0x1f, 0x67, 0x45, 0xcd, 0xf7, 0x58, 0x1f,
-	0xfb, 0x6e, 0xfb, 0xb1, 0xd7, 0xa9, 0x68, 0x3a, 0xf0, 0x3a, 0x15, 0xca, 0x6c, 0x41, 0xe1, 0x3c,
-	0xd3, 0x63, 0xde, 0x0e, 0x49, 0xe4, 0x77, 0xe5, 0x75, 0x57, 0x5f, 0x8d, 0x64, 0xc8, 0x7d, 0xbe,
-	0x01, 0x77, 0xbc, 0x08, 0x87, 0x35, 0x5c, 0x8d, 0x48, 0x05, 0xd7, 0x9a, 0x28, 0xff, 0xdf, 0xee,
-	0x70, 0xce, 0xb4, 0x22, 0x89, 0xf2, 0xb7, 0xb1, 0x25, 0xb0, 0xe0, 0xce, 0x1f, 0x43, 0x3b, 0x90,
-	0xcb, 0xdc, 0xc1, 0x3c, 0x18, 0x14, 0x4c, 0xe0, 0xa8, 0xb2, 0xc3, 0x22, 0x9f, 0x24, 0x5c, 0xca,
-	0xf7, 0x66, 0xf7, 0x35, 0x20, 0xb1, 0xe7, 0x0a, 0x82, 0xdb, 0x60, 0x58, 0xf5, 0x16, 0xb3, 0xed,
-	0xe9, 0xd2, 0xec, 0x90, 0x24, 0x2a, 0xae, 0xff, 0x2d, 0x18, 0x55, 0xd4, 0x8d, 0x74, 0x3e, 0x15,
-	0xec, 0x79, 0x49, 0x9d, 0xf8, 0xe5, 0x1b, 0x5d, 0xd2, 0x8f, 0x48, 0x32, 0x39, 0xeb, 0x15, 0x45,
-	0xb5, 0x74, 0xd6, 0x0b, 0x4c, 0x79, 0x7e, 0x48, 0x81, 0x29, 0x03, 0x02, 0x27, 0xdd, 0x96, 0x34,
-	0xbb, 0x45, 0x7a, 0xac, 0xd9, 0x2b, 0xc0, 0x6c, 0x7a, 0xce, 0xec, 0x41, 0x2a, 0xf7, 0xe9, 0xfb,
-	0xaf, 0x2f, 0x3d, 0x65, 0x38, 0x8e, 0x5a, 0xbf, 0x1c, 0x32, 0x31, 0x30, 0x01, 0x7d, 0x2a, 0x1d,
-	0x70, 0xea, 0xdf, 0x9c, 0x0a, 0xb5, 0xe6, 0xae, 0x42, 0x73, 0xc9, 0xb9, 0x42, 0x72, 0x02, 0xde,
-	0x6b, 0x93, 0x54, 0x01, 0x83, 0x07, 0x06, 0xe8, 0x2f, 0xa6, 0x3b, 0xdd, 0x81, 0x39, 0x6b, 0xb0,
-	0x1e, 0x5e, 0xd3, 0x90, 0xab, 0x2f, 0x16, 0xea, 0x0f, 0xe0, 0x5c, 0xbb, 0x7a, 0xb6, 0x01, 0x7d,
-	0xd0, 0x0f, 0xff, 0x23, 0xdc, 0x07, 0xa6, 0x8a, 0x4d, 0x87, 0x71, 0x4b, 0xb0, 0xd3, 0xb8, 0x2f,
-	0xc5, 0xc5, 0x59, 0x28, 0xd4, 0x1d, 0x38, 0xd3, 0xa6, 0x2e, 0xdf, 0x4e, 0x93, 0x32, 0x05, 0xa6,
-	0x7c, 0xec, 0x9d, 0x94, 0x25, 0xd8, 0x49, 0xf9, 0x52, 0x4c, 0xae, 0xbb, 0x68, 0x9e, 0x36, 0xaf,
-	0x2e, 0x1f, 0x9d, 0xdb, 0xc6, 0xf1, 0xb9, 0x6d, 0x9c, 0x9d, 0xdb, 0xc6, 0xe7, 0x0b, 0xbb, 0x74,
-	0x7c, 0x61, 0x97, 0x7e, 0x5c, 0xd8, 0xa5, 0xd7, 0x13, 0x9a, 0x5c, 0x29, 0xed, 0x37, 0xde, 0xa3,
-	0xbd, 0x25, 0x24, 0x1a, 0x31, 0xe1, 0xd5, 0x3e, 0xf9, 0x21, 0x5f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0xa5, 0xde, 0xbf, 0x77, 0x06, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0xfc, 0x58, 0x7e, 0xf2, 0xc8, 0x1f, 0x19, 0x10, 0x97, 0x82, 0x0b, 0xe9, 0x12,
+	0x35, 0x24, 0xb4, 0x02, 0xc6, 0xb3, 0x10, 0x0e, 0x92, 0x10, 0xa3, 0x70, 0x42, 0x0f, 0xeb, 0x6c,
+	0x3b, 0x29, 0x8d, 0xdd, 0x99, 0xda, 0x99, 0x25, 0xac, 0xc6, 0x8b, 0x27, 0x8e, 0x26, 0xbe, 0x09,
+	0x8f, 0x1e, 0x78, 0x11, 0x1c, 0x3c, 0x10, 0xbc, 0x18, 0x0f, 0x84, 0x80, 0x89, 0xaf, 0xc2, 0xc4,
+	0x74, 0x66, 0xda, 0xae, 0xac, 0xbb, 0xea, 0x5e, 0x08, 0xf3, 0x7c, 0x9f, 0xf9, 0x7c, 0x9f, 0x67,
+	0x66, 0x9e, 0x2e, 0x4c, 0x53, 0x56, 0x0b, 0x89, 0xe3, 0xb1, 0x30, 0xc4, 0xb1, 0xb3, 0xb7, 0xe4,
+	0xbc, 0x6c, 0x90, 0xb8, 0x69, 0x47, 0x31, 0x13, 0x0c, 0x8d, 0x4a, 0xd1, 0x56, 0xa2, 0xbd, 0xb7,
+	0x64, 0x8e, 0xe1, 0x7a, 0x40, 0x99, 0x23, 0xff, 0xaa, 0x1c, 0x73, 0xda, 0x65, 0xbc, 0xce, 0xb8,
+	0xda, 0x77, 0x09, 0x60, 0x4e, 0x29, 0xb1, 0x2a, 0x57, 0x8e, 0x5a, 0x68, 0x69, 0xc2, 0x67, 0x3e,
+	0x53, 0xf1, 0xe4, 0x3f, 0x1d, 0x9d, 0xf1, 0x19, 0xf3, 0x43, 0xe2, 0xe0, 0x28, 0x70, 0x30, 0xa5,
+	0x4c, 0x60, 0x11, 0x30, 0xaa, 0xf7, 0x58, 0x43, 0x00, 0x4f, 0x12, 0xfa, 0x06, 0xf5, 0xc8, 0xbe,
+	0x55, 0x03, 0x94, 0xaf, 0xb6, 0x08, 0x8f, 0x18, 0xe5, 0x04, 0x6d, 0x42, 0x31, 0x48, 0x02, 0x25,
+	0x63, 0xce, 0xb8, 0x33, 0xb8, 0x76, 0xff, 0xe8, 0x74, 0xb6, 0xf0, 0xf5, 0x74, 0x56, 0x97, 0xc9,
+	0xbd, 0x17, 0x76, 0xc0, 0x9c, 0x3a, 0x16, 0xbb, 0xf6, 0x26, 0xf1, 0xb1, 0xdb, 0x5c, 0x27, 0xee,
+	0xc9, 0xe1, 0x22, 0xe8, 0xda, 0xd6, 0x89, 0xfb, 0xe1, 0xfb, 0xc7, 0x05, 0x63, 0x4b, 0x41, 0xac,
+	0x61, 0xb8, 0x2a, 0x3d, 0x1e, 0xe3, 0x06, 0x27, 0x9e, 0x75, 0x0f, 0xc6, 0x5b, 0x96, 0x99, 0xe7,
+	0x4d, 0x18, 0x88, 0x64, 0x44, 0x9a, 0x5e, 0x59, 0x2b, 0x2a, 0x86, 0x0e, 0x5a, 0xeb, 0x30, 0xa2,
+	0x76, 0xc5, 0x01, 0x75, 0x83, 0x08, 0x87, 0x68, 0x19, 0xfe, 0xc7, 0xae, 0xcb, 0x1a, 0x54, 0xe8,
+	0x32, 0x4b, 0x27, 0x87, 0x8b, 0x13, 0xba, 0x86, 0x55, 0xcf, 0x8b, 0x09, 0xe7, 0xdb, 0x22, 0x0e,
+	0xa8, 0xbf, 0x95, 0x26, 0x5a, 0xbb, 0x30, 0xf9, 0x2b, 0x25, 0xb3, 0x7f, 0x04, 0x83, 0x51, 0x1a,
+	0xd4, 0xbc, 0xbb, 0xba, 0xed, 0xeb, 0xed, 0x6d, 0x6f, 0x50, 0xd1, 0xd2, 0xf0, 0x06, 0x15, 0xaa,
+	0xd8, 0x1c, 0x61, 0x3d, 0xd0, 0xc7, 0xbc, 0x13, 0x90, 0xd0, 0xeb, 0xa9, 0xd6, 0x4f, 0x06, 0xa0,
+	0x1c, 0x91, 0x15, 0xfa, 0x0c, 0xae, 0xb9, 0x21, 0x0e, 0xea, 0xb8, 0x16, 0x92, 0x2a, 0xae, 0xb7,
+	0x30, 0xff, 0xbd, 0xde, 0xd1, 0x8c, 0xb4, 0x2a, 0x41, 0x68, 0x07, 0x46, 0xb1, 0x10, 0x84, 0x0b,
+	0xe2, 0xa5, 0xec, 0xbe, 0x1e, 0xd9, 0x23, 0x29, 0x48, 0xa1, 0xb3, 0x77, 0xb7, 0x2d, 0xb0, 0xe0,
+	0xd6, 0x8f, 0xb4, 0x39, 0xb9, 0xcc, 0x9a, 0x5b, 0x80, 0x61, 0xc1, 0x04, 0x0e, 0xab, 0xbb, 0x2c,
+	0xf4, 0x48, 0xcc, 0x65, 0x67, 0xfd, 0xe9, 0x5b, 0x18, 0x92, 0xda, 0x43, 0x25, 0x25, 0xb5, 0xaa,
+	0xdc, 0xfc, 0xde, 0x7a, 0xae, 0x55, 0x82, 0xf2, 0xa7, 0xf5, 0x1c, 0xc6, 0x15, 0xba, 0x99, 0x1c,
+	0x7d, 0x15, 0xbb, 0x6e, 0xdc, 0x20, 0x5e, 0xe9, 0xbf, 0x1e, 0xf1, 0x63, 0x12, 0x26, 0xaf, 0x71,
+	0x55, 0xa1, 0x96, 0xcf, 0xfa, 0xa1, 0x28, 0xfb, 0x47, 0x14, 0x8a, 0x72, 0xf8, 0xd0, 0xb4, 0x7d,
+	0xe9, 0x4b, 0x61, 0xe7, 0x93, 0x69, 0x56, 0xba, 0x88, 0xe9, 0xe9, 0x59, 0x95, 0x83, 0xc4, 0xee,
+	0xed, 0xe7, 0x6f, 0xef, 0xfb, 0x4a, 0x68, 0xd2, 0xb9, 0xfc, 0x55, 0x92, 0xd3, 0x88, 0x62, 0x18,
+	0x50, 0x93, 0x87, 0x66, 0x7e, 0xcf, 0x54, 0xaa, 0x39, 0xdf, 0x4d, 0xcd, 0x2c, 0xe7, 0x73, 0xcb,
+	0x29, 0x74, 0xa3, 0xcd, 0x52, 0x0d, 0x2f, 0x3a, 0x30, 0x60, 0x30, 0x3f, 0xdd, 0xd9, 0x0e, 0xe4,
+	0x34, 0xc1, 0xbc, 0xfd, 0x87, 0x84, 0xcc, 0x7d, 0x29, 0x77, 0xbf, 0x85, 0xe6, 0xdb, 0xdd, 0xd3,
+	0x0d, 0xce, 0x6b, 0x3d, 0x54, 0x6f, 0xd0, 0x3e, 0x14, 0xd5, 0x48, 0x76, 0x38, 0x6e, 0x29, 0x9a,
+	0x95, 0x2e, 0x62, 0xe6, 0xbe, 0x98, 0xbb, 0x5b, 0x68, 0xae, 0xcd, 0x5d, 0xbe, 0x9d, 0x16, 0x67,
+	0x0a, 0x45, 0xf9, 0xd8, 0x3b, 0x39, 0x4b, 0xd1, 0xac, 0x74, 0x11, 0xff, 0xf6, 0xa2, 0x79, 0x92,
+	0xbc, 0xb6, 0x72, 0x74, 0x5e, 0x36, 0x8e, 0xcf, 0xcb, 0xc6, 0xd9, 0x79, 0xd9, 0x78, 0x77, 0x51,
+	0x2e, 0x1c, 0x5f, 0x94, 0x0b, 0x5f, 0x2e, 0xca, 0x85, 0xa7, 0x53, 0x1a, 0xae, 0x9c, 0xf6, 0x9b,
+	0xaf, 0x9c, 0xbd, 0x65, 0x47, 0x34, 0x23, 0xc2, 0x6b, 0x03, 0xf2, 0x47, 0x62, 0xe5, 0xe7, 0x00,
+	0x98, 0x41, 0xf0, 0xc7, 0xd3, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -941,6 +943,16 @@ func (m *QueryYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AttestedAmount.Size()
+		i -= size
+		if _, err := m.AttestedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.ClaimableAmount.Size()
 		i -= size
@@ -1122,6 +1134,8 @@ func (m *QueryYieldResponse) Size() (n int) {
 	_ = l
 	l = m.ClaimableAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.AttestedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1721,6 +1735,40 @@ func (m *QueryYieldResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
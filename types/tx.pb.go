@@ -12,18 +12,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -110,6 +114,10 @@ var xxx_messageInfo_MsgClaimYieldResponse proto.InternalMessageInfo
 type MsgSetPausedState struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// expiry is the time after which the pause is automatically lifted, indefinite if not set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// reason is a description of why the pause was set.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgSetPausedState) Reset()         { *m = MsgSetPausedState{} }
@@ -192,31 +200,35 @@ func init() {
 func init() { proto.RegisterFile("noble/dollar/v1/tx.proto", fileDescriptor_cda63894d37623a5) }
 
 var fileDescriptor_cda63894d37623a5 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xc9, 0xcf, 0xc9, 0x49, 0x2c, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x07, 0xcb, 0xe8, 0x41, 0x64, 0xf4, 0xca, 0x0c, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e,
-	0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0x3a, 0x48, 0x6f, 0x6e, 0x71, 0x3a, 0x54, 0x42, 0x12, 0x22, 0x11,
-	0x0f, 0xe6, 0xe9, 0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05,
-	0x11, 0x55, 0xca, 0xe1, 0xe2, 0xf5, 0x2d, 0x4e, 0x77, 0xce, 0x49, 0xcc, 0xcc, 0x8d, 0xcc, 0x4c,
-	0xcd, 0x49, 0x11, 0x32, 0xe0, 0x62, 0x2b, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x90, 0x63, 0x4a, 0x4a, 0x51, 0x6a,
-	0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x54, 0x9d, 0x95, 0x66, 0xc7, 0x02, 0x79,
-	0x86, 0x17, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x82, 0x0a, 0x76, 0x3d, 0xdf, 0xa0, 0x25,
-	0x08, 0xf5, 0x1c, 0xc2, 0x70, 0x25, 0x71, 0x2e, 0x51, 0x14, 0xdb, 0x82, 0x52, 0x8b, 0x0b, 0xf2,
-	0xf3, 0x8a, 0x53, 0x95, 0x7a, 0x18, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x83, 0x53, 0x4b, 0x02, 0x12,
-	0x4b, 0x8b, 0x53, 0x53, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x49, 0x77, 0x8b, 0x90, 0x18, 0x17, 0x5b,
-	0x01, 0xd8, 0x00, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x28, 0xcf, 0x4a, 0x17, 0x87, 0x1b,
-	0x45, 0xa1, 0x6e, 0x44, 0xb5, 0x58, 0x49, 0x9a, 0x4b, 0x12, 0xc3, 0x35, 0x30, 0xb7, 0x1a, 0x1d,
-	0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x0a, 0xe1, 0xe2, 0x42, 0x0a, 0x37, 0x39, 0x3d, 0xb4,
-	0x78, 0xd3, 0x43, 0xf1, 0xa9, 0x94, 0x1a, 0x7e, 0x79, 0x98, 0xe9, 0x42, 0x09, 0x5c, 0x7c, 0x68,
-	0xa1, 0xa0, 0x84, 0x4d, 0x27, 0xaa, 0x1a, 0x29, 0x2d, 0xc2, 0x6a, 0x60, 0x36, 0x48, 0xb1, 0x36,
-	0x3c, 0xdf, 0xa0, 0xc5, 0xe8, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x92, 0x50, 0x53, 0x20, 0x46, 0x56, 0x54, 0x56, 0xe9, 0x97, 0x19, 0xe9, 0x97, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x53, 0x8d, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xed, 0x2f, 0x9e,
-	0xc6, 0xbf, 0x02, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbd, 0x8e, 0xd3, 0x40,
+	0x18, 0xf4, 0x72, 0x87, 0x05, 0x8b, 0x00, 0xc5, 0xba, 0xe3, 0x1c, 0x23, 0xd9, 0x91, 0x0b, 0x14,
+	0x2c, 0xdd, 0x2e, 0x97, 0x6b, 0xd0, 0x75, 0x84, 0x3a, 0x12, 0x72, 0xae, 0x81, 0x06, 0x1c, 0xbc,
+	0xac, 0x2c, 0xd9, 0x5e, 0xcb, 0xdf, 0x5e, 0x94, 0x50, 0x21, 0x2a, 0x44, 0x75, 0x8f, 0x70, 0x8f,
+	0x90, 0x82, 0x47, 0xa0, 0xa0, 0x8c, 0xa8, 0xa8, 0x00, 0x25, 0x45, 0x78, 0x0c, 0x64, 0xef, 0x5a,
+	0xe0, 0xf0, 0x27, 0x9a, 0x28, 0xf3, 0xcd, 0xec, 0x7c, 0x3b, 0xb3, 0xc6, 0x76, 0x2e, 0x26, 0x29,
+	0xa3, 0xb1, 0x48, 0xd3, 0xa8, 0xa4, 0xd3, 0x23, 0x2a, 0x67, 0xa4, 0x28, 0x85, 0x14, 0xd6, 0xcd,
+	0x9a, 0x21, 0x8a, 0x21, 0xd3, 0x23, 0xa7, 0x13, 0x65, 0x49, 0x2e, 0x68, 0xfd, 0xab, 0x34, 0xce,
+	0xc1, 0x73, 0x01, 0x99, 0x00, 0x9a, 0x01, 0xaf, 0xce, 0x66, 0xc0, 0x35, 0xd1, 0x55, 0xc4, 0xd3,
+	0x1a, 0x51, 0x05, 0x34, 0xb5, 0xc7, 0x05, 0x17, 0x6a, 0x5e, 0xfd, 0xd3, 0x53, 0x8f, 0x0b, 0xc1,
+	0x53, 0x46, 0x6b, 0x34, 0x39, 0x7b, 0x41, 0x65, 0x92, 0x31, 0x90, 0x51, 0x56, 0x28, 0x81, 0x9f,
+	0xe2, 0xeb, 0x23, 0xe0, 0x0f, 0xd3, 0x28, 0xc9, 0x1e, 0x27, 0x2c, 0x8d, 0xad, 0x7b, 0xd8, 0x84,
+	0x84, 0xe7, 0xac, 0xb4, 0x51, 0x0f, 0xf5, 0xaf, 0x0e, 0xed, 0x8f, 0xef, 0x0e, 0xf7, 0xf4, 0xa6,
+	0x07, 0x71, 0x5c, 0x32, 0x80, 0xb1, 0x2c, 0x93, 0x9c, 0x87, 0x5a, 0x77, 0x72, 0xf7, 0xcd, 0x85,
+	0x67, 0x7c, 0xbb, 0xf0, 0x8c, 0xd7, 0x9b, 0x45, 0xa0, 0x87, 0x6f, 0x37, 0x8b, 0xa0, 0xa3, 0xd3,
+	0xff, 0x30, 0xf7, 0x0f, 0xf0, 0x7e, 0x6b, 0x5b, 0xc8, 0xa0, 0x10, 0x39, 0x30, 0xff, 0x33, 0xc2,
+	0x9d, 0x11, 0xf0, 0x31, 0x93, 0x8f, 0xa2, 0x33, 0x60, 0xf1, 0x58, 0x46, 0x92, 0xfd, 0xff, 0x5d,
+	0xac, 0x5b, 0xd8, 0x2c, 0x6a, 0x03, 0xfb, 0x52, 0x0f, 0xf5, 0xaf, 0x84, 0x1a, 0x59, 0xf7, 0xb1,
+	0xc9, 0x66, 0x45, 0x52, 0xce, 0xed, 0x9d, 0x1e, 0xea, 0x5f, 0x1b, 0x38, 0x44, 0x15, 0x43, 0x9a,
+	0x62, 0xc8, 0x69, 0x53, 0xcc, 0x70, 0xf7, 0xfc, 0x8b, 0x87, 0x42, 0xad, 0xaf, 0x1c, 0x4b, 0x16,
+	0x81, 0xc8, 0xed, 0xdd, 0xea, 0x0e, 0xa1, 0x46, 0x27, 0x87, 0x7f, 0x48, 0xbd, 0xaf, 0x53, 0xb7,
+	0xa3, 0xf8, 0xb7, 0x71, 0xf7, 0x97, 0x7c, 0x4d, 0xfa, 0xc1, 0x7b, 0x84, 0x77, 0x46, 0xc0, 0xad,
+	0x53, 0x8c, 0x7f, 0x7a, 0x09, 0x97, 0x6c, 0x7d, 0x2a, 0xa4, 0xd5, 0x9d, 0x73, 0xe7, 0xef, 0x7c,
+	0xe3, 0x6e, 0x3d, 0xc3, 0x37, 0xb6, 0x7a, 0xf5, 0x7f, 0x77, 0xb2, 0xad, 0x71, 0x82, 0x7f, 0x6b,
+	0x9a, 0x0d, 0xce, 0xe5, 0x57, 0x9b, 0x45, 0x80, 0x86, 0xc7, 0x1f, 0x56, 0x2e, 0x5a, 0xae, 0x5c,
+	0xf4, 0x75, 0xe5, 0xa2, 0xf3, 0xb5, 0x6b, 0x2c, 0xd7, 0xae, 0xf1, 0x69, 0xed, 0x1a, 0x4f, 0xba,
+	0xda, 0x45, 0x59, 0xce, 0xe6, 0x2f, 0xe9, 0x74, 0x40, 0xe5, 0xbc, 0x60, 0x30, 0x31, 0xeb, 0x17,
+	0x38, 0xfe, 0x3e, 0x00, 0x8c, 0x62, 0x09, 0xd6, 0x32, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -409,6 +421,23 @@ func (m *MsgSetPausedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -498,6 +527,14 @@ func (m *MsgSetPausedState) Size() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -729,6 +766,74 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -29,10 +29,54 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetYieldRecipient{}, "dollar/SetYieldRecipient", nil)
+	cdc.RegisterConcrete(&MsgSetYieldClaimRecipient{}, "dollar/SetYieldClaimRecipient", nil)
+	cdc.RegisterConcrete(&MsgSetYieldSplit{}, "dollar/SetYieldSplit", nil)
+	cdc.RegisterConcrete(&MsgSetAutoClaim{}, "dollar/SetAutoClaim", nil)
+	cdc.RegisterConcrete(&MsgClaimYieldFor{}, "dollar/ClaimYieldFor", nil)
+	cdc.RegisterConcrete(&MsgSetNonEarningAccount{}, "dollar/SetNonEarningAccount", nil)
+	cdc.RegisterConcrete(&MsgSetTreasury{}, "dollar/SetTreasury", nil)
+	cdc.RegisterConcrete(&MsgReconcileStats{}, "dollar/ReconcileStats", nil)
+	cdc.RegisterConcrete(&MsgSetClaimTip{}, "dollar/SetClaimTip", nil)
+	cdc.RegisterConcrete(&MsgSetPausedType{}, "dollar/SetPausedType", nil)
+	cdc.RegisterConcrete(&MsgSetFee{}, "dollar/SetFee", nil)
+	cdc.RegisterConcrete(&MsgSetFrozenAccount{}, "dollar/SetFrozenAccount", nil)
+	cdc.RegisterConcrete(&MsgClaimFrozenYield{}, "dollar/ClaimFrozenYield", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "dollar/GrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "dollar/RevokeRole", nil)
+	cdc.RegisterConcrete(&MsgSetTimelockDelay{}, "dollar/SetTimelockDelay", nil)
+	cdc.RegisterConcrete(&MsgCancelTimelockedMessage{}, "dollar/CancelTimelockedMessage", nil)
+	cdc.RegisterConcrete(&MsgSetIndex{}, "dollar/SetIndex", nil)
+	cdc.RegisterConcrete(&MsgSetMaxYieldRate{}, "dollar/SetMaxYieldRate", nil)
+	cdc.RegisterConcrete(&MsgApprovePendingIndex{}, "dollar/ApprovePendingIndex", nil)
+	cdc.RegisterConcrete(&MsgRejectPendingIndex{}, "dollar/RejectPendingIndex", nil)
+	cdc.RegisterConcrete(&MsgSetIndexStaleness{}, "dollar/SetIndexStaleness", nil)
+	cdc.RegisterConcrete(&MsgSetHaircutMode{}, "dollar/SetHaircutMode", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetYieldRecipient{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetYieldClaimRecipient{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetYieldSplit{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAutoClaim{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimYieldFor{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetNonEarningAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetTreasury{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgReconcileStats{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetClaimTip{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetPausedType{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetFee{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetFrozenAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimFrozenYield{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgGrantRole{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRevokeRole{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetTimelockDelay{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelTimelockedMessage{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetIndex{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMaxYieldRate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgApprovePendingIndex{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRejectPendingIndex{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetIndexStaleness{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetHaircutMode{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -7,18 +7,24 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -51,11 +57,101 @@ func (Provider) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e69c34fea15dc8ef, []int{0}
 }
 
+// PausedType is a bitmask of the Noble Dollar actions that can be paused
+// independently. Combinations of the values below are valid.
+type PausedType int32
+
+const (
+	// buf:lint:ignore ENUM_ZERO_VALUE_SUFFIX
+	PausedType_NONE PausedType = 0
+	// CLAIM pauses yield claims.
+	PausedType_CLAIM PausedType = 1
+	// TRANSFER pauses $USDN transfers between accounts.
+	PausedType_TRANSFER PausedType = 2
+	// INDEX pauses index updates.
+	PausedType_INDEX PausedType = 4
+	// EXTERNAL_YIELD pauses the distribution of yield to external chains.
+	PausedType_EXTERNAL_YIELD PausedType = 8
+	PausedType_ALL            PausedType = 15
+)
+
+var PausedType_name = map[int32]string{
+	0:  "NONE",
+	1:  "CLAIM",
+	2:  "TRANSFER",
+	4:  "INDEX",
+	8:  "EXTERNAL_YIELD",
+	15: "ALL",
+}
+
+var PausedType_value = map[string]int32{
+	"NONE":           0,
+	"CLAIM":          1,
+	"TRANSFER":       2,
+	"INDEX":          4,
+	"EXTERNAL_YIELD": 8,
+	"ALL":            15,
+}
+
+func (x PausedType) String() string {
+	return proto.EnumName(PausedType_name, int32(x))
+}
+
+func (PausedType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{1}
+}
+
+// Role is a permission that the authority can grant to accounts, allowing
+// them to perform a subset of the admin operations of the Noble Dollar.
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	// PAUSER can pause and unpause the Noble Dollar and its submodules.
+	Role_PAUSER Role = 1
+	// YIELD_RECIPIENT_MANAGER can set the yield recipients of external chains.
+	Role_YIELD_RECIPIENT_MANAGER Role = 2
+	// PORTAL_PEER_MANAGER can manage the peers and bridging paths of the Noble Dollar Portal.
+	Role_PORTAL_PEER_MANAGER Role = 4
+	// INDEX_OPERATOR can perform emergency operations on the index.
+	Role_INDEX_OPERATOR Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "PAUSER",
+	2: "YIELD_RECIPIENT_MANAGER",
+	4: "PORTAL_PEER_MANAGER",
+	5: "INDEX_OPERATOR",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":        0,
+	"PAUSER":                  1,
+	"YIELD_RECIPIENT_MANAGER": 2,
+	"PORTAL_PEER_MANAGER":     4,
+	"INDEX_OPERATOR":          5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{2}
+}
+
 // Stats is copied from the v1 definition to avoid cyclic imports.
 type Stats struct {
-	TotalHolders      uint64                `protobuf:"varint,1,opt,name=total_holders,json=totalHolders,proto3" json:"total_holders,omitempty"`
-	TotalPrincipal    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_principal,json=totalPrincipal,proto3,customtype=cosmossdk.io/math.Int" json:"total_principal"`
-	TotalYieldAccrued cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_yield_accrued,json=totalYieldAccrued,proto3,customtype=cosmossdk.io/math.Int" json:"total_yield_accrued"`
+	TotalHolders       uint64                `protobuf:"varint,1,opt,name=total_holders,json=totalHolders,proto3" json:"total_holders,omitempty"`
+	TotalPrincipal     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_principal,json=totalPrincipal,proto3,customtype=cosmossdk.io/math.Int" json:"total_principal"`
+	TotalYieldAccrued  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_yield_accrued,json=totalYieldAccrued,proto3,customtype=cosmossdk.io/math.Int" json:"total_yield_accrued"`
+	TotalFeesAccrued   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_fees_accrued,json=totalFeesAccrued,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_accrued"`
+	TotalHaircutBurned cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_haircut_burned,json=totalHaircutBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_haircut_burned"`
+	// total_principal_rounding is the amount by which the total principal
+	// exceeds the sum of the principal of all accounts, as the principal of
+	// issuances is rounded up for the stat, but down for the recipient.
+	TotalPrincipalRounding cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_principal_rounding,json=totalPrincipalRounding,proto3,customtype=cosmossdk.io/math.Int" json:"total_principal_rounding"`
 }
 
 func (m *Stats) Reset()         { *m = Stats{} }
@@ -98,120 +194,3829 @@ func (m *Stats) GetTotalHolders() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("noble.dollar.v2.Provider", Provider_name, Provider_value)
-	proto.RegisterType((*Stats)(nil), "noble.dollar.v2.Stats")
+// IndexRecord is a historical record of the Noble Dollar index, stored on every index update.
+type IndexRecord struct {
+	Index  int64     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// sequence orders multiple index updates within the same block.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/dollar/v2/dollar.proto", fileDescriptor_e69c34fea15dc8ef) }
+func (m *IndexRecord) Reset()         { *m = IndexRecord{} }
+func (m *IndexRecord) String() string { return proto.CompactTextString(m) }
+func (*IndexRecord) ProtoMessage()    {}
+func (*IndexRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{1}
+}
+func (m *IndexRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRecord.Merge(m, src)
+}
+func (m *IndexRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRecord.DiscardUnknown(m)
+}
 
-var fileDescriptor_e69c34fea15dc8ef = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xc9, 0xcf, 0xc9, 0x49, 0x2c, 0xd2, 0x2f, 0x33, 0x82, 0xb2, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0xc1, 0xb2, 0x7a, 0x50, 0xb1, 0x32, 0x23, 0x29, 0xc1, 0xc4, 0xdc,
-	0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x23, 0x25, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c,
-	0x0f, 0xe6, 0xe9, 0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05,
-	0x11, 0x55, 0x7a, 0xc9, 0xc8, 0xc5, 0x1a, 0x5c, 0x92, 0x58, 0x52, 0x2c, 0xa4, 0xcc, 0xc5, 0x5b,
-	0x92, 0x5f, 0x92, 0x98, 0x13, 0x9f, 0x91, 0x9f, 0x93, 0x92, 0x5a, 0x54, 0x2c, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x12, 0xc4, 0x03, 0x16, 0xf4, 0x80, 0x88, 0x09, 0x45, 0x72, 0xf1, 0x43, 0x14, 0x15,
-	0x14, 0x65, 0xe6, 0x25, 0x67, 0x16, 0x24, 0xe6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x3a, 0x19,
-	0x9c, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x28, 0xc4, 0xce, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0xcf, 0xbc, 0x92, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0x8e,
-	0xf1, 0xcc, 0x2b, 0x59, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x1f, 0xd8, 0xa0, 0x00, 0x98, 0x39,
-	0x42, 0x09, 0x5c, 0xc2, 0x10, 0xa3, 0x2b, 0x33, 0x53, 0x73, 0x52, 0xe2, 0x13, 0x93, 0x93, 0x8b,
-	0x4a, 0x53, 0x53, 0x24, 0x98, 0xc9, 0x34, 0x5e, 0x10, 0x6c, 0x58, 0x24, 0xc8, 0x2c, 0x47, 0x88,
-	0x51, 0x5a, 0x4a, 0x5c, 0x1c, 0x01, 0x45, 0xf9, 0x65, 0x99, 0x29, 0xa9, 0x45, 0x42, 0xec, 0x5c,
-	0xcc, 0x9e, 0x4e, 0xce, 0x02, 0x0c, 0x42, 0xbc, 0x5c, 0x9c, 0x1e, 0x91, 0x01, 0xae, 0x41, 0x3e,
-	0x8e, 0x7e, 0xae, 0x02, 0x8c, 0x4e, 0x66, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x25, 0x03, 0x0d, 0x78, 0x48, 0x2c, 0x54, 0x54, 0x56, 0x81, 0x22, 0xa8, 0xa4, 0xb2, 0x20,
-	0xb5, 0x58, 0xbf, 0xcc, 0x28, 0x89, 0x0d, 0x1c, 0x9c, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0xf8, 0x59, 0x2e, 0xc3, 0x01, 0x00, 0x00,
+var xxx_messageInfo_IndexRecord proto.InternalMessageInfo
+
+func (m *IndexRecord) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *Stats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IndexRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IndexRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalYieldAccrued.Size()
-		i -= size
-		if _, err := m.TotalYieldAccrued.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *IndexRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// YieldDistribution is a record of how the yield accrued by an index update was distributed, stored on every index update.
+type YieldDistribution struct {
+	Index  int64     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// total_yield is the yield minted to the yield account, net of fees.
+	TotalYield cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_yield,json=totalYield,proto3,customtype=cosmossdk.io/math.Int" json:"total_yield"`
+	// fees is the fee taken out of the accrued yield.
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	// treasury is the yield of non-earning accounts swept to the treasury.
+	Treasury cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=treasury,proto3,customtype=cosmossdk.io/math.Int" json:"treasury"`
+	// flexible_vault is the yield of both vaults registered as rewards of the Flexible vault during Season One.
+	FlexibleVault cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=flexible_vault,json=flexibleVault,proto3,customtype=cosmossdk.io/math.Int" json:"flexible_vault"`
+	// staked_vault_collector is the yield of the Staked vault sent to the collector during Season Two.
+	StakedVaultCollector cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=staked_vault_collector,json=stakedVaultCollector,proto3,customtype=cosmossdk.io/math.Int" json:"staked_vault_collector"`
+	// external_yield is the yield distributed to each external chain.
+	ExternalYield []ExternalYieldDistribution `protobuf:"bytes,9,rep,name=external_yield,json=externalYield,proto3" json:"external_yield"`
+	// user_pool is the remaining yield, claimable by holders.
+	UserPool cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=user_pool,json=userPool,proto3,customtype=cosmossdk.io/math.Int" json:"user_pool"`
+	// sequence orders multiple index updates within the same block.
+	Sequence uint64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *YieldDistribution) Reset()         { *m = YieldDistribution{} }
+func (m *YieldDistribution) String() string { return proto.CompactTextString(m) }
+func (*YieldDistribution) ProtoMessage()    {}
+func (*YieldDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{2}
+}
+func (m *YieldDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintDollar(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalPrincipal.Size()
-		i -= size
-		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *YieldDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldDistribution.Merge(m, src)
+}
+func (m *YieldDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldDistribution proto.InternalMessageInfo
+
+func (m *YieldDistribution) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *YieldDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *YieldDistribution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *YieldDistribution) GetExternalYield() []ExternalYieldDistribution {
+	if m != nil {
+		return m.ExternalYield
+	}
+	return nil
+}
+
+func (m *YieldDistribution) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// ExternalYieldDistribution is a record of the yield distributed to an external chain by an index update.
+type ExternalYieldDistribution struct {
+	Provider   Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=noble.dollar.v2.Provider" json:"provider,omitempty"`
+	Identifier string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// amount is the yield accrued by the external chain.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// retry_amount is the yield carried forward from previous index updates whose transfer failed.
+	RetryAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=retry_amount,json=retryAmount,proto3,customtype=cosmossdk.io/math.Int" json:"retry_amount"`
+	// carried_forward is the yield carried forward to the next index update, as its transfer failed.
+	CarriedForward cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=carried_forward,json=carriedForward,proto3,customtype=cosmossdk.io/math.Int" json:"carried_forward"`
+}
+
+func (m *ExternalYieldDistribution) Reset()         { *m = ExternalYieldDistribution{} }
+func (m *ExternalYieldDistribution) String() string { return proto.CompactTextString(m) }
+func (*ExternalYieldDistribution) ProtoMessage()    {}
+func (*ExternalYieldDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{3}
+}
+func (m *ExternalYieldDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalYieldDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalYieldDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalYieldDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalYieldDistribution.Merge(m, src)
+}
+func (m *ExternalYieldDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalYieldDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalYieldDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalYieldDistribution proto.InternalMessageInfo
+
+func (m *ExternalYieldDistribution) GetProvider() Provider {
+	if m != nil {
+		return m.Provider
+	}
+	return Provider_IBC
+}
+
+func (m *ExternalYieldDistribution) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// YieldShare is a portion of a holder's claimed yield that is paid out to a recipient.
+type YieldShare struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share is expressed in basis points of the claimed yield.
+	Share uint32 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *YieldShare) Reset()         { *m = YieldShare{} }
+func (m *YieldShare) String() string { return proto.CompactTextString(m) }
+func (*YieldShare) ProtoMessage()    {}
+func (*YieldShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{4}
+}
+func (m *YieldShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldShare.Merge(m, src)
+}
+func (m *YieldShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldShare proto.InternalMessageInfo
+
+func (m *YieldShare) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *YieldShare) GetShare() uint32 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+// YieldSplit is the list of shares that a holder's claimed yield is split across.
+type YieldSplit struct {
+	Shares []YieldShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares"`
+}
+
+func (m *YieldSplit) Reset()         { *m = YieldSplit{} }
+func (m *YieldSplit) String() string { return proto.CompactTextString(m) }
+func (*YieldSplit) ProtoMessage()    {}
+func (*YieldSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{5}
+}
+func (m *YieldSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldSplit.Merge(m, src)
+}
+func (m *YieldSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldSplit proto.InternalMessageInfo
+
+func (m *YieldSplit) GetShares() []YieldShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// InvariantResult is the result of checking one of the module's invariants.
+type InvariantResult struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Broken  bool   `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{6}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// PauseInfo contains the expiry and reason of an active pause.
+type PauseInfo struct {
+	// expiry is the time after which the pause is automatically lifted, indefinite if not set.
+	Expiry *time.Time `protobuf:"bytes,1,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// reason is a description of why the pause was set.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PauseInfo) Reset()         { *m = PauseInfo{} }
+func (m *PauseInfo) String() string { return proto.CompactTextString(m) }
+func (*PauseInfo) ProtoMessage()    {}
+func (*PauseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{7}
+}
+func (m *PauseInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseInfo.Merge(m, src)
+}
+func (m *PauseInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseInfo proto.InternalMessageInfo
+
+func (m *PauseInfo) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *PauseInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// PauseInfoEntry contains the expiry and reason of a single paused action of a module.
+type PauseInfoEntry struct {
+	// module is the name of the paused module, e.g. dollar, dollar/portal or dollar/vaults.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// action is the paused action of the module, as a single bit of its paused type.
+	Action int32     `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	Info   PauseInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+}
+
+func (m *PauseInfoEntry) Reset()         { *m = PauseInfoEntry{} }
+func (m *PauseInfoEntry) String() string { return proto.CompactTextString(m) }
+func (*PauseInfoEntry) ProtoMessage()    {}
+func (*PauseInfoEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{8}
+}
+func (m *PauseInfoEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseInfoEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseInfoEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseInfoEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseInfoEntry.Merge(m, src)
+}
+func (m *PauseInfoEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseInfoEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseInfoEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseInfoEntry proto.InternalMessageInfo
+
+func (m *PauseInfoEntry) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *PauseInfoEntry) GetAction() int32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *PauseInfoEntry) GetInfo() PauseInfo {
+	if m != nil {
+		return m.Info
+	}
+	return PauseInfo{}
+}
+
+// ActivePause describes an active pause of the Noble Dollar or one of its submodules.
+type ActivePause struct {
+	// module is the name of the paused module, e.g. dollar, dollar/portal or dollar/vaults.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// paused is a description of the paused action of the module.
+	Paused string    `protobuf:"bytes,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Info   PauseInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+}
+
+func (m *ActivePause) Reset()         { *m = ActivePause{} }
+func (m *ActivePause) String() string { return proto.CompactTextString(m) }
+func (*ActivePause) ProtoMessage()    {}
+func (*ActivePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{9}
+}
+func (m *ActivePause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivePause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivePause.Merge(m, src)
+}
+func (m *ActivePause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivePause proto.InternalMessageInfo
+
+func (m *ActivePause) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ActivePause) GetPaused() string {
+	if m != nil {
+		return m.Paused
+	}
+	return ""
+}
+
+func (m *ActivePause) GetInfo() PauseInfo {
+	if m != nil {
+		return m.Info
+	}
+	return PauseInfo{}
+}
+
+// RoleGrant is a role granted to an account.
+type RoleGrant struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=noble.dollar.v2.Role" json:"role,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{10}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// TimelockedMessage is an admin message that is queued for execution once the timelock delay has passed.
+type TimelockedMessage struct {
+	Id  uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Msg *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// execution_time is the time after which the message is automatically executed.
+	ExecutionTime time.Time `protobuf:"bytes,3,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *TimelockedMessage) Reset()         { *m = TimelockedMessage{} }
+func (m *TimelockedMessage) String() string { return proto.CompactTextString(m) }
+func (*TimelockedMessage) ProtoMessage()    {}
+func (*TimelockedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{11}
+}
+func (m *TimelockedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedMessage.Merge(m, src)
+}
+func (m *TimelockedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedMessage proto.InternalMessageInfo
+
+func (m *TimelockedMessage) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TimelockedMessage) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *TimelockedMessage) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
+}
+
+// PendingIndex is an index of $M that exceeded the maximum yield rate, and is quarantined until the authority resolves it.
+type PendingIndex struct {
+	MIndex int64 `protobuf:"varint,1,opt,name=m_index,json=mIndex,proto3" json:"m_index,omitempty"`
+	// received_time is the time at which the index was received.
+	ReceivedTime time.Time `protobuf:"bytes,2,opt,name=received_time,json=receivedTime,proto3,stdtime" json:"received_time"`
+}
+
+func (m *PendingIndex) Reset()         { *m = PendingIndex{} }
+func (m *PendingIndex) String() string { return proto.CompactTextString(m) }
+func (*PendingIndex) ProtoMessage()    {}
+func (*PendingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{12}
+}
+func (m *PendingIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIndex.Merge(m, src)
+}
+func (m *PendingIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIndex proto.InternalMessageInfo
+
+func (m *PendingIndex) GetMIndex() int64 {
+	if m != nil {
+		return m.MIndex
+	}
+	return 0
+}
+
+func (m *PendingIndex) GetReceivedTime() time.Time {
+	if m != nil {
+		return m.ReceivedTime
+	}
+	return time.Time{}
+}
+
+// IndexStaleness configures when the index is considered stale, and the behaviour applied while it is.
+type IndexStaleness struct {
+	// threshold is the time since the last index update after which the index is considered stale, disabled if zero.
+	Threshold time.Duration `protobuf:"bytes,1,opt,name=threshold,proto3,stdduration" json:"threshold"`
+	// block_vault_locks configures whether new vault locks are blocked while the index is stale.
+	BlockVaultLocks bool `protobuf:"varint,2,opt,name=block_vault_locks,json=blockVaultLocks,proto3" json:"block_vault_locks,omitempty"`
+}
+
+func (m *IndexStaleness) Reset()         { *m = IndexStaleness{} }
+func (m *IndexStaleness) String() string { return proto.CompactTextString(m) }
+func (*IndexStaleness) ProtoMessage()    {}
+func (*IndexStaleness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e69c34fea15dc8ef, []int{13}
+}
+func (m *IndexStaleness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexStaleness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexStaleness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexStaleness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexStaleness.Merge(m, src)
+}
+func (m *IndexStaleness) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexStaleness) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexStaleness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexStaleness proto.InternalMessageInfo
+
+func (m *IndexStaleness) GetThreshold() time.Duration {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *IndexStaleness) GetBlockVaultLocks() bool {
+	if m != nil {
+		return m.BlockVaultLocks
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("noble.dollar.v2.Provider", Provider_name, Provider_value)
+	proto.RegisterEnum("noble.dollar.v2.PausedType", PausedType_name, PausedType_value)
+	proto.RegisterEnum("noble.dollar.v2.Role", Role_name, Role_value)
+	proto.RegisterType((*Stats)(nil), "noble.dollar.v2.Stats")
+	proto.RegisterType((*IndexRecord)(nil), "noble.dollar.v2.IndexRecord")
+	proto.RegisterType((*YieldDistribution)(nil), "noble.dollar.v2.YieldDistribution")
+	proto.RegisterType((*ExternalYieldDistribution)(nil), "noble.dollar.v2.ExternalYieldDistribution")
+	proto.RegisterType((*YieldShare)(nil), "noble.dollar.v2.YieldShare")
+	proto.RegisterType((*YieldSplit)(nil), "noble.dollar.v2.YieldSplit")
+	proto.RegisterType((*InvariantResult)(nil), "noble.dollar.v2.InvariantResult")
+	proto.RegisterType((*PauseInfo)(nil), "noble.dollar.v2.PauseInfo")
+	proto.RegisterType((*PauseInfoEntry)(nil), "noble.dollar.v2.PauseInfoEntry")
+	proto.RegisterType((*ActivePause)(nil), "noble.dollar.v2.ActivePause")
+	proto.RegisterType((*RoleGrant)(nil), "noble.dollar.v2.RoleGrant")
+	proto.RegisterType((*TimelockedMessage)(nil), "noble.dollar.v2.TimelockedMessage")
+	proto.RegisterType((*PendingIndex)(nil), "noble.dollar.v2.PendingIndex")
+	proto.RegisterType((*IndexStaleness)(nil), "noble.dollar.v2.IndexStaleness")
+}
+
+func init() { proto.RegisterFile("noble/dollar/v2/dollar.proto", fileDescriptor_e69c34fea15dc8ef) }
+
+var fileDescriptor_e69c34fea15dc8ef = []byte{
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x73, 0x1a, 0x47,
+	0x16, 0xc7, 0x85, 0x40, 0x08, 0x1e, 0x12, 0x42, 0x6d, 0xd9, 0x42, 0xb2, 0x0b, 0xb9, 0xd8, 0x8b,
+	0x57, 0x55, 0x86, 0x35, 0xbb, 0xeb, 0xf2, 0x69, 0xab, 0x06, 0x69, 0x64, 0x51, 0x8b, 0x10, 0xdb,
+	0xe0, 0x1f, 0x72, 0xd5, 0x66, 0x32, 0xcc, 0x34, 0xa8, 0xad, 0x61, 0x9a, 0xf4, 0x34, 0x44, 0xe4,
+	0x92, 0x53, 0xee, 0xce, 0x2d, 0xf9, 0x0f, 0x72, 0xcc, 0xc1, 0xf9, 0x1f, 0x5c, 0x39, 0xb9, 0x7c,
+	0x4a, 0xe5, 0xe0, 0xa4, 0xec, 0x83, 0x2f, 0xf9, 0x23, 0x52, 0xfd, 0x03, 0x24, 0x4b, 0x95, 0xb8,
+	0xcc, 0x21, 0x17, 0x6a, 0x5e, 0xbf, 0xd7, 0x9f, 0xf7, 0xa6, 0xdf, 0x77, 0xde, 0x0c, 0x70, 0x23,
+	0x64, 0x9d, 0x80, 0x94, 0x7d, 0x16, 0x04, 0x2e, 0x2f, 0x8f, 0x2a, 0xe6, 0xaa, 0x34, 0xe0, 0x4c,
+	0x30, 0xb4, 0xa2, 0xbc, 0x25, 0xb3, 0x36, 0xaa, 0x6c, 0xae, 0xba, 0x7d, 0x1a, 0xb2, 0xb2, 0xfa,
+	0xd5, 0x31, 0x9b, 0x1b, 0x1e, 0x8b, 0xfa, 0x2c, 0x72, 0x94, 0x55, 0xd6, 0x86, 0x71, 0xad, 0xf5,
+	0x58, 0x8f, 0xe9, 0x75, 0x79, 0x35, 0xd9, 0xd0, 0x63, 0xac, 0x17, 0x90, 0xb2, 0xb2, 0x3a, 0xc3,
+	0x6e, 0xd9, 0x0d, 0xc7, 0xc6, 0x55, 0xb8, 0xe8, 0xf2, 0x87, 0xdc, 0x15, 0x94, 0x85, 0xc6, 0xbf,
+	0x75, 0xd1, 0x2f, 0x68, 0x9f, 0x44, 0xc2, 0xed, 0x0f, 0x74, 0x40, 0xf1, 0xdb, 0x04, 0x2c, 0xb4,
+	0x84, 0x2b, 0x22, 0xf4, 0x37, 0x58, 0x16, 0x4c, 0xb8, 0x81, 0x73, 0xcc, 0x02, 0x9f, 0xf0, 0x28,
+	0x1f, 0xbb, 0x19, 0xbb, 0x95, 0xc0, 0x4b, 0x6a, 0x71, 0x5f, 0xaf, 0xa1, 0x23, 0x58, 0xd1, 0x41,
+	0x03, 0x4e, 0x43, 0x8f, 0x0e, 0xdc, 0x20, 0x3f, 0x7f, 0x33, 0x76, 0x2b, 0x5d, 0xfd, 0xc7, 0x8b,
+	0xd7, 0x5b, 0x73, 0x3f, 0xbf, 0xde, 0xba, 0xaa, 0xef, 0x27, 0xf2, 0x4f, 0x4a, 0x94, 0x95, 0xfb,
+	0xae, 0x38, 0x2e, 0xd5, 0x42, 0xf1, 0xea, 0xf9, 0x6d, 0x30, 0x37, 0x5a, 0x0b, 0xc5, 0x77, 0xef,
+	0xbe, 0xdf, 0x8e, 0xe1, 0xac, 0x02, 0x35, 0x27, 0x1c, 0xf4, 0x29, 0x5c, 0xd1, 0xe8, 0x31, 0x25,
+	0x81, 0xef, 0xb8, 0x9e, 0xc7, 0x87, 0xc4, 0xcf, 0xc7, 0x67, 0xc4, 0xaf, 0x2a, 0xd8, 0x91, 0x64,
+	0x59, 0x1a, 0x85, 0x3e, 0x01, 0xa4, 0x33, 0x74, 0x09, 0x89, 0xa6, 0x09, 0x12, 0x33, 0x26, 0xc8,
+	0x29, 0xd6, 0x1e, 0x21, 0xd1, 0x84, 0xdf, 0x81, 0x35, 0x73, 0x82, 0x2e, 0xe5, 0xde, 0x50, 0x38,
+	0x9d, 0x21, 0x0f, 0x89, 0x9f, 0x5f, 0x98, 0x31, 0x83, 0xae, 0x76, 0x5f, 0xc3, 0xaa, 0x8a, 0x85,
+	0x9e, 0x42, 0xfe, 0x42, 0x03, 0x1c, 0xce, 0x86, 0xa1, 0x4f, 0xc3, 0x5e, 0x3e, 0x39, 0x63, 0x9e,
+	0x6b, 0xef, 0x77, 0x02, 0x1b, 0x5e, 0xf1, 0xeb, 0x18, 0x64, 0x6a, 0xa1, 0x4f, 0x4e, 0x31, 0xf1,
+	0x18, 0xf7, 0xd1, 0x1a, 0x2c, 0x50, 0x69, 0x2a, 0x65, 0xc4, 0xb1, 0x36, 0xd0, 0x35, 0x48, 0x1e,
+	0x13, 0xda, 0x3b, 0x16, 0x4a, 0x09, 0x71, 0x6c, 0x2c, 0x74, 0x0f, 0x12, 0x52, 0x6c, 0xaa, 0x81,
+	0x99, 0xca, 0x66, 0x49, 0x2b, 0xb1, 0x34, 0x51, 0x62, 0xa9, 0x3d, 0x51, 0x62, 0x35, 0x25, 0x2b,
+	0x7e, 0xf6, 0xcb, 0x56, 0x0c, 0xab, 0x1d, 0x68, 0x13, 0x52, 0x11, 0xf9, 0x6c, 0x48, 0x42, 0x8f,
+	0xa8, 0xee, 0x24, 0xf0, 0xd4, 0x2e, 0xbe, 0x5b, 0x80, 0x55, 0xd5, 0xd4, 0x5d, 0x1a, 0x09, 0x4e,
+	0x3b, 0x43, 0x29, 0xf6, 0xbf, 0xac, 0xb2, 0xff, 0x41, 0xe6, 0x9c, 0x46, 0x67, 0x96, 0x0e, 0x9c,
+	0x69, 0x13, 0xed, 0x42, 0x42, 0xca, 0x71, 0x66, 0x91, 0xa8, 0xdd, 0xa8, 0x0e, 0x29, 0xc1, 0x89,
+	0x1b, 0x0d, 0xf9, 0x78, 0x66, 0x19, 0x4c, 0x09, 0xe8, 0x11, 0x64, 0xbb, 0x01, 0x39, 0xa5, 0x9d,
+	0x80, 0x38, 0x23, 0x77, 0x18, 0x88, 0xfc, 0xe2, 0x8c, 0xcc, 0xe5, 0x09, 0xe7, 0xa1, 0xc4, 0xa0,
+	0x2e, 0x5c, 0x8b, 0x84, 0x7b, 0x42, 0x7c, 0x8d, 0x75, 0x3c, 0x16, 0x04, 0xc4, 0x13, 0x8c, 0xe7,
+	0x53, 0x33, 0x26, 0x58, 0xd3, 0x3c, 0x85, 0xdf, 0x99, 0xd0, 0xe4, 0x0d, 0x90, 0x53, 0x41, 0x78,
+	0x38, 0x6d, 0x55, 0xfa, 0x66, 0xfc, 0x56, 0xa6, 0xb2, 0x5d, 0xba, 0x30, 0x9f, 0x4b, 0xb6, 0x09,
+	0xbb, 0xa4, 0xa9, 0x6a, 0x42, 0xd6, 0x82, 0x97, 0xc9, 0xf9, 0x00, 0x74, 0x00, 0xe9, 0x61, 0x44,
+	0xb8, 0x33, 0x60, 0x2c, 0xc8, 0xc3, 0xac, 0x07, 0x2d, 0x11, 0x4d, 0xc6, 0x82, 0xf7, 0x94, 0x9e,
+	0xb9, 0xa0, 0xf4, 0xdf, 0xe6, 0x61, 0xe3, 0x0f, 0xab, 0x43, 0xff, 0x86, 0xd4, 0x80, 0xb3, 0x11,
+	0xf5, 0x09, 0x57, 0xa2, 0xcf, 0x56, 0x36, 0x2e, 0xdd, 0x5b, 0xd3, 0x04, 0xe0, 0x69, 0x28, 0x2a,
+	0x00, 0x50, 0x9f, 0x84, 0x82, 0x76, 0x29, 0xe1, 0x7a, 0x74, 0xe3, 0x73, 0x2b, 0x68, 0x1f, 0x92,
+	0x6e, 0x9f, 0x0d, 0x43, 0x31, 0xf3, 0xdc, 0x35, 0xfb, 0x51, 0x0b, 0x96, 0x38, 0x11, 0x7c, 0xec,
+	0x18, 0xde, 0xac, 0xcf, 0x4a, 0x46, 0x51, 0x2c, 0x0d, 0x3d, 0x82, 0x15, 0xcf, 0xe5, 0x9c, 0x12,
+	0xdf, 0xe9, 0x32, 0xfe, 0xb9, 0xcb, 0x67, 0x1f, 0xae, 0x59, 0x03, 0xda, 0xd3, 0x9c, 0xe2, 0x13,
+	0x00, 0x75, 0xca, 0xad, 0x63, 0x97, 0x13, 0x74, 0x17, 0xd2, 0x9c, 0x78, 0x74, 0x40, 0x49, 0x28,
+	0xd4, 0xf9, 0xa6, 0xab, 0xf9, 0x57, 0xcf, 0x6f, 0xaf, 0x19, 0x8a, 0xe5, 0xfb, 0x9c, 0x44, 0x51,
+	0x4b, 0x70, 0x1a, 0xf6, 0xf0, 0x59, 0xa8, 0x1c, 0x44, 0x91, 0x04, 0xa8, 0xa3, 0x5d, 0xc6, 0xda,
+	0x28, 0xd6, 0x27, 0xec, 0x41, 0x40, 0x05, 0xfa, 0x0f, 0x24, 0xd5, 0xb2, 0x7c, 0xc3, 0x4a, 0x51,
+	0x5e, 0xbf, 0xd4, 0xb8, 0xb3, 0x42, 0xaa, 0x69, 0x79, 0x63, 0xe6, 0x64, 0xf5, 0xae, 0xe2, 0x23,
+	0x58, 0xa9, 0x85, 0x23, 0x97, 0x53, 0x37, 0x14, 0x98, 0x44, 0xf2, 0xb9, 0x42, 0x90, 0x08, 0xdd,
+	0x3e, 0xd1, 0x95, 0x62, 0x75, 0x2d, 0xa7, 0x5f, 0x87, 0xb3, 0x13, 0x12, 0xaa, 0x5a, 0x52, 0xd8,
+	0x58, 0x28, 0x0f, 0x8b, 0x7d, 0x12, 0x45, 0x6e, 0x4f, 0x0f, 0xc0, 0x34, 0x9e, 0x98, 0xc5, 0xff,
+	0x43, 0xba, 0xe9, 0x0e, 0x23, 0x52, 0x0b, 0xbb, 0x0c, 0xdd, 0x83, 0x24, 0x39, 0x1d, 0x50, 0x3e,
+	0xce, 0xc7, 0x3e, 0x38, 0x26, 0x13, 0x6a, 0x44, 0x9a, 0x78, 0x99, 0x58, 0x0e, 0x12, 0x16, 0x1a,
+	0x7d, 0x19, 0xab, 0x38, 0x82, 0xec, 0x14, 0x6f, 0x87, 0x42, 0x47, 0xf6, 0x99, 0x3f, 0x0c, 0x26,
+	0x85, 0x1b, 0x4b, 0xae, 0xbb, 0x9e, 0xa0, 0x86, 0xb0, 0x80, 0x8d, 0x85, 0xfe, 0x05, 0x09, 0x1a,
+	0x76, 0xd9, 0x74, 0x70, 0x5f, 0x12, 0xfc, 0x04, 0x6f, 0x1e, 0x5e, 0x15, 0x5d, 0x8c, 0x20, 0x63,
+	0x79, 0x82, 0x8e, 0x88, 0x72, 0xff, 0x59, 0xd2, 0x81, 0x0c, 0xf0, 0x27, 0x65, 0x6b, 0x6b, 0xc6,
+	0xa4, 0x4f, 0x21, 0x8d, 0x59, 0x40, 0xee, 0x73, 0x37, 0x14, 0xa8, 0x02, 0x8b, 0xae, 0xe7, 0xa9,
+	0xc7, 0xe0, 0x43, 0x5a, 0x9a, 0x04, 0xa2, 0xbf, 0x43, 0x82, 0xb3, 0x40, 0x0b, 0x29, 0x5b, 0xb9,
+	0x7a, 0x29, 0xad, 0xa4, 0x63, 0x15, 0x52, 0xfc, 0x21, 0x06, 0xab, 0xb2, 0x19, 0x01, 0xf3, 0x4e,
+	0x88, 0x7f, 0xa0, 0xbb, 0x89, 0xb2, 0x30, 0x4f, 0x7d, 0xf3, 0x11, 0x37, 0x4f, 0x7d, 0xb4, 0x03,
+	0xf1, 0x7e, 0xd4, 0x53, 0xbc, 0x4c, 0x65, 0xed, 0x52, 0x37, 0xad, 0x70, 0x5c, 0xbd, 0xfe, 0xe3,
+	0xf3, 0xdb, 0xeb, 0xa6, 0xac, 0x8e, 0x1b, 0x91, 0xd2, 0xe8, 0x4e, 0x87, 0x08, 0xf7, 0x4e, 0xe9,
+	0x20, 0xea, 0x61, 0xb9, 0x1b, 0xfd, 0x57, 0x0e, 0x56, 0xe2, 0xa9, 0x19, 0xe4, 0x7c, 0xf4, 0x4b,
+	0x74, 0x79, 0xba, 0x57, 0x7a, 0x8b, 0x1c, 0x96, 0x9a, 0x44, 0x7d, 0x6a, 0xa8, 0xaf, 0x0c, 0xb4,
+	0x0e, 0x8b, 0x7d, 0xe7, 0xfc, 0x7b, 0x3c, 0xd9, 0xd7, 0x8e, 0x1a, 0x2c, 0x73, 0xe2, 0x11, 0x3a,
+	0x22, 0xbe, 0x4e, 0x3a, 0xff, 0x11, 0x49, 0x97, 0x26, 0x5b, 0x55, 0xce, 0x2f, 0x21, 0xab, 0x98,
+	0x2d, 0xe1, 0x06, 0x24, 0x24, 0x51, 0x84, 0x2c, 0x48, 0x8b, 0x63, 0x4e, 0x22, 0xf9, 0xd9, 0x6b,
+	0xb4, 0xbe, 0x71, 0x09, 0xbc, 0x6b, 0x3e, 0xab, 0x35, 0xf7, 0x1b, 0xc9, 0x3d, 0xdb, 0x85, 0xb6,
+	0x61, 0xb5, 0x23, 0x0f, 0xdf, 0xbc, 0xd5, 0xe4, 0x65, 0x64, 0x9e, 0xba, 0x15, 0xe5, 0x50, 0xaf,
+	0xa7, 0xba, 0x5c, 0xde, 0x2e, 0x42, 0x6a, 0x32, 0x97, 0xd1, 0x22, 0xc4, 0x6b, 0xd5, 0x9d, 0xdc,
+	0x1c, 0x5a, 0x86, 0xf4, 0xfe, 0x51, 0xd3, 0xc6, 0x75, 0xab, 0x61, 0xe7, 0x62, 0xdb, 0x8f, 0x00,
+	0x94, 0xaa, 0xfc, 0xf6, 0x78, 0x40, 0x50, 0x0a, 0x12, 0x8d, 0xc3, 0x86, 0x9d, 0x9b, 0x43, 0x69,
+	0x58, 0xd8, 0xa9, 0x5b, 0xb5, 0x83, 0x5c, 0x0c, 0x2d, 0x41, 0xaa, 0x8d, 0xad, 0x46, 0x6b, 0xcf,
+	0xc6, 0xb9, 0x79, 0xe9, 0xa8, 0x35, 0x76, 0xed, 0xc7, 0xb9, 0x04, 0x42, 0x90, 0xb5, 0x1f, 0xb7,
+	0x6d, 0xdc, 0xb0, 0xea, 0xce, 0x51, 0xcd, 0xae, 0xef, 0xe6, 0x52, 0x32, 0x8f, 0x55, 0xaf, 0xe7,
+	0x56, 0xb6, 0xbf, 0x8a, 0x41, 0x42, 0x0a, 0x07, 0xad, 0x41, 0x0e, 0x1f, 0xd6, 0x6d, 0xe7, 0x41,
+	0xa3, 0xd5, 0xb4, 0x77, 0x6a, 0x7b, 0x35, 0x7b, 0x37, 0x37, 0x87, 0x00, 0x92, 0x4d, 0xeb, 0x41,
+	0xcb, 0xc6, 0xb9, 0x18, 0xba, 0x0e, 0xeb, 0x6a, 0xbb, 0x83, 0xed, 0x9d, 0x5a, 0xb3, 0x66, 0x37,
+	0xda, 0xce, 0x81, 0xd5, 0xb0, 0xee, 0xab, 0x7c, 0xeb, 0x70, 0xa5, 0x79, 0x88, 0xdb, 0x56, 0xdd,
+	0x69, 0xda, 0x36, 0x9e, 0x3a, 0x54, 0x76, 0x55, 0x88, 0x73, 0xd8, 0xb4, 0xb1, 0xd5, 0x3e, 0xc4,
+	0xb9, 0x85, 0x62, 0x22, 0x15, 0xcf, 0xc5, 0xb7, 0x33, 0x0f, 0xad, 0x07, 0xf5, 0xb6, 0x63, 0xed,
+	0x1e, 0xd4, 0x1a, 0xd5, 0xbb, 0x2f, 0xde, 0x14, 0x62, 0x2f, 0xdf, 0x14, 0x62, 0xbf, 0xbe, 0x29,
+	0xc4, 0x9e, 0xbd, 0x2d, 0xcc, 0xbd, 0x7c, 0x5b, 0x98, 0xfb, 0xe9, 0x6d, 0x61, 0xee, 0xc9, 0x0d,
+	0xa3, 0x70, 0x2d, 0xf7, 0xd3, 0xf1, 0x17, 0xf2, 0x2f, 0x96, 0x18, 0x0f, 0x48, 0x54, 0x1e, 0x55,
+	0x3a, 0x49, 0xd5, 0x90, 0x7f, 0xfe, 0x3e, 0x00, 0xec, 0x71, 0x65, 0x83, 0x85, 0x0d, 0x00, 0x00,
+}
+
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPrincipalRounding.Size()
+		i -= size
+		if _, err := m.TotalPrincipalRounding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalHaircutBurned.Size()
+		i -= size
+		if _, err := m.TotalHaircutBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalFeesAccrued.Size()
+		i -= size
+		if _, err := m.TotalFeesAccrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalYieldAccrued.Size()
+		i -= size
+		if _, err := m.TotalYieldAccrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalPrincipal.Size()
+		i -= size
+		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TotalHolders != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.TotalHolders))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDollar(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.UserPool.Size()
+		i -= size
+		if _, err := m.UserPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.ExternalYield) > 0 {
+		for iNdEx := len(m.ExternalYield) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalYield[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDollar(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.StakedVaultCollector.Size()
+		i -= size
+		if _, err := m.StakedVaultCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FlexibleVault.Size()
+		i -= size
+		if _, err := m.FlexibleVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Treasury.Size()
+		i -= size
+		if _, err := m.Treasury.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalYield.Size()
+		i -= size
+		if _, err := m.TotalYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDollar(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalYieldDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalYieldDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalYieldDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CarriedForward.Size()
+		i -= size
+		if _, err := m.CarriedForward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RetryAmount.Size()
+		i -= size
+		if _, err := m.RetryAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Provider != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Provider))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Share != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Share))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDollar(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expiry != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintDollar(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseInfoEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseInfoEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseInfoEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivePause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivePause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivePause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDollar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Paused) > 0 {
+		i -= len(m.Paused)
+		copy(dAtA[i:], m.Paused)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Paused)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDollar(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDollar(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDollar(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintDollar(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.MIndex != 0 {
+		i = encodeVarintDollar(dAtA, i, uint64(m.MIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexStaleness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexStaleness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexStaleness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockVaultLocks {
+		i--
+		if m.BlockVaultLocks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Threshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintDollar(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDollar(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDollar(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalHolders != 0 {
+		n += 1 + sovDollar(uint64(m.TotalHolders))
+	}
+	l = m.TotalPrincipal.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.TotalYieldAccrued.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.TotalFeesAccrued.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.TotalHaircutBurned.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.TotalPrincipalRounding.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *IndexRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovDollar(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDollar(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDollar(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovDollar(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *YieldDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovDollar(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDollar(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.TotalYield.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.Treasury.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.FlexibleVault.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.StakedVaultCollector.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	if len(m.ExternalYield) > 0 {
+		for _, e := range m.ExternalYield {
+			l = e.Size()
+			n += 1 + l + sovDollar(uint64(l))
+		}
+	}
+	l = m.UserPool.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovDollar(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ExternalYieldDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provider != 0 {
+		n += 1 + sovDollar(uint64(m.Provider))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.RetryAmount.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	l = m.CarriedForward.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *YieldShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	if m.Share != 0 {
+		n += 1 + sovDollar(uint64(m.Share))
+	}
+	return n
+}
+
+func (m *YieldSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovDollar(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseInfoEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovDollar(uint64(m.Action))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *ActivePause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	l = len(m.Paused)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovDollar(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *TimelockedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDollar(uint64(m.Id))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovDollar(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *PendingIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MIndex != 0 {
+		n += 1 + sovDollar(uint64(m.MIndex))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime)
+	n += 1 + l + sovDollar(uint64(l))
+	return n
+}
+
+func (m *IndexStaleness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold)
+	n += 1 + l + sovDollar(uint64(l))
+	if m.BlockVaultLocks {
+		n += 2
+	}
+	return n
+}
+
+func sovDollar(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDollar(x uint64) (n int) {
+	return sovDollar(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolders", wireType)
+			}
+			m.TotalHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHolders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYieldAccrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalYieldAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesAccrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHaircutBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHaircutBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipalRounding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrincipalRounding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Treasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlexibleVault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlexibleVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedVaultCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedVaultCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalYield", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalYield = append(m.ExternalYield, ExternalYieldDistribution{})
+			if err := m.ExternalYield[len(m.ExternalYield)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalYieldDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalYieldDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalYieldDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			m.Provider = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Provider |= Provider(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedForward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarriedForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			m.Share = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Share |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, YieldShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseInfoEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseInfoEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseInfoEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivePause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivePause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivePause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paused = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintDollar(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.TotalHolders != 0 {
-		i = encodeVarintDollar(dAtA, i, uint64(m.TotalHolders))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintDollar(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDollar(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Stats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalHolders != 0 {
-		n += 1 + sovDollar(uint64(m.TotalHolders))
+func (m *TimelockedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDollar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.TotalPrincipal.Size()
-	n += 1 + l + sovDollar(uint64(l))
-	l = m.TotalYieldAccrued.Size()
-	n += 1 + l + sovDollar(uint64(l))
-	return n
-}
 
-func sovDollar(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDollar(x uint64) (n int) {
-	return sovDollar(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Stats) Unmarshal(dAtA []byte) error {
+func (m *PendingIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -234,17 +4039,17 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MIndex", wireType)
 			}
-			m.TotalHolders = 0
+			m.MIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDollar
@@ -254,16 +4059,16 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalHolders |= uint64(b&0x7F) << shift
+				m.MIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDollar
@@ -273,31 +4078,80 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDollar
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDollar
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDollar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDollar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexStaleness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDollar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexStaleness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexStaleness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalYieldAccrued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDollar
@@ -307,26 +4161,45 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDollar
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDollar
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalYieldAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Threshold, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVaultLocks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDollar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockVaultLocks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDollar(dAtA[iNdEx:])
@@ -20,12 +20,81 @@
 
 package v2
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxClaimTip is the maximum tip, in basis points of the claimed yield, that
+// can be paid to signers claiming yield on behalf of holders.
+const MaxClaimTip = 100
+
+// MaxFee is the maximum fee, in basis points of newly accrued yield, that can
+// be taken by the fee collector.
+const MaxFee = 10_000
+
+// MaxIndexChange is the maximum change, in basis points of the current $M
+// index, that can be made when setting the index in an emergency.
+const MaxIndexChange = 100
+
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Index: 1e12,
+		Index:         1e12,
+		EarnerRate:    math.LegacyZeroDec(),
+		MaxYieldRate:  math.LegacyZeroDec(),
+		HaircutFactor: math.LegacyOneDec(),
 	}
 }
 
 func (genesis *GenesisState) Validate() error {
+	if genesis.ClaimTip > MaxClaimTip {
+		return fmt.Errorf("claim tip %d exceeds maximum of %d", genesis.ClaimTip, MaxClaimTip)
+	}
+
+	if genesis.Fee > MaxFee {
+		return fmt.Errorf("fee %d exceeds maximum of %d", genesis.Fee, MaxFee)
+	}
+	if genesis.Fee > 0 && genesis.FeeCollector == "" {
+		return fmt.Errorf("fee collector is required for a fee of %d", genesis.Fee)
+	}
+
+	if genesis.TimelockDelay < 0 {
+		return fmt.Errorf("timelock delay %s must not be negative", genesis.TimelockDelay)
+	}
+	for _, message := range genesis.TimelockedMessages {
+		if message.Id >= genesis.TimelockNonce {
+			return fmt.Errorf("timelocked message %d must be lower than the nonce %d", message.Id, genesis.TimelockNonce)
+		}
+	}
+
+	if !genesis.MaxYieldRate.IsNil() && genesis.MaxYieldRate.IsNegative() {
+		return fmt.Errorf("maximum yield rate %s must not be negative", genesis.MaxYieldRate)
+	}
+	if genesis.AttestedMIndex < 0 {
+		return fmt.Errorf("attested m index %d must not be negative", genesis.AttestedMIndex)
+	}
+	if genesis.PendingIndex != nil && genesis.PendingIndex.MIndex <= 0 {
+		return fmt.Errorf("pending index %d must be positive", genesis.PendingIndex.MIndex)
+	}
+	if !genesis.HaircutFactor.IsNil() && (!genesis.HaircutFactor.IsPositive() || genesis.HaircutFactor.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("haircut factor %s must be positive and at most one", genesis.HaircutFactor)
+	}
+	if genesis.IndexStaleness.Threshold < 0 {
+		return fmt.Errorf("index staleness threshold %s must not be negative", genesis.IndexStaleness.Threshold)
+	}
+
+	for _, grant := range genesis.Roles {
+		if _, found := Role_name[int32(grant.Role)]; !found || grant.Role == Role_ROLE_UNSPECIFIED {
+			return fmt.Errorf("invalid role %d for %s", grant.Role, grant.Account)
+		}
+	}
+
+	for account, split := range genesis.YieldSplits {
+		if err := ValidateYieldShares(split.Shares); err != nil {
+			return fmt.Errorf("invalid yield split for %s: %w", account, err)
+		}
+	}
+
 	return nil
 }
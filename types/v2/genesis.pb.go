@@ -4,20 +4,27 @@
 package v2
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	portal "dollar.noble.xyz/v2/types/portal"
 	vaults "dollar.noble.xyz/v2/types/vaults"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +39,7 @@ type GenesisState struct {
 	// vaults contains the genesis state of the Noble Dollar Vaults submodule.
 	Vaults vaults.GenesisState `protobuf:"bytes,2,opt,name=vaults,proto3" json:"vaults"`
 	// paused contains the genesis paused state of the Noble Dollar.
+	// Deprecated: Use paused_type instead. If true, yield claims are paused.
 	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 	// index contains the genesis index of the Noble Dollar, used for rebasing.
 	Index int64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
@@ -45,6 +53,64 @@ type GenesisState struct {
 	YieldRecipients map[string]string `protobuf:"bytes,8,rep,name=yield_recipients,json=yieldRecipients,proto3" json:"yield_recipients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// retry_amounts contains the genesis retry amounts of yield for external chains.
 	RetryAmounts map[string]string `protobuf:"bytes,9,rep,name=retry_amounts,json=retryAmounts,proto3" json:"retry_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// index_history contains the genesis historical records of the index.
+	IndexHistory []IndexRecord `protobuf:"bytes,10,rep,name=index_history,json=indexHistory,proto3" json:"index_history"`
+	// earner_rate contains the genesis earner rate, used for extrapolating the index.
+	EarnerRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=earner_rate,json=earnerRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"earner_rate"`
+	// yield_claim_recipients contains the genesis recipients of claimed yield for holders.
+	YieldClaimRecipients map[string]string `protobuf:"bytes,12,rep,name=yield_claim_recipients,json=yieldClaimRecipients,proto3" json:"yield_claim_recipients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// claim_tip contains the genesis tip paid to signers claiming yield on behalf of holders.
+	ClaimTip uint32 `protobuf:"varint,13,opt,name=claim_tip,json=claimTip,proto3" json:"claim_tip,omitempty"`
+	// yield_splits contains the genesis splits of holders' claimed yield.
+	YieldSplits map[string]YieldSplit `protobuf:"bytes,14,rep,name=yield_splits,json=yieldSplits,proto3" json:"yield_splits" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// auto_claim_accounts contains the genesis accounts whose yield is claimed automatically.
+	AutoClaimAccounts []string `protobuf:"bytes,15,rep,name=auto_claim_accounts,json=autoClaimAccounts,proto3" json:"auto_claim_accounts,omitempty"`
+	// auto_claim_in_progress indicates whether a pass over the auto claim accounts is in progress.
+	AutoClaimInProgress bool `protobuf:"varint,16,opt,name=auto_claim_in_progress,json=autoClaimInProgress,proto3" json:"auto_claim_in_progress,omitempty"`
+	// auto_claim_cursor contains the last account processed in the current auto claim pass.
+	AutoClaimCursor string `protobuf:"bytes,17,opt,name=auto_claim_cursor,json=autoClaimCursor,proto3" json:"auto_claim_cursor,omitempty"`
+	// non_earning_accounts contains the genesis accounts whose yield is swept to the treasury.
+	NonEarningAccounts []string `protobuf:"bytes,18,rep,name=non_earning_accounts,json=nonEarningAccounts,proto3" json:"non_earning_accounts,omitempty"`
+	// treasury contains the genesis treasury that the yield of non-earning accounts is swept to.
+	Treasury string `protobuf:"bytes,19,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// m_index contains the genesis index of the underlying $M, before fees.
+	MIndex int64 `protobuf:"varint,20,opt,name=m_index,json=mIndex,proto3" json:"m_index,omitempty"`
+	// fee contains the genesis fee rate in basis points, taken from newly accrued yield.
+	Fee uint32 `protobuf:"varint,21,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_collector contains the genesis collector of fees.
+	FeeCollector string `protobuf:"bytes,22,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// frozen_accounts contains the genesis accounts that are frozen.
+	FrozenAccounts []string `protobuf:"bytes,23,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	// paused_type contains the genesis bitmask of paused actions of the Noble Dollar.
+	PausedType PausedType `protobuf:"varint,24,opt,name=paused_type,json=pausedType,proto3,enum=noble.dollar.v2.PausedType" json:"paused_type,omitempty"`
+	// pause_infos contains the genesis expiry and reason of paused actions.
+	PauseInfos []PauseInfoEntry `protobuf:"bytes,25,rep,name=pause_infos,json=pauseInfos,proto3" json:"pause_infos"`
+	// roles contains the genesis roles granted to accounts.
+	Roles []RoleGrant `protobuf:"bytes,26,rep,name=roles,proto3" json:"roles"`
+	// timelock_delay contains the genesis delay after which timelocked admin messages are executed.
+	TimelockDelay time.Duration `protobuf:"bytes,27,opt,name=timelock_delay,json=timelockDelay,proto3,stdduration" json:"timelock_delay"`
+	// timelocked_messages contains the genesis pending timelocked admin messages.
+	TimelockedMessages []TimelockedMessage `protobuf:"bytes,28,rep,name=timelocked_messages,json=timelockedMessages,proto3" json:"timelocked_messages"`
+	// timelock_nonce contains the genesis identifier of the next timelocked admin message.
+	TimelockNonce uint64 `protobuf:"varint,29,opt,name=timelock_nonce,json=timelockNonce,proto3" json:"timelock_nonce,omitempty"`
+	// max_yield_rate contains the genesis maximum annual yield rate implied by index updates.
+	MaxYieldRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,30,opt,name=max_yield_rate,json=maxYieldRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_yield_rate"`
+	// pending_index contains the genesis quarantined index of $M, if any.
+	PendingIndex *PendingIndex `protobuf:"bytes,31,opt,name=pending_index,json=pendingIndex,proto3" json:"pending_index,omitempty"`
+	// index_staleness contains the genesis configuration of when the index is considered stale.
+	IndexStaleness IndexStaleness `protobuf:"bytes,32,opt,name=index_staleness,json=indexStaleness,proto3" json:"index_staleness"`
+	// index_stale contains the genesis flag of whether the index has been reported as stale.
+	IndexStale bool `protobuf:"varint,33,opt,name=index_stale,json=indexStale,proto3" json:"index_stale,omitempty"`
+	// haircut_mode contains the genesis flag of whether decreasing index updates are accepted.
+	HaircutMode bool `protobuf:"varint,34,opt,name=haircut_mode,json=haircutMode,proto3" json:"haircut_mode,omitempty"`
+	// haircut_factor contains the genesis fraction of $USDN balances that is backed by $M.
+	HaircutFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,35,opt,name=haircut_factor,json=haircutFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"haircut_factor"`
+	// yield_distributions contains the genesis records of how yield was distributed by index updates.
+	YieldDistributions []YieldDistribution `protobuf:"bytes,36,rep,name=yield_distributions,json=yieldDistributions,proto3" json:"yield_distributions"`
+	// attested_m_index contains the genesis last index of $M attested by the portal.
+	AttestedMIndex int64 `protobuf:"varint,37,opt,name=attested_m_index,json=attestedMIndex,proto3" json:"attested_m_index,omitempty"`
+	// index_history_start contains the genesis time (unix seconds) the index history started being recorded, if it was started by an upgrade.
+	IndexHistoryStart int64 `protobuf:"varint,38,opt,name=index_history_start,json=indexHistoryStart,proto3" json:"index_history_start,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -143,49 +209,281 @@ func (m *GenesisState) GetRetryAmounts() map[string]string {
 	return nil
 }
 
+func (m *GenesisState) GetIndexHistory() []IndexRecord {
+	if m != nil {
+		return m.IndexHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetYieldClaimRecipients() map[string]string {
+	if m != nil {
+		return m.YieldClaimRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimTip() uint32 {
+	if m != nil {
+		return m.ClaimTip
+	}
+	return 0
+}
+
+func (m *GenesisState) GetYieldSplits() map[string]YieldSplit {
+	if m != nil {
+		return m.YieldSplits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoClaimAccounts() []string {
+	if m != nil {
+		return m.AutoClaimAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoClaimInProgress() bool {
+	if m != nil {
+		return m.AutoClaimInProgress
+	}
+	return false
+}
+
+func (m *GenesisState) GetAutoClaimCursor() string {
+	if m != nil {
+		return m.AutoClaimCursor
+	}
+	return ""
+}
+
+func (m *GenesisState) GetNonEarningAccounts() []string {
+	if m != nil {
+		return m.NonEarningAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *GenesisState) GetMIndex() int64 {
+	if m != nil {
+		return m.MIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFee() uint32 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *GenesisState) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedType() PausedType {
+	if m != nil {
+		return m.PausedType
+	}
+	return PausedType_NONE
+}
+
+func (m *GenesisState) GetPauseInfos() []PauseInfoEntry {
+	if m != nil {
+		return m.PauseInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() []RoleGrant {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockDelay() time.Duration {
+	if m != nil {
+		return m.TimelockDelay
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTimelockedMessages() []TimelockedMessage {
+	if m != nil {
+		return m.TimelockedMessages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockNonce() uint64 {
+	if m != nil {
+		return m.TimelockNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingIndex() *PendingIndex {
+	if m != nil {
+		return m.PendingIndex
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIndexStaleness() IndexStaleness {
+	if m != nil {
+		return m.IndexStaleness
+	}
+	return IndexStaleness{}
+}
+
+func (m *GenesisState) GetIndexStale() bool {
+	if m != nil {
+		return m.IndexStale
+	}
+	return false
+}
+
+func (m *GenesisState) GetHaircutMode() bool {
+	if m != nil {
+		return m.HaircutMode
+	}
+	return false
+}
+
+func (m *GenesisState) GetYieldDistributions() []YieldDistribution {
+	if m != nil {
+		return m.YieldDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestedMIndex() int64 {
+	if m != nil {
+		return m.AttestedMIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIndexHistoryStart() int64 {
+	if m != nil {
+		return m.IndexHistoryStart
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.dollar.v2.GenesisState")
 	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.GenesisState.PrincipalEntry")
 	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.GenesisState.RetryAmountsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.GenesisState.TotalExternalYieldEntry")
+	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.GenesisState.YieldClaimRecipientsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.GenesisState.YieldRecipientsEntry")
+	proto.RegisterMapType((map[string]YieldSplit)(nil), "noble.dollar.v2.GenesisState.YieldSplitsEntry")
 }
 
 func init() { proto.RegisterFile("noble/dollar/v2/genesis.proto", fileDescriptor_ac7f26b2af2d42f8) }
 
 var fileDescriptor_ac7f26b2af2d42f8 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x4d, 0xb3, 0x36, 0xd3, 0x6a, 0xeb, 0x10, 0xea, 0xb2, 0xd4, 0x35, 0x48, 0x0f,
-	0x39, 0xc8, 0x2e, 0x1d, 0x51, 0x44, 0x04, 0x31, 0x10, 0x04, 0x4f, 0x32, 0xf6, 0xa2, 0x20, 0x61,
-	0xda, 0x0c, 0x61, 0x71, 0xdc, 0x59, 0x66, 0x26, 0x4b, 0xd6, 0x4f, 0xe1, 0xc7, 0xea, 0xb1, 0x47,
-	0x4f, 0x22, 0xc9, 0xd5, 0x0f, 0x21, 0x33, 0x6f, 0xd5, 0x6e, 0x37, 0x09, 0xe4, 0x36, 0x33, 0xff,
-	0xf7, 0xff, 0xfd, 0xdf, 0xbe, 0x9d, 0x41, 0x0f, 0x33, 0x79, 0x21, 0x78, 0x32, 0x91, 0x42, 0x30,
-	0x95, 0x14, 0x24, 0x99, 0xf2, 0x8c, 0xeb, 0x54, 0xc7, 0xb9, 0x92, 0x46, 0xe2, 0x43, 0x27, 0xc7,
-	0x20, 0xc7, 0x05, 0x09, 0x7b, 0x53, 0x39, 0x95, 0x4e, 0x4b, 0xec, 0x0a, 0xca, 0xc2, 0xd3, 0x1a,
-	0x25, 0x97, 0xca, 0x30, 0x91, 0x14, 0x67, 0x75, 0x58, 0x78, 0x72, 0x3b, 0xab, 0xc2, 0xae, 0x62,
-	0x14, 0x6c, 0x26, 0x8c, 0x6e, 0x30, 0x1e, 0xff, 0xf6, 0xd1, 0xc1, 0x5b, 0x38, 0xf9, 0x60, 0x98,
-	0xe1, 0x78, 0x88, 0x7c, 0xc8, 0x0b, 0xbc, 0xbe, 0x37, 0xd8, 0x27, 0xa7, 0x71, 0xad, 0x65, 0xd0,
-	0xe2, 0xe2, 0x2c, 0xbe, 0xe9, 0x1a, 0xee, 0x5e, 0xfd, 0x7c, 0xd4, 0xa2, 0x95, 0xd3, 0x32, 0x20,
-	0x2f, 0xd8, 0x59, 0xc5, 0x00, 0x6d, 0x1d, 0x03, 0x54, 0x7c, 0x8c, 0xfc, 0x9c, 0xcd, 0x34, 0x9f,
-	0x04, 0xed, 0xbe, 0x37, 0xd8, 0xa3, 0xd5, 0x0e, 0xf7, 0x50, 0x27, 0xcd, 0x26, 0x7c, 0x1e, 0xec,
-	0xf6, 0xbd, 0x41, 0x9b, 0xc2, 0x06, 0xbf, 0x43, 0xdd, 0x5c, 0xa5, 0xd9, 0x65, 0x9a, 0x33, 0x11,
-	0x74, 0xfa, 0xed, 0xc1, 0x3e, 0x79, 0x72, 0x2b, 0x94, 0xd4, 0xd2, 0xe2, 0xf7, 0x7f, 0xcb, 0x47,
-	0x99, 0x51, 0x25, 0xfd, 0x6f, 0xc7, 0x04, 0x75, 0xb4, 0x61, 0x46, 0x07, 0xbe, 0x6b, 0xfe, 0xb8,
-	0xc1, 0xb1, 0x00, 0x5d, 0xb5, 0x0b, 0xa5, 0x78, 0x8a, 0x7a, 0x46, 0x1a, 0x26, 0xc6, 0x7c, 0x6e,
-	0xb8, 0xca, 0x98, 0x18, 0x97, 0x29, 0x17, 0x93, 0xe0, 0x8e, 0x6b, 0xe5, 0xd9, 0xe6, 0x56, 0xce,
-	0xad, 0x73, 0x54, 0x19, 0x3f, 0x5a, 0x1f, 0xf4, 0x84, 0x4d, 0x43, 0xc0, 0x9f, 0xd1, 0x91, 0x23,
-	0x8f, 0x15, 0xbf, 0x4c, 0xf3, 0x94, 0x67, 0x46, 0x07, 0x7b, 0x2e, 0x84, 0x6c, 0x0e, 0x71, 0x76,
-	0xfa, 0xcf, 0x04, 0x09, 0x87, 0x65, 0xfd, 0x14, 0x9f, 0xa3, 0xbb, 0x8a, 0x1b, 0x55, 0x8e, 0xd9,
-	0x57, 0x39, 0xb3, 0xec, 0xae, 0x63, 0x27, 0x9b, 0xd9, 0xd4, 0x5a, 0xde, 0x80, 0x03, 0xc0, 0x07,
-	0xea, 0xc6, 0x51, 0xf8, 0x0a, 0xdd, 0xab, 0x8f, 0x1b, 0x1f, 0xa1, 0xf6, 0x17, 0x5e, 0xba, 0x2b,
-	0xd6, 0xa5, 0x76, 0x69, 0xff, 0x6b, 0xc1, 0xc4, 0x8c, 0xbb, 0x2b, 0xd3, 0xa5, 0xb0, 0x79, 0xb9,
-	0xf3, 0xc2, 0x0b, 0x47, 0xe8, 0xc1, 0x9a, 0x09, 0x6d, 0x85, 0x19, 0xa2, 0xde, 0xaa, 0x19, 0x6c,
-	0xc5, 0x78, 0x8d, 0xee, 0x37, 0xbe, 0x75, 0x1b, 0xc0, 0xf0, 0xf9, 0xd5, 0x22, 0xf2, 0xae, 0x17,
-	0x91, 0xf7, 0x6b, 0x11, 0x79, 0xdf, 0x97, 0x51, 0xeb, 0x7a, 0x19, 0xb5, 0x7e, 0x2c, 0xa3, 0xd6,
-	0xa7, 0x93, 0x6a, 0xb6, 0x30, 0xe8, 0x79, 0xf9, 0xcd, 0x3e, 0x68, 0x53, 0xe6, 0x5c, 0x27, 0x05,
-	0xb9, 0xf0, 0xdd, 0x6b, 0x7d, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xc2, 0xce, 0x42, 0x5f,
-	0x04, 0x00, 0x00,
+	// 1254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x13, 0x47,
+	0x14, 0xce, 0x12, 0x12, 0xe2, 0xf1, 0x5f, 0x3c, 0x31, 0x61, 0xe2, 0x04, 0xdb, 0xfc, 0xb5, 0x16,
+	0x6a, 0xd7, 0xc5, 0xa8, 0xb4, 0xaa, 0x90, 0x2a, 0x4c, 0x42, 0x0a, 0x2a, 0x08, 0x6d, 0x22, 0x21,
+	0xda, 0xa2, 0xd5, 0x64, 0x77, 0x6c, 0x56, 0xec, 0xce, 0xac, 0x66, 0xc6, 0x56, 0x96, 0xa7, 0xe8,
+	0x65, 0x1f, 0xa1, 0x97, 0xbd, 0xe8, 0x3b, 0x94, 0x4b, 0xd4, 0xab, 0xaa, 0x17, 0xb4, 0x82, 0x8b,
+	0xbe, 0x46, 0x35, 0x33, 0x6b, 0x7b, 0xfd, 0x13, 0x44, 0xc4, 0x8d, 0xb5, 0x73, 0xce, 0xf9, 0xbe,
+	0xf9, 0x7c, 0xce, 0xcc, 0x39, 0x03, 0x2e, 0x52, 0x76, 0x14, 0x92, 0xb6, 0xcf, 0xc2, 0x10, 0xf3,
+	0xf6, 0xb0, 0xd3, 0xee, 0x13, 0x4a, 0x44, 0x20, 0xec, 0x98, 0x33, 0xc9, 0x60, 0x59, 0xbb, 0x6d,
+	0xe3, 0xb6, 0x87, 0x9d, 0x5a, 0x05, 0x47, 0x01, 0x65, 0x6d, 0xfd, 0x6b, 0x62, 0x6a, 0x5b, 0x1e,
+	0x13, 0x11, 0x13, 0xae, 0x5e, 0xb5, 0xcd, 0x22, 0x75, 0x55, 0xfb, 0xac, 0xcf, 0x8c, 0x5d, 0x7d,
+	0xa5, 0xd6, 0x7a, 0x9f, 0xb1, 0x7e, 0x48, 0xda, 0x7a, 0x75, 0x34, 0xe8, 0xb5, 0xfd, 0x01, 0xc7,
+	0x32, 0x60, 0x34, 0xf5, 0x5f, 0x9d, 0xd2, 0x14, 0x33, 0x2e, 0x71, 0xd8, 0x1e, 0xde, 0x98, 0x96,
+	0x56, 0xdb, 0x99, 0x55, 0x9e, 0x8a, 0x5c, 0xc4, 0x31, 0xc4, 0x83, 0x50, 0x8a, 0x39, 0x8e, 0xcb,
+	0x7f, 0x9c, 0x07, 0x85, 0x7d, 0x63, 0x39, 0x90, 0x58, 0x12, 0xd8, 0x05, 0xab, 0x66, 0x3f, 0x64,
+	0x35, 0xad, 0x56, 0xbe, 0x73, 0xd5, 0x9e, 0x4a, 0x80, 0xf1, 0xd9, 0xc3, 0x1b, 0x76, 0x16, 0xd5,
+	0x3d, 0xfb, 0xea, 0x4d, 0x63, 0xc9, 0x49, 0x91, 0x8a, 0xc3, 0xec, 0x87, 0xce, 0x2c, 0xe2, 0x30,
+	0xbe, 0x93, 0x38, 0x8c, 0x17, 0x6e, 0x82, 0xd5, 0x18, 0x0f, 0x04, 0xf1, 0xd1, 0x72, 0xd3, 0x6a,
+	0xad, 0x39, 0xe9, 0x0a, 0x56, 0xc1, 0x4a, 0x40, 0x7d, 0x72, 0x8c, 0xce, 0x36, 0xad, 0xd6, 0xb2,
+	0x63, 0x16, 0xf0, 0x01, 0xc8, 0xc5, 0x3c, 0xa0, 0x5e, 0x10, 0xe3, 0x10, 0xad, 0x34, 0x97, 0x5b,
+	0xf9, 0xce, 0x67, 0x33, 0x9b, 0x76, 0xa6, 0x76, 0xb3, 0x1f, 0x8f, 0xc2, 0xf7, 0xa8, 0xe4, 0x89,
+	0x33, 0x81, 0xc3, 0x0e, 0x58, 0x11, 0x12, 0x4b, 0x81, 0x56, 0xb5, 0xf8, 0xcd, 0x39, 0x1e, 0x45,
+	0x20, 0x52, 0xb9, 0x26, 0x14, 0xf6, 0x41, 0x55, 0x32, 0x89, 0x43, 0x97, 0x1c, 0x4b, 0xc2, 0x29,
+	0x0e, 0xdd, 0x24, 0x20, 0xa1, 0x8f, 0xce, 0x69, 0x29, 0x5f, 0xbe, 0x5f, 0xca, 0xa1, 0x42, 0xee,
+	0xa5, 0xc0, 0xa7, 0x0a, 0x67, 0x34, 0x41, 0x39, 0xe7, 0x80, 0xcf, 0xc0, 0xba, 0x66, 0x76, 0x39,
+	0xf1, 0x82, 0x38, 0x20, 0x54, 0x0a, 0xb4, 0xa6, 0x37, 0xe9, 0xbc, 0x7f, 0x13, 0x0d, 0x77, 0xc6,
+	0x20, 0xb3, 0x43, 0x39, 0x99, 0xb6, 0xc2, 0x43, 0x50, 0xe4, 0x44, 0xf2, 0xc4, 0xc5, 0x11, 0x1b,
+	0x28, 0xee, 0x9c, 0xe6, 0x6e, 0xbf, 0x9f, 0xdb, 0x51, 0x90, 0x3b, 0x06, 0x61, 0x88, 0x0b, 0x3c,
+	0x63, 0x82, 0xfb, 0xa0, 0xa8, 0xcb, 0xe4, 0x3e, 0x0f, 0x84, 0x64, 0x3c, 0x41, 0x40, 0xb3, 0xee,
+	0xcc, 0xb1, 0xde, 0x57, 0x51, 0x0e, 0xf1, 0x18, 0xf7, 0xd3, 0xfc, 0x16, 0x34, 0xf0, 0x3b, 0x83,
+	0x83, 0x4f, 0x40, 0x9e, 0x60, 0x4e, 0x09, 0x77, 0x39, 0x96, 0x04, 0xe5, 0x9b, 0x56, 0x2b, 0xd7,
+	0xbd, 0xa5, 0x02, 0xff, 0x7e, 0xd3, 0xd8, 0x36, 0x17, 0x4f, 0xf8, 0x2f, 0xec, 0x80, 0xb5, 0x23,
+	0x2c, 0x9f, 0xdb, 0xdf, 0x93, 0x3e, 0xf6, 0x92, 0x5d, 0xe2, 0xfd, 0xf9, 0xfb, 0xe7, 0xc0, 0xb8,
+	0xed, 0x5d, 0xe2, 0xfd, 0xfa, 0xdf, 0x6f, 0xd7, 0x2d, 0x07, 0x18, 0x2a, 0x47, 0x9d, 0xfa, 0x08,
+	0x6c, 0x9a, 0xb4, 0x7a, 0x21, 0x0e, 0xa2, 0x6c, 0x72, 0x0b, 0x5a, 0xea, 0x57, 0x1f, 0x90, 0xdc,
+	0xbb, 0x0a, 0x3a, 0x9b, 0xe1, 0x6a, 0xb2, 0xc0, 0x05, 0xb7, 0x41, 0xce, 0x6c, 0x24, 0x83, 0x18,
+	0x15, 0x9b, 0x56, 0xab, 0xe8, 0xac, 0x69, 0xc3, 0x61, 0x10, 0xc3, 0x27, 0xa0, 0x60, 0xb4, 0x88,
+	0x38, 0x0c, 0xa4, 0x40, 0x25, 0xad, 0xc0, 0xfe, 0x00, 0x05, 0x07, 0x1a, 0xa0, 0x37, 0x4e, 0xd3,
+	0x97, 0x4f, 0x26, 0x76, 0x68, 0x83, 0x0d, 0x3c, 0x90, 0x2c, 0xfd, 0x8f, 0xd8, 0xf3, 0x4c, 0x89,
+	0xcb, 0xcd, 0xe5, 0x56, 0xce, 0xa9, 0x28, 0x97, 0xd6, 0x79, 0x27, 0x75, 0xc0, 0x9b, 0x60, 0x33,
+	0x13, 0x1f, 0x50, 0xd5, 0xdf, 0xfa, 0x9c, 0x08, 0x81, 0xd6, 0xf5, 0x95, 0xdc, 0x18, 0x43, 0xee,
+	0xd3, 0xc7, 0xa9, 0x0b, 0x5e, 0x07, 0x95, 0x0c, 0xc8, 0x1b, 0x70, 0xc1, 0x38, 0xaa, 0xa8, 0x42,
+	0x39, 0xe5, 0x71, 0xfc, 0x5d, 0x6d, 0x86, 0x5f, 0x80, 0x2a, 0x65, 0xd4, 0x55, 0x75, 0x08, 0x68,
+	0x7f, 0xa2, 0x08, 0x6a, 0x45, 0x90, 0x32, 0xba, 0x67, 0x5c, 0x63, 0x49, 0x35, 0xb0, 0x26, 0x39,
+	0xc1, 0x62, 0xc0, 0x13, 0xb4, 0xa1, 0x49, 0xc7, 0x6b, 0x78, 0x01, 0x9c, 0x8b, 0x5c, 0xd3, 0x1b,
+	0xaa, 0xba, 0x37, 0xac, 0x46, 0xfa, 0x3c, 0xc1, 0x75, 0xb0, 0xdc, 0x23, 0x04, 0x9d, 0xd7, 0x79,
+	0x56, 0x9f, 0xf0, 0x0a, 0x28, 0xf6, 0x08, 0x71, 0x3d, 0x16, 0x86, 0xc4, 0x93, 0x8c, 0xa3, 0x4d,
+	0xcd, 0x55, 0xe8, 0x11, 0x72, 0x77, 0x64, 0x83, 0x9f, 0x82, 0x72, 0x8f, 0xb3, 0x97, 0x84, 0x4e,
+	0x84, 0x5d, 0xd0, 0xc2, 0x4a, 0xc6, 0x3c, 0x16, 0x75, 0x1b, 0xe4, 0x4d, 0x73, 0x72, 0x65, 0x12,
+	0x13, 0x84, 0x9a, 0x56, 0xab, 0xd4, 0xd9, 0x9e, 0xab, 0xd7, 0x63, 0x1d, 0x73, 0x98, 0xc4, 0xc4,
+	0x01, 0xf1, 0xf8, 0x1b, 0xde, 0x4b, 0xd1, 0x6e, 0x40, 0x7b, 0x4c, 0xa0, 0x2d, 0x5d, 0xed, 0xc6,
+	0x62, 0xf4, 0x7d, 0xda, 0x63, 0xd9, 0xf2, 0x82, 0x78, 0x64, 0x15, 0xf0, 0x16, 0x58, 0xe1, 0x2c,
+	0x24, 0x02, 0xd5, 0x34, 0x43, 0x6d, 0x8e, 0xc1, 0x61, 0x21, 0xd9, 0xe7, 0x98, 0xca, 0x51, 0xeb,
+	0xd2, 0xe1, 0xf0, 0x01, 0x28, 0xc9, 0x20, 0x22, 0x21, 0xf3, 0x5e, 0xb8, 0x3e, 0x09, 0x71, 0x82,
+	0xb6, 0x75, 0xdf, 0xdb, 0xb2, 0xcd, 0x90, 0xb2, 0x47, 0x43, 0xca, 0xde, 0x4d, 0x87, 0x54, 0x77,
+	0x4d, 0xe1, 0x7f, 0xf9, 0xa7, 0x61, 0x39, 0xc5, 0x11, 0x74, 0x57, 0x21, 0xe1, 0x53, 0xb0, 0x31,
+	0x32, 0x10, 0xdf, 0x8d, 0x88, 0x10, 0xb8, 0x4f, 0x04, 0xda, 0xd1, 0x8a, 0x2e, 0xcf, 0x29, 0x3a,
+	0x1c, 0xc7, 0x3e, 0x34, 0xa1, 0xa9, 0x32, 0x28, 0x67, 0x1d, 0x02, 0x5e, 0xcb, 0xc8, 0xa4, 0x8c,
+	0x7a, 0x04, 0x5d, 0x6c, 0x5a, 0xad, 0xb3, 0x13, 0x05, 0x8f, 0x94, 0x11, 0xfe, 0x04, 0x4a, 0x11,
+	0x3e, 0x76, 0xd3, 0x1e, 0xa9, 0x9a, 0x44, 0xfd, 0xa3, 0x9a, 0x44, 0x21, 0xc2, 0xc7, 0xa6, 0x75,
+	0x9a, 0xe1, 0x58, 0x8c, 0x09, 0xf5, 0xd5, 0x61, 0x35, 0x07, 0xad, 0xa1, 0x53, 0x75, 0x71, 0xbe,
+	0x5a, 0x26, 0xca, 0xf4, 0xb3, 0x42, 0x9c, 0x59, 0xc1, 0x47, 0xa0, 0x6c, 0x9a, 0xa1, 0x90, 0x38,
+	0x54, 0x57, 0x58, 0xa0, 0x66, 0xd3, 0x5a, 0x58, 0x73, 0x0d, 0x38, 0x18, 0x85, 0xa5, 0xc9, 0x29,
+	0x05, 0x53, 0x56, 0xd8, 0x00, 0xf9, 0x0c, 0x1f, 0xba, 0xa4, 0xaf, 0x26, 0x98, 0x04, 0xc1, 0x4b,
+	0xa0, 0xf0, 0x1c, 0x07, 0xdc, 0x1b, 0x48, 0x37, 0x62, 0x3e, 0x41, 0x97, 0x75, 0x44, 0x3e, 0xb5,
+	0x3d, 0x64, 0x3e, 0x81, 0xcf, 0x40, 0x69, 0x14, 0xd2, 0xc3, 0xfa, 0x42, 0x5c, 0xf9, 0xa8, 0xac,
+	0x15, 0x53, 0xb6, 0x7b, 0x9a, 0x4c, 0x1d, 0x0b, 0x53, 0x10, 0x3f, 0x10, 0x92, 0x07, 0x47, 0x03,
+	0x75, 0x8a, 0x04, 0xba, 0x7a, 0xc2, 0xb1, 0xd0, 0xf9, 0xde, 0xcd, 0x84, 0x8e, 0x8e, 0x45, 0x32,
+	0xeb, 0x10, 0xb0, 0x05, 0xd6, 0xb1, 0x94, 0x44, 0x48, 0x75, 0xde, 0xd2, 0xa2, 0x5c, 0xd3, 0xb7,
+	0xbf, 0x34, 0xb2, 0x3f, 0x34, 0x79, 0xb7, 0xc1, 0xc6, 0xd4, 0x10, 0x52, 0xf9, 0xe2, 0x12, 0x7d,
+	0xa2, 0x83, 0x2b, 0xd9, 0x31, 0x73, 0xa0, 0x1c, 0xb5, 0xdb, 0xa0, 0x34, 0xfd, 0x46, 0x50, 0x7d,
+	0xe4, 0x05, 0x49, 0xf4, 0xbb, 0x28, 0xe7, 0xa8, 0x4f, 0xf5, 0x18, 0x19, 0xe2, 0x70, 0x40, 0xf4,
+	0x3b, 0x27, 0xe7, 0x98, 0xc5, 0x37, 0x67, 0xbe, 0xb6, 0x6a, 0x7b, 0xe0, 0xc2, 0x09, 0x63, 0xfd,
+	0x54, 0x34, 0x5d, 0x50, 0x5d, 0x34, 0xb8, 0x4f, 0xc5, 0xf1, 0x2d, 0xa8, 0xcc, 0x0d, 0xe8, 0x53,
+	0x11, 0xec, 0x83, 0xad, 0x13, 0x07, 0xdc, 0xa9, 0x88, 0x7e, 0x04, 0xeb, 0xb3, 0x73, 0x6a, 0x01,
+	0xfe, 0x46, 0x16, 0x9f, 0x5f, 0xd0, 0x48, 0x27, 0x1c, 0x19, 0xf2, 0xee, 0xad, 0x57, 0x6f, 0xeb,
+	0xd6, 0xeb, 0xb7, 0x75, 0xeb, 0xdf, 0xb7, 0x75, 0xeb, 0xe7, 0x77, 0xf5, 0xa5, 0xd7, 0xef, 0xea,
+	0x4b, 0x7f, 0xbd, 0xab, 0x2f, 0xfd, 0xb0, 0x93, 0x42, 0x0d, 0xcf, 0x71, 0xf2, 0x52, 0xbd, 0x95,
+	0x55, 0xab, 0x16, 0xed, 0x61, 0xe7, 0x68, 0x55, 0xf7, 0xb7, 0x9b, 0xff, 0x0f, 0x00, 0xb2, 0xd1,
+	0x0e, 0x0f, 0x08, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -208,109 +506,74 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.RetryAmounts) > 0 {
-		for k := range m.RetryAmounts {
-			v := m.RetryAmounts[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+	if m.IndexHistoryStart != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IndexHistoryStart))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.YieldRecipients) > 0 {
-		for k := range m.YieldRecipients {
-			v := m.YieldRecipients[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
-		}
+	if m.AttestedMIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestedMIndex))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
 	}
-	if len(m.TotalExternalYield) > 0 {
-		for k := range m.TotalExternalYield {
-			v := m.TotalExternalYield[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+	if len(m.YieldDistributions) > 0 {
+		for iNdEx := len(m.YieldDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.YieldDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0xa2
 		}
 	}
 	{
-		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.HaircutFactor.Size()
+		i -= size
+		if _, err := m.HaircutFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Principal) > 0 {
-		for k := range m.Principal {
-			v := m.Principal[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
+	if m.HaircutMode {
+		i--
+		if m.HaircutMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.Index != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Paused {
+	if m.IndexStale {
 		i--
-		if m.Paused {
+		if m.IndexStale {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
 	{
-		size, err := m.Vaults.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.IndexStaleness.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -318,34 +581,401 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.PendingIndex != nil {
+		{
+			size, err := m.PendingIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	{
-		size, err := m.Portal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxYieldRate.Size()
+		i -= size
+		if _, err := m.MaxYieldRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.TimelockNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimelockNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TimelockedMessages) > 0 {
+		for iNdEx := len(m.TimelockedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimelockDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimelockDelay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.PauseInfos) > 0 {
+		for iNdEx := len(m.PauseInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.PausedType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Fee != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.NonEarningAccounts) > 0 {
+		for iNdEx := len(m.NonEarningAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NonEarningAccounts[iNdEx])
+			copy(dAtA[i:], m.NonEarningAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NonEarningAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AutoClaimCursor) > 0 {
+		i -= len(m.AutoClaimCursor)
+		copy(dAtA[i:], m.AutoClaimCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoClaimCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.AutoClaimInProgress {
+		i--
+		if m.AutoClaimInProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.AutoClaimAccounts) > 0 {
+		for iNdEx := len(m.AutoClaimAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoClaimAccounts[iNdEx])
+			copy(dAtA[i:], m.AutoClaimAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoClaimAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.YieldSplits) > 0 {
+		for k := range m.YieldSplits {
+			v := m.YieldSplits[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ClaimTip != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimTip))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.YieldClaimRecipients) > 0 {
+		for k := range m.YieldClaimRecipients {
+			v := m.YieldClaimRecipients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.EarnerRate.Size()
+		i -= size
+		if _, err := m.EarnerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.IndexHistory) > 0 {
+		for iNdEx := len(m.IndexHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RetryAmounts) > 0 {
+		for k := range m.RetryAmounts {
+			v := m.RetryAmounts[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.YieldRecipients) > 0 {
+		for k := range m.YieldRecipients {
+			v := m.YieldRecipients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TotalExternalYield) > 0 {
+		for k := range m.TotalExternalYield {
+			v := m.TotalExternalYield[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Principal) > 0 {
+		for k := range m.Principal {
+			v := m.Principal[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Vaults.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Portal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -393,47 +1023,1460 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+	if len(m.IndexHistory) > 0 {
+		for _, e := range m.IndexHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EarnerRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.YieldClaimRecipients) > 0 {
+		for k, v := range m.YieldClaimRecipients {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.ClaimTip != 0 {
+		n += 1 + sovGenesis(uint64(m.ClaimTip))
+	}
+	if len(m.YieldSplits) > 0 {
+		for k, v := range m.YieldSplits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AutoClaimAccounts) > 0 {
+		for _, s := range m.AutoClaimAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoClaimInProgress {
+		n += 3
+	}
+	l = len(m.AutoClaimCursor)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NonEarningAccounts) > 0 {
+		for _, s := range m.NonEarningAccounts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.MIndex != 0 {
+		n += 2 + sovGenesis(uint64(m.MIndex))
+	}
+	if m.Fee != 0 {
+		n += 2 + sovGenesis(uint64(m.Fee))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PausedType != 0 {
+		n += 2 + sovGenesis(uint64(m.PausedType))
+	}
+	if len(m.PauseInfos) > 0 {
+		for _, e := range m.PauseInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimelockDelay)
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TimelockedMessages) > 0 {
+		for _, e := range m.TimelockedMessages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimelockNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.TimelockNonce))
+	}
+	l = m.MaxYieldRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.PendingIndex != nil {
+		l = m.PendingIndex.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.IndexStaleness.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.IndexStale {
+		n += 3
+	}
+	if m.HaircutMode {
+		n += 3
+	}
+	l = m.HaircutFactor.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.YieldDistributions) > 0 {
+		for _, e := range m.YieldDistributions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AttestedMIndex != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestedMIndex))
+	}
+	if m.IndexHistoryStart != 0 {
+		n += 2 + sovGenesis(uint64(m.IndexHistoryStart))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Portal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Principal[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalExternalYield == nil {
+				m.TotalExternalYield = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalExternalYield[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldRecipients == nil {
+				m.YieldRecipients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.YieldRecipients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryAmounts == nil {
+				m.RetryAmounts = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RetryAmounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexHistory = append(m.IndexHistory, IndexRecord{})
+			if err := m.IndexHistory[len(m.IndexHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnerRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarnerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldClaimRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldClaimRecipients == nil {
+				m.YieldClaimRecipients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.YieldClaimRecipients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTip", wireType)
+			}
+			m.ClaimTip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimTip |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldSplits == nil {
+				m.YieldSplits = make(map[string]YieldSplit)
+			}
+			var mapkey string
+			mapvalue := &YieldSplit{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &YieldSplit{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.YieldSplits[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoClaimAccounts = append(m.AutoClaimAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimInProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaimInProgress = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoClaimCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonEarningAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonEarningAccounts = append(m.NonEarningAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MIndex", wireType)
+			}
+			m.MIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedType", wireType)
+			}
+			m.PausedType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedType |= PausedType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseInfos = append(m.PauseInfos, PauseInfoEntry{})
+			if err := m.PauseInfos[len(m.PauseInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Portal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -460,13 +2503,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Portal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Roles = append(m.Roles, RoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -493,15 +2537,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimelockDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedMessages", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -511,17 +2555,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedMessages = append(m.TimelockedMessages, TimelockedMessage{})
+			if err := m.TimelockedMessages[len(m.TimelockedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockNonce", wireType)
 			}
-			m.Index = 0
+			m.TimelockNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -531,16 +2589,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
+				m.TimelockNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxYieldRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -550,122 +2608,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Principal == nil {
-				m.Principal = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.MaxYieldRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Principal[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -692,13 +2657,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingIndex == nil {
+				m.PendingIndex = &PendingIndex{}
+			}
+			if err := m.PendingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexStaleness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -725,109 +2693,55 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalExternalYield == nil {
-				m.TotalExternalYield = make(map[string]string)
+			if err := m.IndexStaleness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexStale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TotalExternalYield[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
+			m.IndexStale = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HaircutMode = bool(v != 0)
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -837,122 +2751,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.YieldRecipients == nil {
-				m.YieldRecipients = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.HaircutFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.YieldRecipients[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldDistributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -979,104 +2800,49 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryAmounts == nil {
-				m.RetryAmounts = make(map[string]string)
+			m.YieldDistributions = append(m.YieldDistributions, YieldDistribution{})
+			if err := m.YieldDistributions[len(m.YieldDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedMIndex", wireType)
+			}
+			m.AttestedMIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedMIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexHistoryStart", wireType)
+			}
+			m.IndexHistoryStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexHistoryStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RetryAmounts[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8,24 +8,29 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +38,166 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryPaused struct {
+}
+
+func (m *QueryPaused) Reset()         { *m = QueryPaused{} }
+func (m *QueryPaused) String() string { return proto.CompactTextString(m) }
+func (*QueryPaused) ProtoMessage()    {}
+func (*QueryPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{0}
+}
+func (m *QueryPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaused.Merge(m, src)
+}
+func (m *QueryPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaused proto.InternalMessageInfo
+
+type QueryPausedResponse struct {
+	Paused PausedType `protobuf:"varint,1,opt,name=paused,proto3,enum=noble.dollar.v2.PausedType" json:"paused,omitempty"`
+}
+
+func (m *QueryPausedResponse) Reset()         { *m = QueryPausedResponse{} }
+func (m *QueryPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedResponse) ProtoMessage()    {}
+func (*QueryPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{1}
+}
+func (m *QueryPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedResponse.Merge(m, src)
+}
+func (m *QueryPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedResponse proto.InternalMessageInfo
+
+func (m *QueryPausedResponse) GetPaused() PausedType {
+	if m != nil {
+		return m.Paused
+	}
+	return PausedType_NONE
+}
+
+type QueryPauses struct {
+}
+
+func (m *QueryPauses) Reset()         { *m = QueryPauses{} }
+func (m *QueryPauses) String() string { return proto.CompactTextString(m) }
+func (*QueryPauses) ProtoMessage()    {}
+func (*QueryPauses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{2}
+}
+func (m *QueryPauses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauses.Merge(m, src)
+}
+func (m *QueryPauses) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauses) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauses proto.InternalMessageInfo
+
+type QueryPausesResponse struct {
+	Pauses []ActivePause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses"`
+}
+
+func (m *QueryPausesResponse) Reset()         { *m = QueryPausesResponse{} }
+func (m *QueryPausesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausesResponse) ProtoMessage()    {}
+func (*QueryPausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{3}
+}
+func (m *QueryPausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausesResponse.Merge(m, src)
+}
+func (m *QueryPausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausesResponse proto.InternalMessageInfo
+
+func (m *QueryPausesResponse) GetPauses() []ActivePause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
 type QueryStats struct {
 }
 
@@ -40,7 +205,7 @@ func (m *QueryStats) Reset()         { *m = QueryStats{} }
 func (m *QueryStats) String() string { return proto.CompactTextString(m) }
 func (*QueryStats) ProtoMessage()    {}
 func (*QueryStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{0}
+	return fileDescriptor_13ad0ac76919569d, []int{4}
 }
 func (m *QueryStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -74,13 +239,15 @@ type QueryStatsResponse struct {
 	TotalPrincipal     cosmossdk_io_math.Int                       `protobuf:"bytes,2,opt,name=total_principal,json=totalPrincipal,proto3,customtype=cosmossdk.io/math.Int" json:"total_principal"`
 	TotalYieldAccrued  cosmossdk_io_math.Int                       `protobuf:"bytes,3,opt,name=total_yield_accrued,json=totalYieldAccrued,proto3,customtype=cosmossdk.io/math.Int" json:"total_yield_accrued"`
 	TotalExternalYield map[string]QueryStatsResponse_ExternalYield `protobuf:"bytes,4,rep,name=total_external_yield,json=totalExternalYield,proto3" json:"total_external_yield" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TotalFeesAccrued   cosmossdk_io_math.Int                       `protobuf:"bytes,5,opt,name=total_fees_accrued,json=totalFeesAccrued,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_accrued"`
+	TotalHaircutBurned cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=total_haircut_burned,json=totalHaircutBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_haircut_burned"`
 }
 
 func (m *QueryStatsResponse) Reset()         { *m = QueryStatsResponse{} }
 func (m *QueryStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsResponse) ProtoMessage()    {}
 func (*QueryStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{1}
+	return fileDescriptor_13ad0ac76919569d, []int{5}
 }
 func (m *QueryStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,7 +299,7 @@ func (m *QueryStatsResponse_ExternalYield) Reset()         { *m = QueryStatsResp
 func (m *QueryStatsResponse_ExternalYield) String() string { return proto.CompactTextString(m) }
 func (*QueryStatsResponse_ExternalYield) ProtoMessage()    {}
 func (*QueryStatsResponse_ExternalYield) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{1, 0}
+	return fileDescriptor_13ad0ac76919569d, []int{5, 0}
 }
 func (m *QueryStatsResponse_ExternalYield) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,7 +342,7 @@ func (m *QueryYieldRecipients) Reset()         { *m = QueryYieldRecipients{} }
 func (m *QueryYieldRecipients) String() string { return proto.CompactTextString(m) }
 func (*QueryYieldRecipients) ProtoMessage()    {}
 func (*QueryYieldRecipients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{2}
+	return fileDescriptor_13ad0ac76919569d, []int{6}
 }
 func (m *QueryYieldRecipients) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +379,7 @@ func (m *QueryYieldRecipientsResponse) Reset()         { *m = QueryYieldRecipien
 func (m *QueryYieldRecipientsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryYieldRecipientsResponse) ProtoMessage()    {}
 func (*QueryYieldRecipientsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{3}
+	return fileDescriptor_13ad0ac76919569d, []int{7}
 }
 func (m *QueryYieldRecipientsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +424,7 @@ func (m *QueryYieldRecipient) Reset()         { *m = QueryYieldRecipient{} }
 func (m *QueryYieldRecipient) String() string { return proto.CompactTextString(m) }
 func (*QueryYieldRecipient) ProtoMessage()    {}
 func (*QueryYieldRecipient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{4}
+	return fileDescriptor_13ad0ac76919569d, []int{8}
 }
 func (m *QueryYieldRecipient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +475,7 @@ func (m *QueryYieldRecipientResponse) Reset()         { *m = QueryYieldRecipient
 func (m *QueryYieldRecipientResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryYieldRecipientResponse) ProtoMessage()    {}
 func (*QueryYieldRecipientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{5}
+	return fileDescriptor_13ad0ac76919569d, []int{9}
 }
 func (m *QueryYieldRecipientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,7 +518,7 @@ func (m *QueryRetryAmounts) Reset()         { *m = QueryRetryAmounts{} }
 func (m *QueryRetryAmounts) String() string { return proto.CompactTextString(m) }
 func (*QueryRetryAmounts) ProtoMessage()    {}
 func (*QueryRetryAmounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{6}
+	return fileDescriptor_13ad0ac76919569d, []int{10}
 }
 func (m *QueryRetryAmounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +555,7 @@ func (m *QueryRetryAmountsResponse) Reset()         { *m = QueryRetryAmountsResp
 func (m *QueryRetryAmountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRetryAmountsResponse) ProtoMessage()    {}
 func (*QueryRetryAmountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{7}
+	return fileDescriptor_13ad0ac76919569d, []int{11}
 }
 func (m *QueryRetryAmountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +600,7 @@ func (m *QueryRetryAmount) Reset()         { *m = QueryRetryAmount{} }
 func (m *QueryRetryAmount) String() string { return proto.CompactTextString(m) }
 func (*QueryRetryAmount) ProtoMessage()    {}
 func (*QueryRetryAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{8}
+	return fileDescriptor_13ad0ac76919569d, []int{12}
 }
 func (m *QueryRetryAmount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +651,7 @@ func (m *QueryRetryAmountResponse) Reset()         { *m = QueryRetryAmountRespon
 func (m *QueryRetryAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRetryAmountResponse) ProtoMessage()    {}
 func (*QueryRetryAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13ad0ac76919569d, []int{9}
+	return fileDescriptor_13ad0ac76919569d, []int{13}
 }
 func (m *QueryRetryAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,881 +680,10900 @@ func (m *QueryRetryAmountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRetryAmountResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryStats)(nil), "noble.dollar.v2.QueryStats")
-	proto.RegisterType((*QueryStatsResponse)(nil), "noble.dollar.v2.QueryStatsResponse")
-	proto.RegisterMapType((map[string]QueryStatsResponse_ExternalYield)(nil), "noble.dollar.v2.QueryStatsResponse.TotalExternalYieldEntry")
-	proto.RegisterType((*QueryStatsResponse_ExternalYield)(nil), "noble.dollar.v2.QueryStatsResponse.ExternalYield")
-	proto.RegisterType((*QueryYieldRecipients)(nil), "noble.dollar.v2.QueryYieldRecipients")
-	proto.RegisterType((*QueryYieldRecipientsResponse)(nil), "noble.dollar.v2.QueryYieldRecipientsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.QueryYieldRecipientsResponse.YieldRecipientsEntry")
-	proto.RegisterType((*QueryYieldRecipient)(nil), "noble.dollar.v2.QueryYieldRecipient")
-	proto.RegisterType((*QueryYieldRecipientResponse)(nil), "noble.dollar.v2.QueryYieldRecipientResponse")
-	proto.RegisterType((*QueryRetryAmounts)(nil), "noble.dollar.v2.QueryRetryAmounts")
-	proto.RegisterType((*QueryRetryAmountsResponse)(nil), "noble.dollar.v2.QueryRetryAmountsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.QueryRetryAmountsResponse.RetryAmountsEntry")
-	proto.RegisterType((*QueryRetryAmount)(nil), "noble.dollar.v2.QueryRetryAmount")
-	proto.RegisterType((*QueryRetryAmountResponse)(nil), "noble.dollar.v2.QueryRetryAmountResponse")
+type QueryYieldClaimRecipient struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/dollar/v2/query.proto", fileDescriptor_13ad0ac76919569d) }
-
-var fileDescriptor_13ad0ac76919569d = []byte{
-	// 858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0x71, 0x69, 0x9e, 0x1d, 0x3b, 0x9e, 0x98, 0x62, 0xaf, 0xad, 0xad, 0xbb, 0x01,
-	0x11, 0x02, 0xdd, 0xa5, 0x46, 0x85, 0xaa, 0x20, 0xa1, 0x5a, 0x0a, 0x34, 0xb7, 0xb2, 0xe5, 0x52,
-	0x2e, 0x66, 0xeb, 0x1d, 0x9c, 0x51, 0xd7, 0x33, 0xcb, 0xee, 0xd8, 0xca, 0x52, 0xf5, 0xc2, 0x09,
-	0x89, 0x0b, 0x12, 0x07, 0x8e, 0x5c, 0x91, 0xb8, 0x70, 0xe0, 0xce, 0xb5, 0xc7, 0x02, 0x17, 0xc4,
-	0xa1, 0x42, 0x0e, 0x12, 0x47, 0xfe, 0x02, 0xda, 0x99, 0xf5, 0x7a, 0xbd, 0xde, 0xd4, 0x6e, 0x24,
-	0x2e, 0xd1, 0xce, 0x7b, 0x6f, 0xbe, 0xef, 0x7b, 0xef, 0xcd, 0x97, 0x04, 0x9a, 0x94, 0xdd, 0x77,
-	0xb1, 0xe9, 0x30, 0xd7, 0xb5, 0x7d, 0x73, 0xdc, 0x31, 0x3f, 0x1f, 0x61, 0x3f, 0x34, 0x3c, 0x9f,
-	0x71, 0x86, 0x2a, 0x22, 0x69, 0xc8, 0xa4, 0x31, 0xee, 0xa8, 0x55, 0x7b, 0x48, 0x28, 0x33, 0xc5,
-	0x4f, 0x59, 0xa3, 0x36, 0xfb, 0x2c, 0x18, 0xb2, 0x40, 0xde, 0x33, 0xc7, 0xd7, 0xd2, 0x00, 0x6a,
-	0x43, 0x26, 0x7b, 0xe2, 0x64, 0xca, 0x43, 0x9c, 0xaa, 0x0d, 0xd8, 0x80, 0xc9, 0x78, 0xf4, 0x15,
-	0x47, 0x5b, 0x03, 0xc6, 0x06, 0x2e, 0x36, 0x6d, 0x8f, 0x98, 0x36, 0xa5, 0x8c, 0xdb, 0x9c, 0x30,
-	0x3a, 0xbd, 0xd3, 0xca, 0x8a, 0x8d, 0x95, 0x89, 0xac, 0x5e, 0x02, 0xf8, 0x28, 0xe2, 0xbe, 0xcb,
-	0x6d, 0x1e, 0xe8, 0x93, 0x4d, 0x40, 0xb3, 0xa3, 0x85, 0x03, 0x8f, 0xd1, 0x00, 0xa3, 0x03, 0xd8,
-	0xe6, 0x8c, 0xdb, 0x6e, 0xef, 0x98, 0xb9, 0x0e, 0xf6, 0x83, 0xba, 0xd2, 0x56, 0xf6, 0x37, 0xbb,
-	0x85, 0x1f, 0xfe, 0xf9, 0xe9, 0x40, 0xb1, 0x4a, 0x22, 0x77, 0x5b, 0xa6, 0xd0, 0x3d, 0xa8, 0xc8,
-	0x5a, 0xcf, 0x27, 0xb4, 0x4f, 0x3c, 0xdb, 0xad, 0xaf, 0xb7, 0x95, 0xfd, 0xad, 0xee, 0x9b, 0x8f,
-	0x9f, 0x5e, 0x5e, 0xfb, 0xf3, 0xe9, 0xe5, 0x17, 0x65, 0x47, 0x81, 0xf3, 0xc0, 0x20, 0xcc, 0x1c,
-	0xda, 0xfc, 0xd8, 0x38, 0xa2, 0xfc, 0xb7, 0x9f, 0xaf, 0x42, 0xdc, 0xea, 0x11, 0xe5, 0x12, 0xb8,
-	0x2c, 0x80, 0xee, 0x4c, 0x71, 0xd0, 0xa7, 0xb0, 0x2b, 0xa1, 0x43, 0x82, 0x5d, 0xa7, 0x67, 0xf7,
-	0xfb, 0xfe, 0x08, 0x3b, 0xf5, 0x8d, 0x73, 0xc2, 0x57, 0x05, 0xd8, 0xbd, 0x08, 0xeb, 0x96, 0x84,
-	0x42, 0x01, 0xd4, 0x24, 0x03, 0x3e, 0xe1, 0xd8, 0xa7, 0x53, 0xaa, 0xfa, 0x66, 0x7b, 0x63, 0xbf,
-	0xd8, 0x79, 0xd7, 0xc8, 0xac, 0xd6, 0x58, 0x9c, 0x95, 0xf1, 0x71, 0x74, 0xff, 0x30, 0xbe, 0x2e,
-	0xc0, 0x0f, 0x29, 0xf7, 0xc3, 0xee, 0x66, 0xa4, 0xcf, 0x42, 0x7c, 0x21, 0xad, 0x72, 0xd8, 0x9e,
-	0x0b, 0xa0, 0x06, 0x5c, 0xec, 0x1f, 0xdb, 0x84, 0xf6, 0x88, 0x23, 0x26, 0xbd, 0x65, 0xbd, 0x20,
-	0xce, 0x47, 0x0e, 0xba, 0x0d, 0x17, 0xec, 0x21, 0x1b, 0x51, 0x7e, 0xee, 0xa1, 0xc6, 0xf7, 0xd5,
-	0x13, 0x78, 0xe9, 0x0c, 0xa9, 0x68, 0x07, 0x36, 0x1e, 0xe0, 0x30, 0xa6, 0x8e, 0x3e, 0xd1, 0x87,
-	0x50, 0x18, 0xdb, 0xee, 0x08, 0x0b, 0xd6, 0x62, 0xe7, 0xda, 0x2a, 0x83, 0x98, 0x03, 0xb6, 0xe4,
-	0xfd, 0x9b, 0xeb, 0x37, 0x14, 0xfd, 0x12, 0xd4, 0x44, 0xb9, 0x4c, 0xe0, 0x3e, 0xf1, 0x08, 0xa6,
-	0x3c, 0xd0, 0x7f, 0x55, 0xa0, 0x95, 0x97, 0x48, 0x9e, 0xe1, 0x10, 0x76, 0xe4, 0xe6, 0xfd, 0x24,
-	0x57, 0x57, 0xc4, 0x66, 0xba, 0xf9, 0x82, 0xce, 0x00, 0x32, 0x32, 0x71, 0xd1, 0xb5, 0x55, 0x09,
-	0xe7, 0xa3, 0x6a, 0x17, 0x6a, 0x79, 0x85, 0x39, 0xe3, 0xa9, 0xa5, 0xc7, 0xb3, 0x95, 0xee, 0xd5,
-	0x85, 0xdd, 0x1c, 0x25, 0xe8, 0x3a, 0x5c, 0xf4, 0x7c, 0x36, 0x26, 0x0e, 0xf6, 0x05, 0x4e, 0xb9,
-	0xd3, 0x58, 0xe8, 0xe0, 0x4e, 0x5c, 0x60, 0x25, 0xa5, 0x48, 0x03, 0x20, 0x0e, 0xa6, 0x9c, 0x7c,
-	0x46, 0xb0, 0x1f, 0x93, 0xa5, 0x22, 0xfa, 0x07, 0xd0, 0xcc, 0x61, 0x4b, 0xe6, 0xf7, 0x2a, 0x54,
-	0x32, 0xf3, 0x8b, 0x9b, 0x28, 0xcf, 0xb7, 0xae, 0xef, 0x42, 0x55, 0xe0, 0x58, 0x98, 0xfb, 0xe1,
-	0x2d, 0xf1, 0x5e, 0x02, 0xfd, 0x17, 0x05, 0x1a, 0x0b, 0xd1, 0x04, 0xdb, 0x86, 0x6d, 0x3f, 0x8a,
-	0xf7, 0xe4, 0xf3, 0x9a, 0x2e, 0xe6, 0xbd, 0xfc, 0xc5, 0xe4, 0x41, 0x18, 0xe9, 0xa0, 0x5c, 0x49,
-	0xc9, 0x4f, 0x85, 0xd4, 0xf7, 0xa1, 0xba, 0x50, 0xf2, 0x5c, 0xcb, 0x20, 0xb0, 0x93, 0x65, 0xff,
-	0xbf, 0x36, 0xc1, 0xa0, 0x9e, 0xa5, 0x4a, 0x46, 0x75, 0x17, 0x4a, 0xe9, 0x51, 0x49, 0xed, 0xe7,
-	0x70, 0x72, 0x31, 0x35, 0x9d, 0xce, 0xbf, 0x05, 0x28, 0x08, 0x46, 0x44, 0xa1, 0x20, 0x8c, 0x88,
-	0x9a, 0xcf, 0x70, 0xa9, 0xba, 0xb7, 0x82, 0x85, 0xf5, 0xbd, 0xaf, 0x22, 0xa2, 0x2f, 0x7f, 0xff,
-	0xfb, 0xdb, 0xf5, 0x3a, 0xba, 0x64, 0x66, 0xff, 0x90, 0x04, 0x82, 0xe6, 0x3b, 0x05, 0x2a, 0x19,
-	0x9f, 0xa0, 0x57, 0x56, 0xf2, 0xa3, 0x7a, 0xf5, 0xb9, 0x6c, 0xab, 0x1b, 0x33, 0x39, 0x7b, 0xe8,
-	0xca, 0x82, 0x9c, 0xec, 0xef, 0x06, 0xf4, 0xa3, 0x02, 0xe5, 0x8c, 0xf1, 0x5e, 0x5e, 0x85, 0x51,
-	0x7d, 0x63, 0x95, 0xaa, 0x44, 0xd6, 0xe1, 0x4c, 0xd6, 0x4d, 0x74, 0x63, 0x99, 0x2c, 0xf3, 0xe1,
-	0xf4, 0x11, 0x3d, 0x32, 0x1f, 0xce, 0x5e, 0xcc, 0x23, 0xf4, 0xb5, 0x02, 0xa5, 0xf4, 0xfb, 0x46,
-	0xfa, 0x72, 0xef, 0xa8, 0x07, 0xab, 0xfb, 0x4b, 0x7f, 0x7d, 0xa6, 0xb3, 0x8d, 0xb4, 0x05, 0x9d,
-	0x73, 0xf6, 0x45, 0xdf, 0x2b, 0x50, 0x4c, 0xfb, 0xe4, 0xca, 0x52, 0x22, 0xf5, 0xb5, 0xa5, 0x25,
-	0x89, 0x94, 0xee, 0x4c, 0xca, 0x3b, 0xe8, 0xfa, 0x33, 0xa5, 0x9c, 0x35, 0xaf, 0xee, 0xdb, 0x8f,
-	0x27, 0x9a, 0xf2, 0x64, 0xa2, 0x29, 0x7f, 0x4d, 0x34, 0xe5, 0x9b, 0x53, 0x6d, 0xed, 0xc9, 0xa9,
-	0xb6, 0xf6, 0xc7, 0xa9, 0xb6, 0xf6, 0x49, 0x2b, 0x56, 0x20, 0xe5, 0x9c, 0x84, 0x5f, 0x44, 0x98,
-	0x3c, 0xf4, 0x70, 0x60, 0x8e, 0x3b, 0xf7, 0x2f, 0x88, 0x7f, 0x7c, 0xde, 0xfa, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x08, 0x91, 0x53, 0x64, 0xc5, 0x09, 0x00, 0x00,
+func (m *QueryYieldClaimRecipient) Reset()         { *m = QueryYieldClaimRecipient{} }
+func (m *QueryYieldClaimRecipient) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldClaimRecipient) ProtoMessage()    {}
+func (*QueryYieldClaimRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
-	YieldRecipients(ctx context.Context, in *QueryYieldRecipients, opts ...grpc.CallOption) (*QueryYieldRecipientsResponse, error)
-	YieldRecipient(ctx context.Context, in *QueryYieldRecipient, opts ...grpc.CallOption) (*QueryYieldRecipientResponse, error)
-	RetryAmounts(ctx context.Context, in *QueryRetryAmounts, opts ...grpc.CallOption) (*QueryRetryAmountsResponse, error)
-	RetryAmount(ctx context.Context, in *QueryRetryAmount, opts ...grpc.CallOption) (*QueryRetryAmountResponse, error)
+func (m *QueryYieldClaimRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryYieldClaimRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldClaimRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryYieldClaimRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldClaimRecipient.Merge(m, src)
 }
-
-func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
-	out := new(QueryStatsResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Stats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryYieldClaimRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldClaimRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldClaimRecipient.DiscardUnknown(m)
 }
 
-func (c *queryClient) YieldRecipients(ctx context.Context, in *QueryYieldRecipients, opts ...grpc.CallOption) (*QueryYieldRecipientsResponse, error) {
-	out := new(QueryYieldRecipientsResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldRecipients", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryYieldClaimRecipient proto.InternalMessageInfo
+
+func (m *QueryYieldClaimRecipient) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) YieldRecipient(ctx context.Context, in *QueryYieldRecipient, opts ...grpc.CallOption) (*QueryYieldRecipientResponse, error) {
-	out := new(QueryYieldRecipientResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldRecipient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryYieldClaimRecipientResponse struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (c *queryClient) RetryAmounts(ctx context.Context, in *QueryRetryAmounts, opts ...grpc.CallOption) (*QueryRetryAmountsResponse, error) {
-	out := new(QueryRetryAmountsResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/RetryAmounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryYieldClaimRecipientResponse) Reset()         { *m = QueryYieldClaimRecipientResponse{} }
+func (m *QueryYieldClaimRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldClaimRecipientResponse) ProtoMessage()    {}
+func (*QueryYieldClaimRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{15}
+}
+func (m *QueryYieldClaimRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldClaimRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldClaimRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryYieldClaimRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldClaimRecipientResponse.Merge(m, src)
+}
+func (m *QueryYieldClaimRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldClaimRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldClaimRecipientResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RetryAmount(ctx context.Context, in *QueryRetryAmount, opts ...grpc.CallOption) (*QueryRetryAmountResponse, error) {
-	out := new(QueryRetryAmountResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/RetryAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryYieldClaimRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryYieldClaimRecipientResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
-	YieldRecipients(context.Context, *QueryYieldRecipients) (*QueryYieldRecipientsResponse, error)
-	YieldRecipient(context.Context, *QueryYieldRecipient) (*QueryYieldRecipientResponse, error)
-	RetryAmounts(context.Context, *QueryRetryAmounts) (*QueryRetryAmountsResponse, error)
-	RetryAmount(context.Context, *QueryRetryAmount) (*QueryRetryAmountResponse, error)
+type QueryYieldSplit struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryYieldSplit) Reset()         { *m = QueryYieldSplit{} }
+func (m *QueryYieldSplit) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldSplit) ProtoMessage()    {}
+func (*QueryYieldSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{16}
 }
-
-func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+func (m *QueryYieldSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) YieldRecipients(ctx context.Context, req *QueryYieldRecipients) (*QueryYieldRecipientsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method YieldRecipients not implemented")
+func (m *QueryYieldSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) YieldRecipient(ctx context.Context, req *QueryYieldRecipient) (*QueryYieldRecipientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method YieldRecipient not implemented")
+func (m *QueryYieldSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldSplit.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RetryAmounts(ctx context.Context, req *QueryRetryAmounts) (*QueryRetryAmountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryAmounts not implemented")
+func (m *QueryYieldSplit) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RetryAmount(ctx context.Context, req *QueryRetryAmount) (*QueryRetryAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryAmount not implemented")
+func (m *QueryYieldSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldSplit.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryYieldSplit proto.InternalMessageInfo
 
-func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStats)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Query/Stats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+func (m *QueryYieldSplit) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_YieldRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryYieldRecipients)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).YieldRecipients(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Query/YieldRecipients",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).YieldRecipients(ctx, req.(*QueryYieldRecipients))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryYieldSplitResponse struct {
+	Shares []YieldShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares"`
 }
 
-func _Query_YieldRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryYieldRecipient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).YieldRecipient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Query/YieldRecipient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).YieldRecipient(ctx, req.(*QueryYieldRecipient))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryYieldSplitResponse) Reset()         { *m = QueryYieldSplitResponse{} }
+func (m *QueryYieldSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldSplitResponse) ProtoMessage()    {}
+func (*QueryYieldSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{17}
 }
-
-func _Query_RetryAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRetryAmounts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RetryAmounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Query/RetryAmounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RetryAmounts(ctx, req.(*QueryRetryAmounts))
+func (m *QueryYieldSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryYieldSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldSplitResponse.Merge(m, src)
+}
+func (m *QueryYieldSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldSplitResponse.DiscardUnknown(m)
 }
 
-func _Query_RetryAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRetryAmount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RetryAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Query/RetryAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RetryAmount(ctx, req.(*QueryRetryAmount))
+var xxx_messageInfo_QueryYieldSplitResponse proto.InternalMessageInfo
+
+func (m *QueryYieldSplitResponse) GetShares() []YieldShare {
+	if m != nil {
+		return m.Shares
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.dollar.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Stats",
-			Handler:    _Query_Stats_Handler,
-		},
-		{
-			MethodName: "YieldRecipients",
-			Handler:    _Query_YieldRecipients_Handler,
-		},
-		{
-			MethodName: "YieldRecipient",
-			Handler:    _Query_YieldRecipient_Handler,
-		},
-		{
-			MethodName: "RetryAmounts",
-			Handler:    _Query_RetryAmounts_Handler,
-		},
-		{
-			MethodName: "RetryAmount",
-			Handler:    _Query_RetryAmount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/dollar/v2/query.proto",
+type QueryAutoClaim struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAutoClaim) Reset()         { *m = QueryAutoClaim{} }
+func (m *QueryAutoClaim) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoClaim) ProtoMessage()    {}
+func (*QueryAutoClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{18}
+}
+func (m *QueryAutoClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAutoClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoClaim.Merge(m, src)
 }
-
-func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAutoClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoClaim.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoClaim proto.InternalMessageInfo
+
+func (m *QueryAutoClaim) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAutoClaimResponse struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalExternalYield) > 0 {
-		for k := range m.TotalExternalYield {
-			v := m.TotalExternalYield[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size := m.TotalYieldAccrued.Size()
-		i -= size
-		if _, err := m.TotalYieldAccrued.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalPrincipal.Size()
-		i -= size
-		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryAutoClaimResponse) Reset()         { *m = QueryAutoClaimResponse{} }
+func (m *QueryAutoClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoClaimResponse) ProtoMessage()    {}
+func (*QueryAutoClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{19}
+}
+func (m *QueryAutoClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.TotalHolders != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalHolders))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAutoClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoClaimResponse.Merge(m, src)
+}
+func (m *QueryAutoClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoClaimResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse_ExternalYield) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoClaimResponse proto.InternalMessageInfo
+
+func (m *QueryAutoClaimResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryStatsResponse_ExternalYield) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNonEarningAccounts struct {
 }
 
-func (m *QueryStatsResponse_ExternalYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryNonEarningAccounts) Reset()         { *m = QueryNonEarningAccounts{} }
+func (m *QueryNonEarningAccounts) String() string { return proto.CompactTextString(m) }
+func (*QueryNonEarningAccounts) ProtoMessage()    {}
+func (*QueryNonEarningAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{20}
+}
+func (m *QueryNonEarningAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNonEarningAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNonEarningAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNonEarningAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNonEarningAccounts.Merge(m, src)
+}
+func (m *QueryNonEarningAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNonEarningAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNonEarningAccounts.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipients) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNonEarningAccounts proto.InternalMessageInfo
+
+type QueryNonEarningAccountsResponse struct {
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryNonEarningAccountsResponse) Reset()         { *m = QueryNonEarningAccountsResponse{} }
+func (m *QueryNonEarningAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNonEarningAccountsResponse) ProtoMessage()    {}
+func (*QueryNonEarningAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{21}
+}
+func (m *QueryNonEarningAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNonEarningAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNonEarningAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryNonEarningAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNonEarningAccountsResponse.Merge(m, src)
+}
+func (m *QueryNonEarningAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNonEarningAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNonEarningAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipients) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNonEarningAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryNonEarningAccountsResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
 }
 
-func (m *QueryYieldRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryFrozenAccounts struct {
 }
 
-func (m *QueryYieldRecipientsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenAccounts) Reset()         { *m = QueryFrozenAccounts{} }
+func (m *QueryFrozenAccounts) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccounts) ProtoMessage()    {}
+func (*QueryFrozenAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{22}
+}
+func (m *QueryFrozenAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFrozenAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccounts.Merge(m, src)
+}
+func (m *QueryFrozenAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccounts.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFrozenAccounts proto.InternalMessageInfo
+
+type QueryFrozenAccountsResponse struct {
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (m *QueryYieldRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.YieldRecipients) > 0 {
-		for k := range m.YieldRecipients {
-			v := m.YieldRecipients[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFrozenAccountsResponse) Reset()         { *m = QueryFrozenAccountsResponse{} }
+func (m *QueryFrozenAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountsResponse) ProtoMessage()    {}
+func (*QueryFrozenAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{23}
+}
+func (m *QueryFrozenAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFrozenAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountsResponse.Merge(m, src)
+}
+func (m *QueryFrozenAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFrozenAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountsResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryYieldRecipient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRoles struct {
 }
 
-func (m *QueryYieldRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Identifier) > 0 {
-		i -= len(m.Identifier)
-		copy(dAtA[i:], m.Identifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Provider != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Provider))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRoles) Reset()         { *m = QueryRoles{} }
+func (m *QueryRoles) String() string { return proto.CompactTextString(m) }
+func (*QueryRoles) ProtoMessage()    {}
+func (*QueryRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{24}
 }
-
-func (m *QueryYieldRecipientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoles.Merge(m, src)
+}
+func (m *QueryRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoles.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRoles proto.InternalMessageInfo
+
+type QueryRolesResponse struct {
+	Roles []RoleGrant `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
 }
 
-func (m *QueryYieldRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.YieldRecipient) > 0 {
-		i -= len(m.YieldRecipient)
-		copy(dAtA[i:], m.YieldRecipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.YieldRecipient)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRolesResponse) Reset()         { *m = QueryRolesResponse{} }
+func (m *QueryRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesResponse) ProtoMessage()    {}
+func (*QueryRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{25}
+}
+func (m *QueryRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesResponse.Merge(m, src)
+}
+func (m *QueryRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRetryAmounts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRolesResponse proto.InternalMessageInfo
+
+func (m *QueryRolesResponse) GetRoles() []RoleGrant {
+	if m != nil {
+		return m.Roles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRetryAmounts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountRoles struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryRetryAmounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAccountRoles) Reset()         { *m = QueryAccountRoles{} }
+func (m *QueryAccountRoles) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRoles) ProtoMessage()    {}
+func (*QueryAccountRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{26}
 }
-
-func (m *QueryRetryAmountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRetryAmountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRoles.Merge(m, src)
+}
+func (m *QueryAccountRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRoles.DiscardUnknown(m)
 }
 
-func (m *QueryRetryAmountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RetryAmounts) > 0 {
-		for k := range m.RetryAmounts {
-			v := m.RetryAmounts[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryAccountRoles proto.InternalMessageInfo
 
-func (m *QueryRetryAmount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountRoles) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRetryAmount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountRolesResponse struct {
+	Roles []Role `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=noble.dollar.v2.Role" json:"roles,omitempty"`
 }
 
-func (m *QueryRetryAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Identifier) > 0 {
-		i -= len(m.Identifier)
-		copy(dAtA[i:], m.Identifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Provider != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Provider))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAccountRolesResponse) Reset()         { *m = QueryAccountRolesResponse{} }
+func (m *QueryAccountRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRolesResponse) ProtoMessage()    {}
+func (*QueryAccountRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{27}
+}
+func (m *QueryAccountRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRolesResponse.Merge(m, src)
+}
+func (m *QueryAccountRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRolesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRetryAmountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRolesResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRolesResponse) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRetryAmountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTimelockDelay struct {
 }
 
-func (m *QueryRetryAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RetryAmount.Size()
-		i -= size
-		if _, err := m.RetryAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTimelockDelay) Reset()         { *m = QueryTimelockDelay{} }
+func (m *QueryTimelockDelay) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockDelay) ProtoMessage()    {}
+func (*QueryTimelockDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{28}
+}
+func (m *QueryTimelockDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryTimelockDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockDelay.Merge(m, src)
 }
-func (m *QueryStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryTimelockDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockDelay.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalHolders != 0 {
-		n += 1 + sovQuery(uint64(m.TotalHolders))
-	}
-	l = m.TotalPrincipal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalYieldAccrued.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TotalExternalYield) > 0 {
-		for k, v := range m.TotalExternalYield {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+var xxx_messageInfo_QueryTimelockDelay proto.InternalMessageInfo
+
+type QueryTimelockDelayResponse struct {
+	Delay time.Duration `protobuf:"bytes,1,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *QueryTimelockDelayResponse) Reset()         { *m = QueryTimelockDelayResponse{} }
+func (m *QueryTimelockDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockDelayResponse) ProtoMessage()    {}
+func (*QueryTimelockDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{29}
+}
+func (m *QueryTimelockDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryTimelockDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockDelayResponse.Merge(m, src)
+}
+func (m *QueryTimelockDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockDelayResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse_ExternalYield) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryTimelockDelayResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockDelayResponse) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryYieldRecipients) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryTimelockedMessages struct {
 }
 
-func (m *QueryYieldRecipientsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.YieldRecipients) > 0 {
-		for k, v := range m.YieldRecipients {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+func (m *QueryTimelockedMessages) Reset()         { *m = QueryTimelockedMessages{} }
+func (m *QueryTimelockedMessages) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedMessages) ProtoMessage()    {}
+func (*QueryTimelockedMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{30}
+}
+func (m *QueryTimelockedMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockedMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryYieldRecipient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Provider != 0 {
-		n += 1 + sovQuery(uint64(m.Provider))
-	}
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTimelockedMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedMessages.Merge(m, src)
+}
+func (m *QueryTimelockedMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockedMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedMessages.DiscardUnknown(m)
 }
 
-func (m *QueryYieldRecipientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.YieldRecipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var xxx_messageInfo_QueryTimelockedMessages proto.InternalMessageInfo
+
+type QueryTimelockedMessagesResponse struct {
+	Messages []TimelockedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
 }
 
-func (m *QueryRetryAmounts) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTimelockedMessagesResponse) Reset()         { *m = QueryTimelockedMessagesResponse{} }
+func (m *QueryTimelockedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedMessagesResponse) ProtoMessage()    {}
+func (*QueryTimelockedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{31}
+}
+func (m *QueryTimelockedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
+func (m *QueryTimelockedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedMessagesResponse.Merge(m, src)
+}
+func (m *QueryTimelockedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimelockedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockedMessagesResponse) GetMessages() []TimelockedMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type QueryIndexCircuitBreaker struct {
+}
+
+func (m *QueryIndexCircuitBreaker) Reset()         { *m = QueryIndexCircuitBreaker{} }
+func (m *QueryIndexCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexCircuitBreaker) ProtoMessage()    {}
+func (*QueryIndexCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{32}
+}
+func (m *QueryIndexCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexCircuitBreaker.Merge(m, src)
+}
+func (m *QueryIndexCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexCircuitBreaker proto.InternalMessageInfo
+
+type QueryIndexCircuitBreakerResponse struct {
+	MaxYieldRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_yield_rate,json=maxYieldRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_yield_rate"`
+	PendingIndex *PendingIndex               `protobuf:"bytes,2,opt,name=pending_index,json=pendingIndex,proto3" json:"pending_index,omitempty"`
+}
+
+func (m *QueryIndexCircuitBreakerResponse) Reset()         { *m = QueryIndexCircuitBreakerResponse{} }
+func (m *QueryIndexCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryIndexCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{33}
+}
+func (m *QueryIndexCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryIndexCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryIndexCircuitBreakerResponse) GetPendingIndex() *PendingIndex {
+	if m != nil {
+		return m.PendingIndex
+	}
+	return nil
+}
+
+type QueryIndexStatus struct {
+}
+
+func (m *QueryIndexStatus) Reset()         { *m = QueryIndexStatus{} }
+func (m *QueryIndexStatus) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexStatus) ProtoMessage()    {}
+func (*QueryIndexStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{34}
+}
+func (m *QueryIndexStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexStatus.Merge(m, src)
+}
+func (m *QueryIndexStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexStatus proto.InternalMessageInfo
+
+type QueryIndexStatusResponse struct {
+	// last_update is the time of the last index update, not set if the index was never updated.
+	LastUpdate *time.Time `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update,omitempty"`
+	// elapsed is the time since the last index update.
+	Elapsed   time.Duration  `protobuf:"bytes,2,opt,name=elapsed,proto3,stdduration" json:"elapsed"`
+	Staleness IndexStaleness `protobuf:"bytes,3,opt,name=staleness,proto3" json:"staleness"`
+	Stale     bool           `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (m *QueryIndexStatusResponse) Reset()         { *m = QueryIndexStatusResponse{} }
+func (m *QueryIndexStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexStatusResponse) ProtoMessage()    {}
+func (*QueryIndexStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{35}
+}
+func (m *QueryIndexStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexStatusResponse.Merge(m, src)
+}
+func (m *QueryIndexStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexStatusResponse proto.InternalMessageInfo
+
+func (m *QueryIndexStatusResponse) GetLastUpdate() *time.Time {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return nil
+}
+
+func (m *QueryIndexStatusResponse) GetElapsed() time.Duration {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+func (m *QueryIndexStatusResponse) GetStaleness() IndexStaleness {
+	if m != nil {
+		return m.Staleness
+	}
+	return IndexStaleness{}
+}
+
+func (m *QueryIndexStatusResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+type QueryHaircut struct {
+}
+
+func (m *QueryHaircut) Reset()         { *m = QueryHaircut{} }
+func (m *QueryHaircut) String() string { return proto.CompactTextString(m) }
+func (*QueryHaircut) ProtoMessage()    {}
+func (*QueryHaircut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{36}
+}
+func (m *QueryHaircut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaircut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaircut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHaircut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaircut.Merge(m, src)
+}
+func (m *QueryHaircut) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaircut) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaircut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHaircut proto.InternalMessageInfo
+
+type QueryHaircutResponse struct {
+	Enabled bool                        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Factor  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"factor"`
+}
+
+func (m *QueryHaircutResponse) Reset()         { *m = QueryHaircutResponse{} }
+func (m *QueryHaircutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHaircutResponse) ProtoMessage()    {}
+func (*QueryHaircutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{37}
+}
+func (m *QueryHaircutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaircutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaircutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHaircutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaircutResponse.Merge(m, src)
+}
+func (m *QueryHaircutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaircutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaircutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHaircutResponse proto.InternalMessageInfo
+
+func (m *QueryHaircutResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type QueryEffectiveBalance struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryEffectiveBalance) Reset()         { *m = QueryEffectiveBalance{} }
+func (m *QueryEffectiveBalance) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveBalance) ProtoMessage()    {}
+func (*QueryEffectiveBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{38}
+}
+func (m *QueryEffectiveBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveBalance.Merge(m, src)
+}
+func (m *QueryEffectiveBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveBalance proto.InternalMessageInfo
+
+func (m *QueryEffectiveBalance) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryEffectiveBalanceResponse struct {
+	// balance is the nominal $USDN balance of the account.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// effective_balance is the amount of $M backing the balance, reduced pro-rata by the haircut factor.
+	EffectiveBalance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=effective_balance,json=effectiveBalance,proto3,customtype=cosmossdk.io/math.Int" json:"effective_balance"`
+}
+
+func (m *QueryEffectiveBalanceResponse) Reset()         { *m = QueryEffectiveBalanceResponse{} }
+func (m *QueryEffectiveBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveBalanceResponse) ProtoMessage()    {}
+func (*QueryEffectiveBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{39}
+}
+func (m *QueryEffectiveBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveBalanceResponse.Merge(m, src)
+}
+func (m *QueryEffectiveBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveBalanceResponse proto.InternalMessageInfo
+
+type QueryTreasury struct {
+}
+
+func (m *QueryTreasury) Reset()         { *m = QueryTreasury{} }
+func (m *QueryTreasury) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasury) ProtoMessage()    {}
+func (*QueryTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{40}
+}
+func (m *QueryTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasury.Merge(m, src)
+}
+func (m *QueryTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasury proto.InternalMessageInfo
+
+type QueryTreasuryResponse struct {
+	Treasury string `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (m *QueryTreasuryResponse) Reset()         { *m = QueryTreasuryResponse{} }
+func (m *QueryTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryResponse) ProtoMessage()    {}
+func (*QueryTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{41}
+}
+func (m *QueryTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryResponse.Merge(m, src)
+}
+func (m *QueryTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryResponse) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+type QueryClaimTip struct {
+}
+
+func (m *QueryClaimTip) Reset()         { *m = QueryClaimTip{} }
+func (m *QueryClaimTip) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimTip) ProtoMessage()    {}
+func (*QueryClaimTip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{42}
+}
+func (m *QueryClaimTip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimTip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimTip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimTip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimTip.Merge(m, src)
+}
+func (m *QueryClaimTip) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimTip) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimTip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimTip proto.InternalMessageInfo
+
+type QueryClaimTipResponse struct {
+	Tip uint32 `protobuf:"varint,1,opt,name=tip,proto3" json:"tip,omitempty"`
+}
+
+func (m *QueryClaimTipResponse) Reset()         { *m = QueryClaimTipResponse{} }
+func (m *QueryClaimTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimTipResponse) ProtoMessage()    {}
+func (*QueryClaimTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{43}
+}
+func (m *QueryClaimTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimTipResponse.Merge(m, src)
+}
+func (m *QueryClaimTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimTipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimTipResponse proto.InternalMessageInfo
+
+func (m *QueryClaimTipResponse) GetTip() uint32 {
+	if m != nil {
+		return m.Tip
+	}
+	return 0
+}
+
+type QueryFee struct {
+}
+
+func (m *QueryFee) Reset()         { *m = QueryFee{} }
+func (m *QueryFee) String() string { return proto.CompactTextString(m) }
+func (*QueryFee) ProtoMessage()    {}
+func (*QueryFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{44}
+}
+func (m *QueryFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFee.Merge(m, src)
+}
+func (m *QueryFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFee proto.InternalMessageInfo
+
+type QueryFeeResponse struct {
+	Fee       uint32 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	Collector string `protobuf:"bytes,2,opt,name=collector,proto3" json:"collector,omitempty"`
+}
+
+func (m *QueryFeeResponse) Reset()         { *m = QueryFeeResponse{} }
+func (m *QueryFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeResponse) ProtoMessage()    {}
+func (*QueryFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{45}
+}
+func (m *QueryFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeResponse.Merge(m, src)
+}
+func (m *QueryFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeResponse proto.InternalMessageInfo
+
+func (m *QueryFeeResponse) GetFee() uint32 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *QueryFeeResponse) GetCollector() string {
+	if m != nil {
+		return m.Collector
+	}
+	return ""
+}
+
+type QueryInvariants struct {
+}
+
+func (m *QueryInvariants) Reset()         { *m = QueryInvariants{} }
+func (m *QueryInvariants) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariants) ProtoMessage()    {}
+func (*QueryInvariants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{46}
+}
+func (m *QueryInvariants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariants.Merge(m, src)
+}
+func (m *QueryInvariants) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariants) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariants proto.InternalMessageInfo
+
+type QueryInvariantsResponse struct {
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{47}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+type QueryYieldLiability struct {
+}
+
+func (m *QueryYieldLiability) Reset()         { *m = QueryYieldLiability{} }
+func (m *QueryYieldLiability) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldLiability) ProtoMessage()    {}
+func (*QueryYieldLiability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{48}
+}
+func (m *QueryYieldLiability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldLiability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldLiability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldLiability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldLiability.Merge(m, src)
+}
+func (m *QueryYieldLiability) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldLiability) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldLiability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldLiability proto.InternalMessageInfo
+
+type QueryYieldLiabilityResponse struct {
+	// liability is the total claimable but unclaimed yield of all accounts, at the last attested index.
+	Liability cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=liability,proto3,customtype=cosmossdk.io/math.Int" json:"liability"`
+	// reserve is the balance of the yield account.
+	Reserve cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=reserve,proto3,customtype=cosmossdk.io/math.Int" json:"reserve"`
+	// surplus is the reserve minus the liability, negative if the reserve falls short.
+	Surplus cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=surplus,proto3,customtype=cosmossdk.io/math.Int" json:"surplus"`
+	// rounding is the yield of accounts that is excluded from the liability, as a single unit of yield isn't claimable.
+	Rounding cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=rounding,proto3,customtype=cosmossdk.io/math.Int" json:"rounding"`
+	// accounts is the amount of accounts with principal.
+	Accounts int64 `protobuf:"varint,5,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Solvent  bool  `protobuf:"varint,6,opt,name=solvent,proto3" json:"solvent,omitempty"`
+	// excess is the sum of balances in excess of the present amount of their principal, e.g. after a haircut, which is netted against future yield.
+	Excess cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=excess,proto3,customtype=cosmossdk.io/math.Int" json:"excess"`
+}
+
+func (m *QueryYieldLiabilityResponse) Reset()         { *m = QueryYieldLiabilityResponse{} }
+func (m *QueryYieldLiabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldLiabilityResponse) ProtoMessage()    {}
+func (*QueryYieldLiabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{49}
+}
+func (m *QueryYieldLiabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldLiabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldLiabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldLiabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldLiabilityResponse.Merge(m, src)
+}
+func (m *QueryYieldLiabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldLiabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldLiabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldLiabilityResponse proto.InternalMessageInfo
+
+func (m *QueryYieldLiabilityResponse) GetAccounts() int64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *QueryYieldLiabilityResponse) GetSolvent() bool {
+	if m != nil {
+		return m.Solvent
+	}
+	return false
+}
+
+type QueryIndexAt struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryIndexAt) Reset()         { *m = QueryIndexAt{} }
+func (m *QueryIndexAt) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexAt) ProtoMessage()    {}
+func (*QueryIndexAt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{50}
+}
+func (m *QueryIndexAt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexAt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexAt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexAt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexAt.Merge(m, src)
+}
+func (m *QueryIndexAt) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexAt) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexAt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexAt proto.InternalMessageInfo
+
+func (m *QueryIndexAt) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+type QueryIndexAtResponse struct {
+	Record IndexRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryIndexAtResponse) Reset()         { *m = QueryIndexAtResponse{} }
+func (m *QueryIndexAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexAtResponse) ProtoMessage()    {}
+func (*QueryIndexAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{51}
+}
+func (m *QueryIndexAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexAtResponse.Merge(m, src)
+}
+func (m *QueryIndexAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexAtResponse proto.InternalMessageInfo
+
+func (m *QueryIndexAtResponse) GetRecord() IndexRecord {
+	if m != nil {
+		return m.Record
+	}
+	return IndexRecord{}
+}
+
+type QueryIndexHistory struct {
+	// start_time is the inclusive lower bound of the requested history, unbounded if not set.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the inclusive upper bound of the requested history, unbounded if not set.
+	EndTime    time.Time          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexHistory) Reset()         { *m = QueryIndexHistory{} }
+func (m *QueryIndexHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexHistory) ProtoMessage()    {}
+func (*QueryIndexHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{52}
+}
+func (m *QueryIndexHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexHistory.Merge(m, src)
+}
+func (m *QueryIndexHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexHistory proto.InternalMessageInfo
+
+func (m *QueryIndexHistory) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryIndexHistory) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryIndexHistory) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryIndexHistoryResponse struct {
+	History    []IndexRecord       `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexHistoryResponse) Reset()         { *m = QueryIndexHistoryResponse{} }
+func (m *QueryIndexHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexHistoryResponse) ProtoMessage()    {}
+func (*QueryIndexHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{53}
+}
+func (m *QueryIndexHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexHistoryResponse.Merge(m, src)
+}
+func (m *QueryIndexHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryIndexHistoryResponse) GetHistory() []IndexRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryIndexHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryYieldDistributions struct {
+	// start_time is the inclusive lower bound of the requested distributions, unbounded if not set.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the inclusive upper bound of the requested distributions, unbounded if not set.
+	EndTime    time.Time          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryYieldDistributions) Reset()         { *m = QueryYieldDistributions{} }
+func (m *QueryYieldDistributions) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldDistributions) ProtoMessage()    {}
+func (*QueryYieldDistributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{54}
+}
+func (m *QueryYieldDistributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldDistributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldDistributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldDistributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldDistributions.Merge(m, src)
+}
+func (m *QueryYieldDistributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldDistributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldDistributions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldDistributions proto.InternalMessageInfo
+
+func (m *QueryYieldDistributions) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryYieldDistributions) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryYieldDistributions) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryYieldDistributionsResponse struct {
+	Distributions []YieldDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryYieldDistributionsResponse) Reset()         { *m = QueryYieldDistributionsResponse{} }
+func (m *QueryYieldDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldDistributionsResponse) ProtoMessage()    {}
+func (*QueryYieldDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{55}
+}
+func (m *QueryYieldDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldDistributionsResponse.Merge(m, src)
+}
+func (m *QueryYieldDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryYieldDistributionsResponse) GetDistributions() []YieldDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *QueryYieldDistributionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCurrentIndex struct {
+}
+
+func (m *QueryCurrentIndex) Reset()         { *m = QueryCurrentIndex{} }
+func (m *QueryCurrentIndex) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentIndex) ProtoMessage()    {}
+func (*QueryCurrentIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{56}
+}
+func (m *QueryCurrentIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentIndex.Merge(m, src)
+}
+func (m *QueryCurrentIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentIndex proto.InternalMessageInfo
+
+type QueryCurrentIndexResponse struct {
+	// index is the last index attested by the Noble Dollar Portal.
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// current_index is the index continuously extrapolated from the last attested index.
+	CurrentIndex int64 `protobuf:"varint,2,opt,name=current_index,json=currentIndex,proto3" json:"current_index,omitempty"`
+	// earner_rate is the continuously compounded annual rate used for extrapolation.
+	EarnerRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=earner_rate,json=earnerRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"earner_rate"`
+	// last_updated is the time of the last index update.
+	LastUpdated time.Time `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3,stdtime" json:"last_updated"`
+}
+
+func (m *QueryCurrentIndexResponse) Reset()         { *m = QueryCurrentIndexResponse{} }
+func (m *QueryCurrentIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentIndexResponse) ProtoMessage()    {}
+func (*QueryCurrentIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{57}
+}
+func (m *QueryCurrentIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentIndexResponse.Merge(m, src)
+}
+func (m *QueryCurrentIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentIndexResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentIndexResponse) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueryCurrentIndexResponse) GetCurrentIndex() int64 {
+	if m != nil {
+		return m.CurrentIndex
+	}
+	return 0
+}
+
+func (m *QueryCurrentIndexResponse) GetLastUpdated() time.Time {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return time.Time{}
+}
+
+type QueryYieldRate struct {
+	// window is the lookback period of the yield rate, since genesis if not set.
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *QueryYieldRate) Reset()         { *m = QueryYieldRate{} }
+func (m *QueryYieldRate) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldRate) ProtoMessage()    {}
+func (*QueryYieldRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{58}
+}
+func (m *QueryYieldRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldRate.Merge(m, src)
+}
+func (m *QueryYieldRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldRate proto.InternalMessageInfo
+
+func (m *QueryYieldRate) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type QueryYieldRateResponse struct {
+	Start IndexRecord `protobuf:"bytes,1,opt,name=start,proto3" json:"start"`
+	End   IndexRecord `protobuf:"bytes,2,opt,name=end,proto3" json:"end"`
+	// apr is the realized yield over the window, annualized without compounding.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// apy is the realized yield over the window, annualized with continuous compounding.
+	Apy cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=apy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apy"`
+}
+
+func (m *QueryYieldRateResponse) Reset()         { *m = QueryYieldRateResponse{} }
+func (m *QueryYieldRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldRateResponse) ProtoMessage()    {}
+func (*QueryYieldRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ad0ac76919569d, []int{59}
+}
+func (m *QueryYieldRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldRateResponse.Merge(m, src)
+}
+func (m *QueryYieldRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldRateResponse proto.InternalMessageInfo
+
+func (m *QueryYieldRateResponse) GetStart() IndexRecord {
+	if m != nil {
+		return m.Start
+	}
+	return IndexRecord{}
+}
+
+func (m *QueryYieldRateResponse) GetEnd() IndexRecord {
+	if m != nil {
+		return m.End
+	}
+	return IndexRecord{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPaused)(nil), "noble.dollar.v2.QueryPaused")
+	proto.RegisterType((*QueryPausedResponse)(nil), "noble.dollar.v2.QueryPausedResponse")
+	proto.RegisterType((*QueryPauses)(nil), "noble.dollar.v2.QueryPauses")
+	proto.RegisterType((*QueryPausesResponse)(nil), "noble.dollar.v2.QueryPausesResponse")
+	proto.RegisterType((*QueryStats)(nil), "noble.dollar.v2.QueryStats")
+	proto.RegisterType((*QueryStatsResponse)(nil), "noble.dollar.v2.QueryStatsResponse")
+	proto.RegisterMapType((map[string]QueryStatsResponse_ExternalYield)(nil), "noble.dollar.v2.QueryStatsResponse.TotalExternalYieldEntry")
+	proto.RegisterType((*QueryStatsResponse_ExternalYield)(nil), "noble.dollar.v2.QueryStatsResponse.ExternalYield")
+	proto.RegisterType((*QueryYieldRecipients)(nil), "noble.dollar.v2.QueryYieldRecipients")
+	proto.RegisterType((*QueryYieldRecipientsResponse)(nil), "noble.dollar.v2.QueryYieldRecipientsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.QueryYieldRecipientsResponse.YieldRecipientsEntry")
+	proto.RegisterType((*QueryYieldRecipient)(nil), "noble.dollar.v2.QueryYieldRecipient")
+	proto.RegisterType((*QueryYieldRecipientResponse)(nil), "noble.dollar.v2.QueryYieldRecipientResponse")
+	proto.RegisterType((*QueryRetryAmounts)(nil), "noble.dollar.v2.QueryRetryAmounts")
+	proto.RegisterType((*QueryRetryAmountsResponse)(nil), "noble.dollar.v2.QueryRetryAmountsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "noble.dollar.v2.QueryRetryAmountsResponse.RetryAmountsEntry")
+	proto.RegisterType((*QueryRetryAmount)(nil), "noble.dollar.v2.QueryRetryAmount")
+	proto.RegisterType((*QueryRetryAmountResponse)(nil), "noble.dollar.v2.QueryRetryAmountResponse")
+	proto.RegisterType((*QueryYieldClaimRecipient)(nil), "noble.dollar.v2.QueryYieldClaimRecipient")
+	proto.RegisterType((*QueryYieldClaimRecipientResponse)(nil), "noble.dollar.v2.QueryYieldClaimRecipientResponse")
+	proto.RegisterType((*QueryYieldSplit)(nil), "noble.dollar.v2.QueryYieldSplit")
+	proto.RegisterType((*QueryYieldSplitResponse)(nil), "noble.dollar.v2.QueryYieldSplitResponse")
+	proto.RegisterType((*QueryAutoClaim)(nil), "noble.dollar.v2.QueryAutoClaim")
+	proto.RegisterType((*QueryAutoClaimResponse)(nil), "noble.dollar.v2.QueryAutoClaimResponse")
+	proto.RegisterType((*QueryNonEarningAccounts)(nil), "noble.dollar.v2.QueryNonEarningAccounts")
+	proto.RegisterType((*QueryNonEarningAccountsResponse)(nil), "noble.dollar.v2.QueryNonEarningAccountsResponse")
+	proto.RegisterType((*QueryFrozenAccounts)(nil), "noble.dollar.v2.QueryFrozenAccounts")
+	proto.RegisterType((*QueryFrozenAccountsResponse)(nil), "noble.dollar.v2.QueryFrozenAccountsResponse")
+	proto.RegisterType((*QueryRoles)(nil), "noble.dollar.v2.QueryRoles")
+	proto.RegisterType((*QueryRolesResponse)(nil), "noble.dollar.v2.QueryRolesResponse")
+	proto.RegisterType((*QueryAccountRoles)(nil), "noble.dollar.v2.QueryAccountRoles")
+	proto.RegisterType((*QueryAccountRolesResponse)(nil), "noble.dollar.v2.QueryAccountRolesResponse")
+	proto.RegisterType((*QueryTimelockDelay)(nil), "noble.dollar.v2.QueryTimelockDelay")
+	proto.RegisterType((*QueryTimelockDelayResponse)(nil), "noble.dollar.v2.QueryTimelockDelayResponse")
+	proto.RegisterType((*QueryTimelockedMessages)(nil), "noble.dollar.v2.QueryTimelockedMessages")
+	proto.RegisterType((*QueryTimelockedMessagesResponse)(nil), "noble.dollar.v2.QueryTimelockedMessagesResponse")
+	proto.RegisterType((*QueryIndexCircuitBreaker)(nil), "noble.dollar.v2.QueryIndexCircuitBreaker")
+	proto.RegisterType((*QueryIndexCircuitBreakerResponse)(nil), "noble.dollar.v2.QueryIndexCircuitBreakerResponse")
+	proto.RegisterType((*QueryIndexStatus)(nil), "noble.dollar.v2.QueryIndexStatus")
+	proto.RegisterType((*QueryIndexStatusResponse)(nil), "noble.dollar.v2.QueryIndexStatusResponse")
+	proto.RegisterType((*QueryHaircut)(nil), "noble.dollar.v2.QueryHaircut")
+	proto.RegisterType((*QueryHaircutResponse)(nil), "noble.dollar.v2.QueryHaircutResponse")
+	proto.RegisterType((*QueryEffectiveBalance)(nil), "noble.dollar.v2.QueryEffectiveBalance")
+	proto.RegisterType((*QueryEffectiveBalanceResponse)(nil), "noble.dollar.v2.QueryEffectiveBalanceResponse")
+	proto.RegisterType((*QueryTreasury)(nil), "noble.dollar.v2.QueryTreasury")
+	proto.RegisterType((*QueryTreasuryResponse)(nil), "noble.dollar.v2.QueryTreasuryResponse")
+	proto.RegisterType((*QueryClaimTip)(nil), "noble.dollar.v2.QueryClaimTip")
+	proto.RegisterType((*QueryClaimTipResponse)(nil), "noble.dollar.v2.QueryClaimTipResponse")
+	proto.RegisterType((*QueryFee)(nil), "noble.dollar.v2.QueryFee")
+	proto.RegisterType((*QueryFeeResponse)(nil), "noble.dollar.v2.QueryFeeResponse")
+	proto.RegisterType((*QueryInvariants)(nil), "noble.dollar.v2.QueryInvariants")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "noble.dollar.v2.QueryInvariantsResponse")
+	proto.RegisterType((*QueryYieldLiability)(nil), "noble.dollar.v2.QueryYieldLiability")
+	proto.RegisterType((*QueryYieldLiabilityResponse)(nil), "noble.dollar.v2.QueryYieldLiabilityResponse")
+	proto.RegisterType((*QueryIndexAt)(nil), "noble.dollar.v2.QueryIndexAt")
+	proto.RegisterType((*QueryIndexAtResponse)(nil), "noble.dollar.v2.QueryIndexAtResponse")
+	proto.RegisterType((*QueryIndexHistory)(nil), "noble.dollar.v2.QueryIndexHistory")
+	proto.RegisterType((*QueryIndexHistoryResponse)(nil), "noble.dollar.v2.QueryIndexHistoryResponse")
+	proto.RegisterType((*QueryYieldDistributions)(nil), "noble.dollar.v2.QueryYieldDistributions")
+	proto.RegisterType((*QueryYieldDistributionsResponse)(nil), "noble.dollar.v2.QueryYieldDistributionsResponse")
+	proto.RegisterType((*QueryCurrentIndex)(nil), "noble.dollar.v2.QueryCurrentIndex")
+	proto.RegisterType((*QueryCurrentIndexResponse)(nil), "noble.dollar.v2.QueryCurrentIndexResponse")
+	proto.RegisterType((*QueryYieldRate)(nil), "noble.dollar.v2.QueryYieldRate")
+	proto.RegisterType((*QueryYieldRateResponse)(nil), "noble.dollar.v2.QueryYieldRateResponse")
+}
+
+func init() { proto.RegisterFile("noble/dollar/v2/query.proto", fileDescriptor_13ad0ac76919569d) }
+
+var fileDescriptor_13ad0ac76919569d = []byte{
+	// 2817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0x77, 0x7b, 0xbf, 0xdf, 0x7e, 0xba, 0xbc, 0xb6, 0x67, 0x7b, 0xd7, 0xbb, 0xeb, 0xb2, 0xbd,
+	0x5e, 0xaf, 0xed, 0x19, 0xef, 0xe4, 0x03, 0xe7, 0x8b, 0xb0, 0x63, 0x7b, 0xbd, 0x46, 0x4e, 0x94,
+	0x4c, 0x8c, 0xa2, 0x20, 0x60, 0xa8, 0x9d, 0xae, 0x5d, 0xb7, 0xdc, 0xd3, 0x3d, 0xe9, 0xaa, 0xd9,
+	0xec, 0x24, 0xb2, 0x40, 0x5c, 0x00, 0x21, 0x20, 0x52, 0x0e, 0x70, 0x40, 0x8a, 0xc4, 0x97, 0x22,
+	0x71, 0x41, 0x88, 0x13, 0x17, 0x2e, 0x1c, 0x22, 0x4e, 0x81, 0x5c, 0x10, 0x87, 0x00, 0x09, 0x52,
+	0xfe, 0x04, 0x38, 0xa2, 0xae, 0xaa, 0xae, 0xee, 0x9e, 0x9e, 0x9e, 0xe9, 0x9d, 0xc0, 0x85, 0x8b,
+	0x35, 0xfd, 0xde, 0xef, 0xbd, 0xf7, 0xab, 0x57, 0xd5, 0xaf, 0x5e, 0xbf, 0x35, 0x2c, 0xba, 0xde,
+	0xae, 0x43, 0x4b, 0x96, 0xe7, 0x38, 0xc4, 0x2f, 0x1d, 0x94, 0x4b, 0xaf, 0xb7, 0xa8, 0xdf, 0x2e,
+	0x36, 0x7d, 0x8f, 0x7b, 0x68, 0x56, 0x28, 0x8b, 0x52, 0x59, 0x3c, 0x28, 0x9b, 0x27, 0x48, 0xc3,
+	0x76, 0xbd, 0x92, 0xf8, 0x57, 0x62, 0xcc, 0x8d, 0xba, 0xc7, 0x1a, 0x1e, 0x2b, 0xed, 0x12, 0x46,
+	0xa5, 0x71, 0xe9, 0x60, 0x73, 0x97, 0x72, 0xb2, 0x59, 0x6a, 0x92, 0x7d, 0xdb, 0x25, 0xdc, 0xf6,
+	0x5c, 0x85, 0x5d, 0x54, 0xd8, 0x10, 0x16, 0x0f, 0x66, 0x2e, 0x48, 0x65, 0x4d, 0x3c, 0x95, 0xe4,
+	0x83, 0x52, 0xcd, 0xef, 0x7b, 0xfb, 0x9e, 0x94, 0x07, 0xbf, 0x94, 0x74, 0x69, 0xdf, 0xf3, 0xf6,
+	0x1d, 0x5a, 0x22, 0x4d, 0xbb, 0x44, 0x5c, 0xd7, 0xe3, 0x22, 0x54, 0x68, 0xb3, 0xac, 0xb4, 0xe2,
+	0x69, 0xb7, 0xb5, 0x57, 0xb2, 0x5a, 0x7e, 0x9c, 0xcb, 0x4a, 0xa7, 0x9e, 0xdb, 0x0d, 0xca, 0x38,
+	0x69, 0x34, 0x43, 0xf7, 0x9d, 0x99, 0x91, 0xbf, 0xa4, 0x16, 0x4f, 0xc3, 0xe4, 0xcb, 0x01, 0xf9,
+	0x97, 0x48, 0x8b, 0x51, 0x0b, 0xbf, 0x0c, 0x27, 0x63, 0x8f, 0x55, 0xca, 0x9a, 0x9e, 0xcb, 0x28,
+	0x7a, 0x1a, 0x46, 0x9b, 0x42, 0x52, 0x30, 0x56, 0x8d, 0xf5, 0x99, 0xf2, 0x62, 0xb1, 0x23, 0xa3,
+	0x45, 0x69, 0x70, 0xbf, 0xdd, 0xa4, 0x95, 0x91, 0xf7, 0x3e, 0xfd, 0xf5, 0x86, 0x51, 0x55, 0x16,
+	0xc9, 0x08, 0x2c, 0x19, 0x81, 0xa5, 0x22, 0xb0, 0x82, 0xb1, 0x3a, 0xb4, 0x3e, 0x59, 0x5e, 0x4a,
+	0x45, 0xd8, 0xaa, 0x73, 0xfb, 0x80, 0x0a, 0xb3, 0xca, 0xf0, 0xfb, 0x1f, 0xad, 0x1c, 0x53, 0x11,
+	0x18, 0x9e, 0x02, 0x10, 0x2e, 0x5f, 0xe1, 0x84, 0x33, 0xfc, 0xcb, 0x51, 0x40, 0xd1, 0xa3, 0x0e,
+	0xb0, 0x01, 0xd3, 0xdc, 0xe3, 0xc4, 0xa9, 0x3d, 0xf0, 0x1c, 0x8b, 0xfa, 0x4c, 0xac, 0x64, 0x38,
+	0x24, 0x3b, 0x25, 0x74, 0x3b, 0x52, 0x85, 0x5e, 0x83, 0x59, 0x89, 0x6d, 0xfa, 0xb6, 0x5b, 0xb7,
+	0x9b, 0xc4, 0x29, 0x1c, 0x5f, 0x35, 0xd6, 0x27, 0x2a, 0xd7, 0x83, 0xb8, 0x7f, 0xfd, 0x68, 0xe5,
+	0x94, 0xdc, 0x56, 0x66, 0x3d, 0x2c, 0xda, 0x5e, 0xa9, 0x41, 0xf8, 0x83, 0xe2, 0x5d, 0x97, 0xff,
+	0xf9, 0xb7, 0xd7, 0x40, 0xed, 0xf7, 0x5d, 0x97, 0x4b, 0xc7, 0x33, 0xc2, 0xd1, 0x4b, 0xa1, 0x1f,
+	0xf4, 0x75, 0x38, 0x29, 0x5d, 0xb7, 0x6d, 0xea, 0x58, 0x35, 0x52, 0xaf, 0xfb, 0x2d, 0x6a, 0x15,
+	0x86, 0x06, 0x74, 0x7f, 0x42, 0x38, 0x7b, 0x2d, 0xf0, 0xb5, 0x25, 0x5d, 0x21, 0x06, 0xf3, 0x32,
+	0x02, 0x3d, 0xe4, 0xd4, 0x77, 0xc3, 0x50, 0x85, 0x61, 0x91, 0xd7, 0x67, 0x52, 0x79, 0x4d, 0xe7,
+	0xaa, 0x78, 0x3f, 0xb0, 0xbf, 0xad, 0xcc, 0x85, 0xf3, 0xdb, 0x2e, 0xf7, 0xdb, 0x2a, 0xed, 0x88,
+	0xa7, 0xd4, 0xe8, 0x6b, 0x20, 0xa5, 0xb5, 0x3d, 0x4a, 0x99, 0x5e, 0xd5, 0xc8, 0x80, 0xab, 0x9a,
+	0x13, 0xbe, 0xb6, 0x29, 0x65, 0xe1, 0xa2, 0x76, 0xc3, 0x45, 0x3d, 0x20, 0xb6, 0x5f, 0x6f, 0xf1,
+	0xda, 0x6e, 0xcb, 0x77, 0xa9, 0x55, 0x18, 0x1d, 0x30, 0x82, 0x64, 0xbb, 0x23, 0x9d, 0x55, 0x84,
+	0x2f, 0x93, 0xc3, 0x74, 0x72, 0x51, 0x0b, 0x30, 0x5e, 0x7f, 0x40, 0x6c, 0xb7, 0x66, 0xcb, 0x73,
+	0x3f, 0x51, 0x1d, 0x13, 0xcf, 0x77, 0x2d, 0xb4, 0x03, 0xa3, 0xa4, 0xe1, 0xb5, 0x5c, 0x3e, 0xf0,
+	0xc1, 0x50, 0xf6, 0xe6, 0x21, 0x9c, 0xc9, 0x48, 0x37, 0x9a, 0x83, 0xa1, 0x87, 0xb4, 0xad, 0x42,
+	0x07, 0x3f, 0xd1, 0x1d, 0x18, 0x39, 0x20, 0x4e, 0x8b, 0x8a, 0xa8, 0x93, 0xe5, 0xcd, 0x3c, 0x9b,
+	0x99, 0x70, 0x5c, 0x95, 0xf6, 0x4f, 0x1f, 0xbf, 0x61, 0xe0, 0xd3, 0x30, 0x2f, 0xe0, 0x52, 0x41,
+	0xeb, 0x76, 0xd3, 0xa6, 0x2e, 0x67, 0xf8, 0x4f, 0x06, 0x2c, 0x75, 0x53, 0xe8, 0x57, 0xa9, 0x01,
+	0x73, 0xf2, 0xf4, 0xfa, 0x5a, 0xa7, 0xde, 0xda, 0x4a, 0x77, 0x42, 0x19, 0x8e, 0x8a, 0x1d, 0x72,
+	0xb1, 0xea, 0xea, 0x6c, 0x3b, 0x29, 0x35, 0x2b, 0x30, 0xdf, 0x0d, 0xd8, 0x25, 0x3d, 0xf3, 0xf1,
+	0xf4, 0x4c, 0xc4, 0xd7, 0xea, 0xa8, 0xaa, 0x93, 0x74, 0x84, 0x9e, 0x80, 0xf1, 0xa6, 0xef, 0x1d,
+	0xd8, 0x16, 0xf5, 0x55, 0x65, 0x5b, 0x48, 0x57, 0x36, 0x05, 0xa8, 0x6a, 0x28, 0x5a, 0x06, 0xb0,
+	0x2d, 0xea, 0x72, 0x7b, 0xcf, 0xa6, 0xbe, 0x0a, 0x16, 0x93, 0xe0, 0x6d, 0x58, 0xec, 0x12, 0x4d,
+	0xe7, 0xef, 0x12, 0xcc, 0x76, 0xe4, 0x4f, 0x2d, 0x62, 0x26, 0xb9, 0x74, 0x7c, 0x12, 0x4e, 0x08,
+	0x3f, 0x55, 0xca, 0xfd, 0xf6, 0x96, 0x38, 0x2f, 0x0c, 0xff, 0xde, 0x80, 0x85, 0x94, 0x54, 0xfb,
+	0x26, 0x30, 0xed, 0x07, 0xf2, 0x9a, 0x3c, 0x5e, 0xe1, 0xc6, 0x3c, 0xdb, 0x7d, 0x63, 0xba, 0xb9,
+	0x28, 0xc6, 0x85, 0x72, 0x4b, 0xa6, 0xfc, 0x98, 0xc8, 0x7c, 0x1e, 0x4e, 0xa4, 0x20, 0x47, 0xda,
+	0x0c, 0x1b, 0xe6, 0x3a, 0xa3, 0xff, 0xaf, 0x76, 0xc2, 0x83, 0x42, 0x67, 0x28, 0x9d, 0xaa, 0x57,
+	0x60, 0x2a, 0x9e, 0xaa, 0x82, 0x31, 0xe0, 0x9b, 0x3c, 0x19, 0xcb, 0x0e, 0x7e, 0x1c, 0x0a, 0xd1,
+	0xd6, 0xdf, 0x74, 0x88, 0xdd, 0x88, 0x4e, 0x5b, 0x01, 0xc6, 0x48, 0xbd, 0x1e, 0xc5, 0xaa, 0x86,
+	0x8f, 0xf8, 0x0b, 0xb0, 0x9a, 0x65, 0xa5, 0xe9, 0x2e, 0xc1, 0x44, 0xe7, 0x79, 0x89, 0x04, 0xf8,
+	0x0a, 0xcc, 0x46, 0x1e, 0x5e, 0x69, 0x3a, 0x76, 0xaf, 0x70, 0xaf, 0xc1, 0x99, 0x0e, 0xb0, 0x8e,
+	0xf2, 0x79, 0x18, 0x65, 0x0f, 0x88, 0xaf, 0xef, 0xe1, 0xf4, 0x4d, 0x2f, 0x8d, 0x02, 0x4c, 0x65,
+	0x22, 0xc8, 0x95, 0x2a, 0x67, 0xd2, 0x0a, 0x6f, 0xc0, 0x8c, 0x70, 0xbd, 0xd5, 0xe2, 0x9e, 0x58,
+	0x48, 0x0f, 0x1a, 0x65, 0x38, 0x9d, 0xc4, 0x6a, 0x16, 0x05, 0x18, 0xa3, 0x2e, 0xd9, 0x75, 0x54,
+	0xc3, 0x31, 0x5e, 0x0d, 0x1f, 0xf1, 0x82, 0xa2, 0xfe, 0xa2, 0xe7, 0xde, 0x26, 0xbe, 0x6b, 0xbb,
+	0xfb, 0x5b, 0xd2, 0x1b, 0xc3, 0xcf, 0xc1, 0x4a, 0x86, 0x4a, 0xfb, 0x35, 0x61, 0x5c, 0x05, 0x97,
+	0xeb, 0x9b, 0xa8, 0xea, 0x67, 0x7c, 0x4a, 0x95, 0x88, 0x6d, 0xdf, 0x7b, 0x93, 0xba, 0xda, 0xeb,
+	0x53, 0xb0, 0xd8, 0x45, 0x9c, 0xcb, 0x63, 0xd8, 0x97, 0x54, 0x3d, 0x87, 0x32, 0x7c, 0x0f, 0x50,
+	0xf4, 0xa4, 0xed, 0x9f, 0x84, 0x11, 0x3f, 0x10, 0xa8, 0x74, 0x9b, 0xa9, 0x74, 0x07, 0xf0, 0x3b,
+	0x3e, 0x71, 0xb9, 0xba, 0x7d, 0x25, 0x1c, 0x5f, 0x53, 0xa5, 0x41, 0x11, 0x12, 0x4e, 0x7b, 0xa4,
+	0x7a, 0x07, 0x16, 0x52, 0x70, 0xcd, 0xe1, 0x4a, 0x9c, 0xc3, 0x4c, 0xf9, 0x54, 0x57, 0x0e, 0x61,
+	0xe0, 0x79, 0xb5, 0x8c, 0xfb, 0x76, 0x83, 0x3a, 0x5e, 0xfd, 0xe1, 0x2d, 0xea, 0x90, 0x36, 0x7e,
+	0x15, 0xcc, 0xb4, 0x54, 0x07, 0x78, 0x0a, 0x46, 0xac, 0x40, 0x20, 0x58, 0x4d, 0x96, 0x17, 0x8a,
+	0xb2, 0x67, 0x2d, 0x86, 0x3d, 0x6b, 0xf1, 0x96, 0xea, 0x69, 0x2b, 0xe3, 0xc1, 0x1a, 0x7f, 0xfc,
+	0xb7, 0x15, 0xa3, 0x2a, 0x2d, 0xf4, 0x7e, 0x87, 0x8e, 0xa9, 0xf5, 0x02, 0x65, 0x8c, 0xec, 0x53,
+	0x86, 0xf7, 0x61, 0x25, 0x43, 0xa5, 0x03, 0xdf, 0x82, 0xf1, 0x86, 0x92, 0xa9, 0x04, 0xe3, 0xd4,
+	0xe2, 0x52, 0xe6, 0x2a, 0xd1, 0xda, 0x12, 0x9b, 0xea, 0x9d, 0xbe, 0xeb, 0x5a, 0xf4, 0xf0, 0x66,
+	0xd0, 0x32, 0xd8, 0xbc, 0xe2, 0x53, 0xf2, 0x90, 0xfa, 0xf8, 0x0f, 0x06, 0xac, 0x66, 0x29, 0x35,
+	0x8d, 0xaf, 0xc0, 0x4c, 0x83, 0x1c, 0xaa, 0x96, 0xcf, 0x27, 0x9c, 0xaa, 0x5a, 0xf3, 0xa4, 0xaa,
+	0x35, 0x8b, 0xe9, 0x5a, 0x73, 0x8f, 0xee, 0x93, 0x7a, 0xfb, 0x16, 0xad, 0xc7, 0x2a, 0xce, 0x2d,
+	0x5a, 0x57, 0xdd, 0x6a, 0x83, 0x1c, 0xca, 0xbb, 0x85, 0x70, 0x8a, 0x2a, 0x30, 0xdd, 0xa4, 0xae,
+	0x65, 0xbb, 0xfb, 0x35, 0x3b, 0x20, 0xa1, 0x9a, 0x83, 0xb3, 0xe9, 0xfa, 0x29, 0x51, 0x82, 0x69,
+	0x75, 0xaa, 0x19, 0x7b, 0xc2, 0x48, 0x95, 0x64, 0xf1, 0x14, 0xf4, 0x0f, 0x2d, 0x86, 0xff, 0x65,
+	0x40, 0xa1, 0x53, 0xa8, 0x97, 0xb4, 0x05, 0x93, 0x0e, 0x61, 0xbc, 0xd6, 0x6a, 0x5a, 0xe1, 0x7a,
+	0x82, 0xd3, 0xdb, 0xb9, 0xb1, 0xf7, 0xc3, 0x8f, 0x91, 0xca, 0xf0, 0xdb, 0xc1, 0xae, 0x42, 0x60,
+	0xf4, 0x25, 0x61, 0x83, 0x9e, 0x83, 0x31, 0xea, 0x90, 0x66, 0xf0, 0x55, 0x71, 0x3c, 0xff, 0xb9,
+	0x08, 0x6d, 0xd0, 0x4d, 0x98, 0x60, 0x9c, 0x38, 0xd4, 0xa5, 0x8c, 0x89, 0xfe, 0x79, 0xb2, 0xbc,
+	0x92, 0x5a, 0x72, 0x48, 0x5d, 0xc2, 0xd4, 0xce, 0x46, 0x76, 0xc1, 0x25, 0x25, 0x1e, 0x0a, 0xc3,
+	0xa2, 0xcc, 0xc8, 0x07, 0x3c, 0x03, 0x53, 0x62, 0xe1, 0xaa, 0x3f, 0xc4, 0xdf, 0x34, 0x60, 0x3e,
+	0x2e, 0xe8, 0x5f, 0xa7, 0xd0, 0x8b, 0x30, 0xba, 0x47, 0xea, 0xdc, 0x53, 0x97, 0xd2, 0xc0, 0x5b,
+	0xad, 0xbc, 0xe0, 0x4d, 0x38, 0x25, 0x18, 0xdc, 0xde, 0xdb, 0xa3, 0xe2, 0x43, 0xa8, 0x42, 0x1c,
+	0xe2, 0xd6, 0x69, 0x8f, 0x77, 0xfe, 0x8f, 0x06, 0x9c, 0xed, 0x6a, 0xa3, 0xe9, 0x7f, 0x11, 0xc6,
+	0x76, 0xa5, 0x68, 0xe0, 0xcb, 0x2f, 0x74, 0x80, 0xbe, 0x0a, 0x27, 0x68, 0x18, 0xa7, 0x16, 0x7a,
+	0x1d, 0xb4, 0x39, 0x9e, 0xa3, 0x1d, 0x94, 0xf1, 0x2c, 0x4c, 0xcb, 0x97, 0xdd, 0xa7, 0x84, 0xb5,
+	0xfc, 0x36, 0x7e, 0x0c, 0x4e, 0x25, 0x04, 0xf1, 0x8a, 0xcc, 0x95, 0x4c, 0x65, 0x44, 0x3f, 0x6b,
+	0x2f, 0xe2, 0xb6, 0xb9, 0x6f, 0x37, 0xf1, 0x65, 0x38, 0x95, 0x10, 0x68, 0x2f, 0x73, 0x30, 0xc4,
+	0xed, 0xa6, 0x70, 0x30, 0x5d, 0x0d, 0x7e, 0x62, 0x80, 0x71, 0x79, 0x11, 0x50, 0x8a, 0x2b, 0xea,
+	0x75, 0xd9, 0xa6, 0x34, 0x6e, 0xb1, 0x47, 0x69, 0x68, 0xb1, 0x47, 0xc5, 0x8d, 0x5d, 0xf7, 0x1c,
+	0x87, 0x46, 0xc7, 0xa0, 0x1a, 0x09, 0xf0, 0x09, 0x75, 0x63, 0xdf, 0x75, 0x0f, 0x88, 0x6f, 0x93,
+	0xe0, 0xc2, 0x20, 0x70, 0xa6, 0x43, 0xa4, 0xbd, 0x6f, 0x03, 0xd8, 0x5a, 0xaa, 0x6a, 0xd9, 0x6a,
+	0x97, 0xe3, 0xae, 0x20, 0x55, 0xca, 0x5a, 0x4e, 0x78, 0x65, 0xc4, 0x2c, 0xf5, 0x2d, 0x27, 0xca,
+	0xc7, 0x3d, 0x9b, 0xec, 0xda, 0x8e, 0xcd, 0xdb, 0xf8, 0xc3, 0x21, 0x58, 0xec, 0x22, 0xd7, 0xe1,
+	0x5f, 0x84, 0x09, 0x27, 0x14, 0x0e, 0x7c, 0x56, 0x22, 0x17, 0xc1, 0xc9, 0xf3, 0x29, 0xa3, 0xfe,
+	0xc1, 0xe0, 0x67, 0x24, 0x74, 0x10, 0xf8, 0x62, 0x2d, 0xbf, 0xe9, 0xb4, 0xd8, 0xc0, 0x9f, 0xd1,
+	0xa1, 0x03, 0x74, 0x0f, 0xc6, 0x7d, 0xaf, 0x25, 0x0a, 0x63, 0x61, 0x78, 0x40, 0x67, 0xda, 0x43,
+	0xa2, 0x39, 0x08, 0xbe, 0x85, 0x87, 0xa2, 0xe6, 0x20, 0x78, 0x6f, 0x99, 0xe7, 0x1c, 0x04, 0xcd,
+	0xdc, 0xa8, 0x2c, 0x1d, 0xea, 0x31, 0xf8, 0xb6, 0xa4, 0x87, 0xf5, 0xa0, 0xaa, 0x8d, 0x0d, 0xfa,
+	0x6d, 0x29, 0xed, 0xf1, 0x8e, 0xaa, 0x63, 0xa2, 0x0a, 0x6e, 0x71, 0x74, 0x03, 0x86, 0x83, 0xe9,
+	0x50, 0x8e, 0x6a, 0x2d, 0xea, 0xad, 0xa8, 0xd8, 0xc2, 0x02, 0x57, 0x61, 0x3e, 0xee, 0x29, 0x3e,
+	0xb6, 0xf1, 0x69, 0xdd, 0xf3, 0x2d, 0xe5, 0x73, 0xa9, 0x7b, 0x05, 0xae, 0x0a, 0x4c, 0x38, 0xb6,
+	0x91, 0x16, 0xf8, 0x1f, 0x86, 0xea, 0x61, 0x04, 0x64, 0xc7, 0x66, 0xdc, 0xf3, 0xdb, 0xe8, 0x26,
+	0x00, 0xe3, 0xc4, 0xe7, 0xb5, 0x23, 0x33, 0x9d, 0x10, 0x76, 0x81, 0x06, 0x3d, 0x0f, 0xe3, 0xd4,
+	0xb5, 0xa4, 0x8b, 0xe3, 0x47, 0x70, 0x31, 0x46, 0x5d, 0x4b, 0x38, 0xd8, 0x06, 0x88, 0xa6, 0x7e,
+	0xea, 0x76, 0x59, 0x2b, 0xaa, 0x3c, 0x07, 0x23, 0xc2, 0xa2, 0x1c, 0xf9, 0xa9, 0x11, 0x61, 0xf1,
+	0x25, 0xb2, 0x4f, 0xab, 0xf4, 0xf5, 0x16, 0x65, 0xbc, 0x1a, 0xb3, 0xc4, 0x3f, 0x0d, 0x3f, 0xd6,
+	0xe2, 0x6b, 0xd4, 0xd9, 0x7b, 0x16, 0xc6, 0x1e, 0x48, 0x51, 0xe6, 0xd4, 0x2b, 0x9d, 0xbe, 0xd0,
+	0x04, 0xdd, 0x49, 0x70, 0x94, 0xcb, 0xbc, 0xd4, 0x97, 0xa3, 0x0c, 0x9d, 0x20, 0xf9, 0xa9, 0x11,
+	0xff, 0x1e, 0xb8, 0x65, 0x33, 0xee, 0xdb, 0xbb, 0xad, 0x40, 0xc5, 0xfe, 0xcf, 0xb6, 0xe3, 0x77,
+	0x06, 0xac, 0x64, 0xac, 0x34, 0x56, 0xea, 0xa6, 0xad, 0xb8, 0x22, 0xb3, 0x71, 0x4c, 0xf9, 0x50,
+	0x1b, 0x94, 0x34, 0xff, 0xef, 0x6d, 0x53, 0x38, 0x0d, 0xb8, 0xd9, 0xf2, 0x7d, 0xea, 0x72, 0xd9,
+	0xb8, 0xfd, 0x3b, 0x3c, 0x60, 0x71, 0xa9, 0x5e, 0xcb, 0x3c, 0x8c, 0xc8, 0x96, 0xd0, 0x10, 0xd5,
+	0x47, 0x3e, 0xa0, 0xf3, 0x30, 0x5d, 0x97, 0xe8, 0x58, 0xc3, 0x38, 0x54, 0x9d, 0xaa, 0xc7, 0x5c,
+	0xa0, 0x57, 0x61, 0x92, 0x12, 0xdf, 0xa5, 0xbe, 0x6c, 0x58, 0x87, 0x3e, 0x53, 0x17, 0x03, 0xd2,
+	0x95, 0x68, 0x57, 0xef, 0xc0, 0x54, 0xac, 0x73, 0xb4, 0x0a, 0xc3, 0x47, 0x38, 0x10, 0x93, 0x51,
+	0xfb, 0x68, 0xe1, 0x17, 0xd4, 0xa7, 0x66, 0xd4, 0x09, 0x3f, 0x03, 0xa3, 0x6f, 0xd8, 0xae, 0xe5,
+	0xbd, 0x71, 0x94, 0x0f, 0x0d, 0x65, 0x82, 0x7f, 0x72, 0x1c, 0x4e, 0x27, 0xfd, 0xe9, 0x34, 0xde,
+	0x10, 0x5d, 0xa2, 0xcf, 0x8f, 0x50, 0xe4, 0xa4, 0x01, 0x7a, 0x1c, 0x86, 0xa8, 0x1b, 0xf6, 0xb7,
+	0x79, 0xec, 0x02, 0x38, 0xda, 0x81, 0x21, 0xd2, 0xf4, 0x3f, 0x63, 0xce, 0x03, 0x17, 0xd2, 0x53,
+	0xbb, 0x30, 0xfc, 0x59, 0x3d, 0xb5, 0xcb, 0xef, 0x9e, 0x83, 0x11, 0x91, 0x1e, 0xe4, 0xc3, 0xa8,
+	0x9c, 0xf6, 0xa3, 0xa5, 0xee, 0x53, 0x25, 0xa9, 0x35, 0x2f, 0xf4, 0xd2, 0x86, 0xb9, 0xc5, 0x17,
+	0xbe, 0x13, 0xc4, 0xf8, 0xd6, 0x87, 0xff, 0x7c, 0xe7, 0xf8, 0x02, 0x3a, 0x53, 0xea, 0xfc, 0x63,
+	0x85, 0xfc, 0x23, 0x82, 0x8e, 0xc9, 0x7a, 0xc6, 0x64, 0x3d, 0x63, 0xb2, 0x23, 0xc5, 0x64, 0xc8,
+	0x85, 0x11, 0x31, 0x49, 0x45, 0x8b, 0x3d, 0xc6, 0xac, 0xe6, 0xf9, 0x1c, 0x33, 0x58, 0x7c, 0x3e,
+	0x0a, 0x58, 0x40, 0xa7, 0x53, 0x01, 0x99, 0x08, 0xf3, 0x23, 0x03, 0x66, 0x3b, 0x06, 0x9d, 0xe8,
+	0x62, 0xae, 0x81, 0xaa, 0x79, 0xed, 0x48, 0x73, 0x57, 0x5c, 0x8c, 0xe8, 0x9c, 0x47, 0xe7, 0x52,
+	0x74, 0x3a, 0x87, 0xbb, 0xe8, 0x57, 0x06, 0xcc, 0x24, 0x7d, 0xa1, 0x0b, 0x79, 0x22, 0x9a, 0x57,
+	0xf3, 0xa0, 0x34, 0xad, 0xdb, 0x11, 0xad, 0xa7, 0xd1, 0x8d, 0x7e, 0xb4, 0x4a, 0x6f, 0x85, 0x53,
+	0xc0, 0x47, 0xa5, 0xb7, 0xa2, 0x91, 0xdf, 0x23, 0xf4, 0x3d, 0x03, 0xa6, 0xe2, 0x03, 0x4a, 0x84,
+	0xfb, 0x0f, 0x3f, 0xcd, 0x8d, 0xfc, 0x03, 0x52, 0x7c, 0x25, 0xe2, 0xb9, 0x8a, 0x96, 0x53, 0x3c,
+	0x13, 0xf3, 0x57, 0xf4, 0xae, 0x01, 0x93, 0x31, 0x2f, 0xe8, 0x5c, 0xdf, 0x40, 0xe6, 0xe5, 0xbe,
+	0x10, 0x4d, 0xa5, 0x12, 0x51, 0xf9, 0x1c, 0x7a, 0xa2, 0x27, 0x95, 0xcc, 0x7c, 0xfd, 0xc6, 0x80,
+	0x93, 0xdd, 0xc6, 0x95, 0x97, 0x7b, 0x6c, 0x5e, 0x12, 0x6a, 0x6e, 0xe6, 0x86, 0x6a, 0xe6, 0xcf,
+	0x45, 0xcc, 0xcb, 0xe8, 0x7a, 0xc6, 0x66, 0xd7, 0x03, 0xdb, 0xf8, 0x96, 0xab, 0xf6, 0xf9, 0x11,
+	0xfa, 0xbe, 0x01, 0x10, 0x9b, 0x75, 0xae, 0xf6, 0x20, 0x20, 0x10, 0xe6, 0x7a, 0x3f, 0x84, 0x66,
+	0xf6, 0x58, 0xc4, 0x6c, 0x1d, 0xad, 0x65, 0x30, 0x63, 0x81, 0x49, 0x8c, 0xcf, 0x77, 0x0d, 0x98,
+	0x88, 0x66, 0x9e, 0x2b, 0xdd, 0x83, 0x69, 0x80, 0x79, 0xa9, 0x0f, 0x40, 0x93, 0x29, 0x47, 0x64,
+	0x2e, 0xa1, 0x8b, 0x29, 0x32, 0xa4, 0xc5, 0x3d, 0x99, 0xa5, 0x18, 0x97, 0x9f, 0x1b, 0x80, 0xd2,
+	0x43, 0x50, 0x94, 0x91, 0x81, 0x34, 0xd2, 0xbc, 0x9e, 0x17, 0x99, 0x97, 0xa6, 0xeb, 0xb9, 0x35,
+	0x2a, 0x4d, 0x6b, 0xfa, 0x0b, 0xe8, 0x1d, 0x03, 0x66, 0x92, 0x53, 0xd5, 0xac, 0xaa, 0x92, 0x44,
+	0x99, 0x57, 0xf3, 0xa0, 0x34, 0xb5, 0x6b, 0x11, 0x35, 0x8c, 0x56, 0x53, 0xd4, 0xf6, 0x84, 0x55,
+	0xc4, 0xca, 0x85, 0x11, 0x39, 0x4c, 0xcd, 0xa8, 0xfa, 0x42, 0x69, 0x9e, 0xef, 0xa1, 0xcc, 0x5b,
+	0xf5, 0xc5, 0x3c, 0x35, 0x38, 0xc8, 0x53, 0x89, 0x21, 0x6e, 0x46, 0xb5, 0x8a, 0x63, 0xcc, 0x8d,
+	0xfe, 0x98, 0xbc, 0xeb, 0x17, 0x2c, 0x62, 0x87, 0xe7, 0x87, 0x06, 0x4c, 0x27, 0xa6, 0xb8, 0x28,
+	0x63, 0xad, 0x09, 0x90, 0x79, 0x25, 0x07, 0x48, 0x53, 0xba, 0x1a, 0x51, 0x3a, 0x87, 0x56, 0x52,
+	0x94, 0xb8, 0x32, 0xaa, 0x89, 0x11, 0x30, 0xfa, 0x99, 0x01, 0x28, 0x3d, 0xe3, 0xcd, 0x3a, 0xce,
+	0x69, 0xa4, 0x79, 0x3d, 0x2f, 0x52, 0x13, 0xdc, 0x8c, 0x08, 0xae, 0xa1, 0x0b, 0x99, 0x04, 0xa9,
+	0x55, 0x0b, 0x87, 0xc4, 0xe8, 0x3d, 0x03, 0x4e, 0x76, 0x99, 0x01, 0x67, 0x55, 0xd1, 0x2e, 0x50,
+	0x73, 0x33, 0x37, 0x34, 0x6f, 0xad, 0x12, 0xed, 0x7d, 0xad, 0x2e, 0x6d, 0x6b, 0xbb, 0x8a, 0xd2,
+	0xb7, 0x0d, 0x98, 0x8c, 0xcd, 0x74, 0xb3, 0xae, 0xa4, 0x18, 0xc4, 0xbc, 0xdc, 0x17, 0xa2, 0x29,
+	0x6d, 0x44, 0x94, 0x56, 0xd0, 0xd9, 0x0c, 0x4a, 0x4c, 0x46, 0x3e, 0x80, 0x31, 0x35, 0x52, 0x45,
+	0x67, 0xbb, 0x47, 0x50, 0x6a, 0xf3, 0x62, 0x4f, 0xb5, 0x0e, 0x7e, 0x31, 0x0a, 0x6e, 0xa2, 0x42,
+	0x2a, 0xb8, 0xfa, 0xdf, 0x03, 0xe8, 0x17, 0x06, 0xcc, 0xa5, 0x26, 0xa9, 0x6b, 0xdd, 0x43, 0x74,
+	0xe2, 0xcc, 0x62, 0x3e, 0x9c, 0xe6, 0xf4, 0x54, 0xc4, 0xa9, 0x88, 0xae, 0xa6, 0x38, 0xa5, 0xa6,
+	0xa6, 0xb1, 0x97, 0xf1, 0x4d, 0x18, 0x0f, 0xe7, 0x9b, 0x68, 0x39, 0xe3, 0x14, 0x2b, 0xbd, 0xb9,
+	0xd6, 0x5b, 0xaf, 0xe9, 0xac, 0x45, 0x74, 0x16, 0xd1, 0x42, 0xfa, 0x6c, 0x87, 0xf1, 0xde, 0x82,
+	0xf1, 0x70, 0x2a, 0x9a, 0x15, 0x3b, 0xd4, 0x9b, 0x6b, 0xbd, 0xf5, 0x3a, 0xf6, 0xa5, 0x28, 0xf6,
+	0x12, 0x32, 0x53, 0xb1, 0xe5, 0x75, 0xcf, 0xed, 0x26, 0xda, 0x83, 0xa1, 0x6d, 0x4a, 0xd1, 0x42,
+	0x46, 0xa5, 0xa7, 0xd4, 0x3c, 0x97, 0xa9, 0xd2, 0xd1, 0xce, 0x45, 0xd1, 0x4e, 0xa3, 0xf9, 0x74,
+	0xe5, 0xa7, 0x14, 0x3d, 0x02, 0x88, 0x86, 0xad, 0x59, 0x5d, 0x44, 0x84, 0x30, 0xd7, 0xfb, 0x21,
+	0xa2, 0xe2, 0x2f, 0xe2, 0x9e, 0x45, 0x8b, 0x5d, 0xde, 0x00, 0x1d, 0xf0, 0x07, 0x61, 0x63, 0xad,
+	0x27, 0xae, 0x3d, 0x1b, 0x6b, 0x8d, 0x32, 0xaf, 0xe6, 0x41, 0x69, 0x2e, 0xeb, 0x99, 0xd5, 0x5f,
+	0x36, 0x33, 0xd1, 0x5c, 0xf6, 0x0d, 0x18, 0x0b, 0x87, 0x85, 0x67, 0x7b, 0xbc, 0xf2, 0x5b, 0x99,
+	0x2f, 0x64, 0xc7, 0x80, 0xb0, 0xdf, 0x69, 0x13, 0xd5, 0xa0, 0x44, 0xb8, 0x68, 0xda, 0x13, 0x73,
+	0x40, 0xdc, 0xc3, 0xbf, 0xc2, 0x98, 0x1b, 0xfd, 0x31, 0x79, 0x9b, 0x76, 0x49, 0x24, 0x1c, 0xad,
+	0x05, 0x57, 0x4e, 0x97, 0x61, 0x58, 0xaf, 0x1e, 0x32, 0x81, 0x34, 0xaf, 0xe7, 0x45, 0xe6, 0xbd,
+	0x72, 0xe4, 0x46, 0x25, 0x27, 0x4b, 0x41, 0xce, 0xe2, 0x63, 0x9f, 0xac, 0x9c, 0xc5, 0x31, 0xe6,
+	0x46, 0x7f, 0xcc, 0xd1, 0x72, 0xa6, 0xa6, 0x46, 0xe8, 0x1b, 0x30, 0x11, 0x4d, 0x62, 0x56, 0x7a,
+	0x7d, 0xf8, 0x11, 0x4e, 0xcd, 0x4b, 0x7d, 0x00, 0xd1, 0xd9, 0x8d, 0x38, 0x74, 0x7b, 0x99, 0xa2,
+	0xbf, 0xa9, 0x56, 0x9e, 0x7c, 0xff, 0xe3, 0x65, 0xe3, 0x83, 0x8f, 0x97, 0x8d, 0xbf, 0x7f, 0xbc,
+	0x6c, 0xbc, 0xfd, 0xc9, 0xf2, 0xb1, 0x0f, 0x3e, 0x59, 0x3e, 0xf6, 0x97, 0x4f, 0x96, 0x8f, 0x7d,
+	0x79, 0x49, 0x45, 0x91, 0x21, 0x0f, 0xdb, 0x6f, 0x06, 0x96, 0xbc, 0xdd, 0xa4, 0xac, 0x74, 0x50,
+	0xde, 0x1d, 0x15, 0xd3, 0xa1, 0xc7, 0xfe, 0x33, 0x00, 0xda, 0x4e, 0xbe, 0xfb, 0x43, 0x2a, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Pauses(ctx context.Context, in *QueryPauses, opts ...grpc.CallOption) (*QueryPausesResponse, error)
+	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	YieldRecipients(ctx context.Context, in *QueryYieldRecipients, opts ...grpc.CallOption) (*QueryYieldRecipientsResponse, error)
+	YieldRecipient(ctx context.Context, in *QueryYieldRecipient, opts ...grpc.CallOption) (*QueryYieldRecipientResponse, error)
+	RetryAmounts(ctx context.Context, in *QueryRetryAmounts, opts ...grpc.CallOption) (*QueryRetryAmountsResponse, error)
+	RetryAmount(ctx context.Context, in *QueryRetryAmount, opts ...grpc.CallOption) (*QueryRetryAmountResponse, error)
+	YieldClaimRecipient(ctx context.Context, in *QueryYieldClaimRecipient, opts ...grpc.CallOption) (*QueryYieldClaimRecipientResponse, error)
+	YieldSplit(ctx context.Context, in *QueryYieldSplit, opts ...grpc.CallOption) (*QueryYieldSplitResponse, error)
+	AutoClaim(ctx context.Context, in *QueryAutoClaim, opts ...grpc.CallOption) (*QueryAutoClaimResponse, error)
+	NonEarningAccounts(ctx context.Context, in *QueryNonEarningAccounts, opts ...grpc.CallOption) (*QueryNonEarningAccountsResponse, error)
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccounts, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	AccountRoles(ctx context.Context, in *QueryAccountRoles, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error)
+	TimelockDelay(ctx context.Context, in *QueryTimelockDelay, opts ...grpc.CallOption) (*QueryTimelockDelayResponse, error)
+	TimelockedMessages(ctx context.Context, in *QueryTimelockedMessages, opts ...grpc.CallOption) (*QueryTimelockedMessagesResponse, error)
+	IndexCircuitBreaker(ctx context.Context, in *QueryIndexCircuitBreaker, opts ...grpc.CallOption) (*QueryIndexCircuitBreakerResponse, error)
+	IndexStatus(ctx context.Context, in *QueryIndexStatus, opts ...grpc.CallOption) (*QueryIndexStatusResponse, error)
+	Haircut(ctx context.Context, in *QueryHaircut, opts ...grpc.CallOption) (*QueryHaircutResponse, error)
+	EffectiveBalance(ctx context.Context, in *QueryEffectiveBalance, opts ...grpc.CallOption) (*QueryEffectiveBalanceResponse, error)
+	Treasury(ctx context.Context, in *QueryTreasury, opts ...grpc.CallOption) (*QueryTreasuryResponse, error)
+	ClaimTip(ctx context.Context, in *QueryClaimTip, opts ...grpc.CallOption) (*QueryClaimTipResponse, error)
+	Fee(ctx context.Context, in *QueryFee, opts ...grpc.CallOption) (*QueryFeeResponse, error)
+	Invariants(ctx context.Context, in *QueryInvariants, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	YieldLiability(ctx context.Context, in *QueryYieldLiability, opts ...grpc.CallOption) (*QueryYieldLiabilityResponse, error)
+	IndexAt(ctx context.Context, in *QueryIndexAt, opts ...grpc.CallOption) (*QueryIndexAtResponse, error)
+	IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error)
+	YieldDistributions(ctx context.Context, in *QueryYieldDistributions, opts ...grpc.CallOption) (*QueryYieldDistributionsResponse, error)
+	CurrentIndex(ctx context.Context, in *QueryCurrentIndex, opts ...grpc.CallOption) (*QueryCurrentIndexResponse, error)
+	YieldRate(ctx context.Context, in *QueryYieldRate, opts ...grpc.CallOption) (*QueryYieldRateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauses(ctx context.Context, in *QueryPauses, opts ...grpc.CallOption) (*QueryPausesResponse, error) {
+	out := new(QueryPausesResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Pauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldRecipients(ctx context.Context, in *QueryYieldRecipients, opts ...grpc.CallOption) (*QueryYieldRecipientsResponse, error) {
+	out := new(QueryYieldRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldRecipient(ctx context.Context, in *QueryYieldRecipient, opts ...grpc.CallOption) (*QueryYieldRecipientResponse, error) {
+	out := new(QueryYieldRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetryAmounts(ctx context.Context, in *QueryRetryAmounts, opts ...grpc.CallOption) (*QueryRetryAmountsResponse, error) {
+	out := new(QueryRetryAmountsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/RetryAmounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetryAmount(ctx context.Context, in *QueryRetryAmount, opts ...grpc.CallOption) (*QueryRetryAmountResponse, error) {
+	out := new(QueryRetryAmountResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/RetryAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldClaimRecipient(ctx context.Context, in *QueryYieldClaimRecipient, opts ...grpc.CallOption) (*QueryYieldClaimRecipientResponse, error) {
+	out := new(QueryYieldClaimRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldClaimRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldSplit(ctx context.Context, in *QueryYieldSplit, opts ...grpc.CallOption) (*QueryYieldSplitResponse, error) {
+	out := new(QueryYieldSplitResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoClaim(ctx context.Context, in *QueryAutoClaim, opts ...grpc.CallOption) (*QueryAutoClaimResponse, error) {
+	out := new(QueryAutoClaimResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/AutoClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NonEarningAccounts(ctx context.Context, in *QueryNonEarningAccounts, opts ...grpc.CallOption) (*QueryNonEarningAccountsResponse, error) {
+	out := new(QueryNonEarningAccountsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/NonEarningAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccounts, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/FrozenAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Roles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRoles(ctx context.Context, in *QueryAccountRoles, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error) {
+	out := new(QueryAccountRolesResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/AccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockDelay(ctx context.Context, in *QueryTimelockDelay, opts ...grpc.CallOption) (*QueryTimelockDelayResponse, error) {
+	out := new(QueryTimelockDelayResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/TimelockDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedMessages(ctx context.Context, in *QueryTimelockedMessages, opts ...grpc.CallOption) (*QueryTimelockedMessagesResponse, error) {
+	out := new(QueryTimelockedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/TimelockedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexCircuitBreaker(ctx context.Context, in *QueryIndexCircuitBreaker, opts ...grpc.CallOption) (*QueryIndexCircuitBreakerResponse, error) {
+	out := new(QueryIndexCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/IndexCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexStatus(ctx context.Context, in *QueryIndexStatus, opts ...grpc.CallOption) (*QueryIndexStatusResponse, error) {
+	out := new(QueryIndexStatusResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/IndexStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Haircut(ctx context.Context, in *QueryHaircut, opts ...grpc.CallOption) (*QueryHaircutResponse, error) {
+	out := new(QueryHaircutResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Haircut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveBalance(ctx context.Context, in *QueryEffectiveBalance, opts ...grpc.CallOption) (*QueryEffectiveBalanceResponse, error) {
+	out := new(QueryEffectiveBalanceResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/EffectiveBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Treasury(ctx context.Context, in *QueryTreasury, opts ...grpc.CallOption) (*QueryTreasuryResponse, error) {
+	out := new(QueryTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Treasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimTip(ctx context.Context, in *QueryClaimTip, opts ...grpc.CallOption) (*QueryClaimTipResponse, error) {
+	out := new(QueryClaimTipResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/ClaimTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Fee(ctx context.Context, in *QueryFee, opts ...grpc.CallOption) (*QueryFeeResponse, error) {
+	out := new(QueryFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Fee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariants, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldLiability(ctx context.Context, in *QueryYieldLiability, opts ...grpc.CallOption) (*QueryYieldLiabilityResponse, error) {
+	out := new(QueryYieldLiabilityResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldLiability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexAt(ctx context.Context, in *QueryIndexAt, opts ...grpc.CallOption) (*QueryIndexAtResponse, error) {
+	out := new(QueryIndexAtResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/IndexAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IndexHistory(ctx context.Context, in *QueryIndexHistory, opts ...grpc.CallOption) (*QueryIndexHistoryResponse, error) {
+	out := new(QueryIndexHistoryResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/IndexHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldDistributions(ctx context.Context, in *QueryYieldDistributions, opts ...grpc.CallOption) (*QueryYieldDistributionsResponse, error) {
+	out := new(QueryYieldDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentIndex(ctx context.Context, in *QueryCurrentIndex, opts ...grpc.CallOption) (*QueryCurrentIndexResponse, error) {
+	out := new(QueryCurrentIndexResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/CurrentIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldRate(ctx context.Context, in *QueryYieldRate, opts ...grpc.CallOption) (*QueryYieldRateResponse, error) {
+	out := new(QueryYieldRateResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Query/YieldRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Pauses(context.Context, *QueryPauses) (*QueryPausesResponse, error)
+	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
+	YieldRecipients(context.Context, *QueryYieldRecipients) (*QueryYieldRecipientsResponse, error)
+	YieldRecipient(context.Context, *QueryYieldRecipient) (*QueryYieldRecipientResponse, error)
+	RetryAmounts(context.Context, *QueryRetryAmounts) (*QueryRetryAmountsResponse, error)
+	RetryAmount(context.Context, *QueryRetryAmount) (*QueryRetryAmountResponse, error)
+	YieldClaimRecipient(context.Context, *QueryYieldClaimRecipient) (*QueryYieldClaimRecipientResponse, error)
+	YieldSplit(context.Context, *QueryYieldSplit) (*QueryYieldSplitResponse, error)
+	AutoClaim(context.Context, *QueryAutoClaim) (*QueryAutoClaimResponse, error)
+	NonEarningAccounts(context.Context, *QueryNonEarningAccounts) (*QueryNonEarningAccountsResponse, error)
+	FrozenAccounts(context.Context, *QueryFrozenAccounts) (*QueryFrozenAccountsResponse, error)
+	Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error)
+	AccountRoles(context.Context, *QueryAccountRoles) (*QueryAccountRolesResponse, error)
+	TimelockDelay(context.Context, *QueryTimelockDelay) (*QueryTimelockDelayResponse, error)
+	TimelockedMessages(context.Context, *QueryTimelockedMessages) (*QueryTimelockedMessagesResponse, error)
+	IndexCircuitBreaker(context.Context, *QueryIndexCircuitBreaker) (*QueryIndexCircuitBreakerResponse, error)
+	IndexStatus(context.Context, *QueryIndexStatus) (*QueryIndexStatusResponse, error)
+	Haircut(context.Context, *QueryHaircut) (*QueryHaircutResponse, error)
+	EffectiveBalance(context.Context, *QueryEffectiveBalance) (*QueryEffectiveBalanceResponse, error)
+	Treasury(context.Context, *QueryTreasury) (*QueryTreasuryResponse, error)
+	ClaimTip(context.Context, *QueryClaimTip) (*QueryClaimTipResponse, error)
+	Fee(context.Context, *QueryFee) (*QueryFeeResponse, error)
+	Invariants(context.Context, *QueryInvariants) (*QueryInvariantsResponse, error)
+	YieldLiability(context.Context, *QueryYieldLiability) (*QueryYieldLiabilityResponse, error)
+	IndexAt(context.Context, *QueryIndexAt) (*QueryIndexAtResponse, error)
+	IndexHistory(context.Context, *QueryIndexHistory) (*QueryIndexHistoryResponse, error)
+	YieldDistributions(context.Context, *QueryYieldDistributions) (*QueryYieldDistributionsResponse, error)
+	CurrentIndex(context.Context, *QueryCurrentIndex) (*QueryCurrentIndexResponse, error)
+	YieldRate(context.Context, *QueryYieldRate) (*QueryYieldRateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) Pauses(ctx context.Context, req *QueryPauses) (*QueryPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauses not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) YieldRecipients(ctx context.Context, req *QueryYieldRecipients) (*QueryYieldRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldRecipients not implemented")
+}
+func (*UnimplementedQueryServer) YieldRecipient(ctx context.Context, req *QueryYieldRecipient) (*QueryYieldRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldRecipient not implemented")
+}
+func (*UnimplementedQueryServer) RetryAmounts(ctx context.Context, req *QueryRetryAmounts) (*QueryRetryAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryAmounts not implemented")
+}
+func (*UnimplementedQueryServer) RetryAmount(ctx context.Context, req *QueryRetryAmount) (*QueryRetryAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryAmount not implemented")
+}
+func (*UnimplementedQueryServer) YieldClaimRecipient(ctx context.Context, req *QueryYieldClaimRecipient) (*QueryYieldClaimRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldClaimRecipient not implemented")
+}
+func (*UnimplementedQueryServer) YieldSplit(ctx context.Context, req *QueryYieldSplit) (*QueryYieldSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldSplit not implemented")
+}
+func (*UnimplementedQueryServer) AutoClaim(ctx context.Context, req *QueryAutoClaim) (*QueryAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoClaim not implemented")
+}
+func (*UnimplementedQueryServer) NonEarningAccounts(ctx context.Context, req *QueryNonEarningAccounts) (*QueryNonEarningAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NonEarningAccounts not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAccounts(ctx context.Context, req *QueryFrozenAccounts) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (*UnimplementedQueryServer) Roles(ctx context.Context, req *QueryRoles) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (*UnimplementedQueryServer) AccountRoles(ctx context.Context, req *QueryAccountRoles) (*QueryAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRoles not implemented")
+}
+func (*UnimplementedQueryServer) TimelockDelay(ctx context.Context, req *QueryTimelockDelay) (*QueryTimelockDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockDelay not implemented")
+}
+func (*UnimplementedQueryServer) TimelockedMessages(ctx context.Context, req *QueryTimelockedMessages) (*QueryTimelockedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedMessages not implemented")
+}
+func (*UnimplementedQueryServer) IndexCircuitBreaker(ctx context.Context, req *QueryIndexCircuitBreaker) (*QueryIndexCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexCircuitBreaker not implemented")
+}
+func (*UnimplementedQueryServer) IndexStatus(ctx context.Context, req *QueryIndexStatus) (*QueryIndexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexStatus not implemented")
+}
+func (*UnimplementedQueryServer) Haircut(ctx context.Context, req *QueryHaircut) (*QueryHaircutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Haircut not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveBalance(ctx context.Context, req *QueryEffectiveBalance) (*QueryEffectiveBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveBalance not implemented")
+}
+func (*UnimplementedQueryServer) Treasury(ctx context.Context, req *QueryTreasury) (*QueryTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Treasury not implemented")
+}
+func (*UnimplementedQueryServer) ClaimTip(ctx context.Context, req *QueryClaimTip) (*QueryClaimTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTip not implemented")
+}
+func (*UnimplementedQueryServer) Fee(ctx context.Context, req *QueryFee) (*QueryFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fee not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariants) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) YieldLiability(ctx context.Context, req *QueryYieldLiability) (*QueryYieldLiabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldLiability not implemented")
+}
+func (*UnimplementedQueryServer) IndexAt(ctx context.Context, req *QueryIndexAt) (*QueryIndexAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexAt not implemented")
+}
+func (*UnimplementedQueryServer) IndexHistory(ctx context.Context, req *QueryIndexHistory) (*QueryIndexHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexHistory not implemented")
+}
+func (*UnimplementedQueryServer) YieldDistributions(ctx context.Context, req *QueryYieldDistributions) (*QueryYieldDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldDistributions not implemented")
+}
+func (*UnimplementedQueryServer) CurrentIndex(ctx context.Context, req *QueryCurrentIndex) (*QueryCurrentIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentIndex not implemented")
+}
+func (*UnimplementedQueryServer) YieldRate(ctx context.Context, req *QueryYieldRate) (*QueryYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldRate not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Pauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauses(ctx, req.(*QueryPauses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldRecipients)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldRecipients(ctx, req.(*QueryYieldRecipients))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldRecipient(ctx, req.(*QueryYieldRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetryAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetryAmounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetryAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/RetryAmounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetryAmounts(ctx, req.(*QueryRetryAmounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetryAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetryAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetryAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/RetryAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetryAmount(ctx, req.(*QueryRetryAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldClaimRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldClaimRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldClaimRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldClaimRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldClaimRecipient(ctx, req.(*QueryYieldClaimRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldSplit(ctx, req.(*QueryYieldSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/AutoClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoClaim(ctx, req.(*QueryAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NonEarningAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNonEarningAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NonEarningAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/NonEarningAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NonEarningAccounts(ctx, req.(*QueryNonEarningAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/FrozenAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Roles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/AccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRoles(ctx, req.(*QueryAccountRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/TimelockDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockDelay(ctx, req.(*QueryTimelockDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/TimelockedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedMessages(ctx, req.(*QueryTimelockedMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/IndexCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexCircuitBreaker(ctx, req.(*QueryIndexCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/IndexStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexStatus(ctx, req.(*QueryIndexStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Haircut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaircut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Haircut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Haircut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Haircut(ctx, req.(*QueryHaircut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/EffectiveBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveBalance(ctx, req.(*QueryEffectiveBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Treasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Treasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Treasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Treasury(ctx, req.(*QueryTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimTip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/ClaimTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimTip(ctx, req.(*QueryClaimTip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Fee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Fee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Fee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Fee(ctx, req.(*QueryFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldLiability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldLiability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldLiability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldLiability(ctx, req.(*QueryYieldLiability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/IndexAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexAt(ctx, req.(*QueryIndexAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/IndexHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexHistory(ctx, req.(*QueryIndexHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldDistributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldDistributions(ctx, req.(*QueryYieldDistributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/CurrentIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentIndex(ctx, req.(*QueryCurrentIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Query/YieldRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldRate(ctx, req.(*QueryYieldRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.dollar.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Pauses",
+			Handler:    _Query_Pauses_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "YieldRecipients",
+			Handler:    _Query_YieldRecipients_Handler,
+		},
+		{
+			MethodName: "YieldRecipient",
+			Handler:    _Query_YieldRecipient_Handler,
+		},
+		{
+			MethodName: "RetryAmounts",
+			Handler:    _Query_RetryAmounts_Handler,
+		},
+		{
+			MethodName: "RetryAmount",
+			Handler:    _Query_RetryAmount_Handler,
+		},
+		{
+			MethodName: "YieldClaimRecipient",
+			Handler:    _Query_YieldClaimRecipient_Handler,
+		},
+		{
+			MethodName: "YieldSplit",
+			Handler:    _Query_YieldSplit_Handler,
+		},
+		{
+			MethodName: "AutoClaim",
+			Handler:    _Query_AutoClaim_Handler,
+		},
+		{
+			MethodName: "NonEarningAccounts",
+			Handler:    _Query_NonEarningAccounts_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "AccountRoles",
+			Handler:    _Query_AccountRoles_Handler,
+		},
+		{
+			MethodName: "TimelockDelay",
+			Handler:    _Query_TimelockDelay_Handler,
+		},
+		{
+			MethodName: "TimelockedMessages",
+			Handler:    _Query_TimelockedMessages_Handler,
+		},
+		{
+			MethodName: "IndexCircuitBreaker",
+			Handler:    _Query_IndexCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "IndexStatus",
+			Handler:    _Query_IndexStatus_Handler,
+		},
+		{
+			MethodName: "Haircut",
+			Handler:    _Query_Haircut_Handler,
+		},
+		{
+			MethodName: "EffectiveBalance",
+			Handler:    _Query_EffectiveBalance_Handler,
+		},
+		{
+			MethodName: "Treasury",
+			Handler:    _Query_Treasury_Handler,
+		},
+		{
+			MethodName: "ClaimTip",
+			Handler:    _Query_ClaimTip_Handler,
+		},
+		{
+			MethodName: "Fee",
+			Handler:    _Query_Fee_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "YieldLiability",
+			Handler:    _Query_YieldLiability_Handler,
+		},
+		{
+			MethodName: "IndexAt",
+			Handler:    _Query_IndexAt_Handler,
+		},
+		{
+			MethodName: "IndexHistory",
+			Handler:    _Query_IndexHistory_Handler,
+		},
+		{
+			MethodName: "YieldDistributions",
+			Handler:    _Query_YieldDistributions_Handler,
+		},
+		{
+			MethodName: "CurrentIndex",
+			Handler:    _Query_CurrentIndex_Handler,
+		},
+		{
+			MethodName: "YieldRate",
+			Handler:    _Query_YieldRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/dollar/v2/query.proto",
+}
+
+func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Paused))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalHaircutBurned.Size()
+		i -= size
+		if _, err := m.TotalHaircutBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalFeesAccrued.Size()
+		i -= size
+		if _, err := m.TotalFeesAccrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TotalExternalYield) > 0 {
+		for k := range m.TotalExternalYield {
+			v := m.TotalExternalYield[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalYieldAccrued.Size()
+		i -= size
+		if _, err := m.TotalYieldAccrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalPrincipal.Size()
+		i -= size
+		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TotalHolders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalHolders))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse_ExternalYield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse_ExternalYield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse_ExternalYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YieldRecipients) > 0 {
+		for k := range m.YieldRecipients {
+			v := m.YieldRecipients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Provider != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Provider))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YieldRecipient) > 0 {
+		i -= len(m.YieldRecipient)
+		copy(dAtA[i:], m.YieldRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.YieldRecipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryAmounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryAmounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryAmounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryAmountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryAmountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryAmountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryAmounts) > 0 {
+		for k := range m.RetryAmounts {
+			v := m.RetryAmounts[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Provider != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Provider))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RetryAmount.Size()
+		i -= size
+		if _, err := m.RetryAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldClaimRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldClaimRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldClaimRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldClaimRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldClaimRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldClaimRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNonEarningAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNonEarningAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNonEarningAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNonEarningAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNonEarningAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNonEarningAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA3 := make([]byte, len(m.Roles)*10)
+		var j2 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingIndex != nil {
+		{
+			size, err := m.PendingIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.MaxYieldRate.Size()
+		i -= size
+		if _, err := m.MaxYieldRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Staleness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Elapsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.LastUpdate != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdate):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaircut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaircut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHaircutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaircutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaircutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EffectiveBalance.Size()
+		i -= size
+		if _, err := m.EffectiveBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimTip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimTip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimTip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tip != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tip))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collector) > 0 {
+		i -= len(m.Collector)
+		copy(dAtA[i:], m.Collector)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldLiability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldLiability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldLiability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldLiabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldLiabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldLiabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Excess.Size()
+		i -= size
+		if _, err := m.Excess.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Solvent {
+		i--
+		if m.Solvent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Accounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rounding.Size()
+		i -= size
+		if _, err := m.Rounding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Surplus.Size()
+		i -= size
+		if _, err := m.Surplus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Liability.Size()
+		i -= size
+		if _, err := m.Liability.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexAt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexAt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexAt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldDistributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldDistributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdated):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EarnerRate.Size()
+		i -= size
+		if _, err := m.EarnerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apy.Size()
+		i -= size
+		if _, err := m.Apy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused != 0 {
+		n += 1 + sovQuery(uint64(m.Paused))
+	}
+	return n
+}
+
+func (m *QueryPauses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalHolders != 0 {
+		n += 1 + sovQuery(uint64(m.TotalHolders))
+	}
+	l = m.TotalPrincipal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalYieldAccrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalExternalYield) > 0 {
+		for k, v := range m.TotalExternalYield {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	l = m.TotalFeesAccrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalHaircutBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatsResponse_ExternalYield) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryYieldRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryYieldRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.YieldRecipients) > 0 {
+		for k, v := range m.YieldRecipients {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryYieldRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provider != 0 {
+		n += 1 + sovQuery(uint64(m.Provider))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YieldRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetryAmounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRetryAmountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RetryAmounts) > 0 {
+		for k, v := range m.RetryAmounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryRetryAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provider != 0 {
+		n += 1 + sovQuery(uint64(m.Provider))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetryAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RetryAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryYieldClaimRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldClaimRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNonEarningAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNonEarningAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFrozenAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryTimelockDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimelockDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTimelockedMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimelockedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIndexCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndexCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxYieldRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingIndex != nil {
+		l = m.PendingIndex.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndexStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUpdate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Staleness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryHaircut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHaircutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.Factor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimTip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClaimTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tip != 0 {
+		n += 1 + sovQuery(uint64(m.Tip))
+	}
+	return n
+}
+
+func (m *QueryFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
+	l = len(m.Collector)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryYieldLiability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryYieldLiabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Surplus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rounding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Accounts != 0 {
+		n += 1 + sovQuery(uint64(m.Accounts))
+	}
+	if m.Solvent {
+		n += 2
+	}
+	l = m.Excess.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndexAt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndexAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIndexHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldDistributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryYieldDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.CurrentIndex != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentIndex))
+	}
+	l = m.EarnerRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdated)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryYieldRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryYieldRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			m.Paused = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paused |= PausedType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, ActivePause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolders", wireType)
+			}
+			m.TotalHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHolders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYieldAccrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalYieldAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalExternalYield == nil {
+				m.TotalExternalYield = make(map[string]QueryStatsResponse_ExternalYield)
+			}
+			var mapkey string
+			mapvalue := &QueryStatsResponse_ExternalYield{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &QueryStatsResponse_ExternalYield{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalExternalYield[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesAccrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHaircutBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHaircutBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalYield: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalYield: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldRecipients) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldRecipients: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldRecipientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldRecipientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldRecipients == nil {
+				m.YieldRecipients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.YieldRecipients[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			m.Provider = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Provider |= Provider(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YieldRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryAmounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryAmounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryAmountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryAmountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryAmountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryAmounts == nil {
+				m.RetryAmounts = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RetryAmounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			m.Provider = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Provider |= Provider(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldClaimRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldClaimRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldClaimRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldClaimRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldClaimRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldClaimRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, YieldShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNonEarningAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNonEarningAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNonEarningAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNonEarningAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNonEarningAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNonEarningAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockedMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockedMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockedMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockedMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockedMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, TimelockedMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxYieldRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxYieldRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingIndex == nil {
+				m.PendingIndex = &PendingIndex{}
+			}
+			if err := m.PendingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdate == nil {
+				m.LastUpdate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Elapsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staleness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaircut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaircut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaircut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaircutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaircutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaircutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimTip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimTip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimTip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			m.Tip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tip |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryRetryAmountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.RetryAmounts) > 0 {
-		for k, v := range m.RetryAmounts {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldLiability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldLiability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldLiability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldLiabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldLiabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldLiabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rounding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solvent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Solvent = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Excess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryRetryAmount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Provider != 0 {
-		n += 1 + sovQuery(uint64(m.Provider))
-	}
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryRetryAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RetryAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryStats) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexAt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1410,12 +11596,45 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexAt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexAt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1437,7 +11656,7 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,17 +11679,17 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalHolders", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			m.TotalHolders = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1480,16 +11699,80 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalHolders |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndexHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndexHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndexHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1499,31 +11782,30 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalYieldAccrued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1533,29 +11815,28 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalYieldAccrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalExternalYield", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1582,105 +11863,12 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalExternalYield == nil {
-				m.TotalExternalYield = make(map[string]QueryStatsResponse_ExternalYield)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var mapkey string
-			mapvalue := &QueryStatsResponse_ExternalYield{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &QueryStatsResponse_ExternalYield{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TotalExternalYield[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1703,7 +11891,7 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1726,17 +11914,17 @@ func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExternalYield: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalYield: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1746,29 +11934,31 @@ func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, IndexRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1778,23 +11968,25 @@ func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1819,7 +12011,7 @@ func (m *QueryStatsResponse_ExternalYield) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryYieldRecipients) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldDistributions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,16 +12030,118 @@ func (m *QueryYieldRecipients) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryYieldRecipients: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryYieldRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldDistributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1869,7 +12163,7 @@ func (m *QueryYieldRecipients) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryYieldRecipientsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldDistributionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1892,15 +12186,15 @@ func (m *QueryYieldRecipientsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryYieldRecipientsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryYieldDistributionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryYieldRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryYieldDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1927,103 +12221,46 @@ func (m *QueryYieldRecipientsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.YieldRecipients == nil {
-				m.YieldRecipients = make(map[string]string)
+			m.Distributions = append(m.Distributions, YieldDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.YieldRecipients[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2046,7 +12283,7 @@ func (m *QueryYieldRecipientsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryYieldRecipient) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2069,63 +12306,12 @@ func (m *QueryYieldRecipient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryYieldRecipient: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryYieldRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			m.Provider = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Provider |= Provider(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2147,7 +12333,7 @@ func (m *QueryYieldRecipient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryYieldRecipientResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2170,15 +12356,53 @@ func (m *QueryYieldRecipientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryYieldRecipientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryYieldRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentIndex", wireType)
+			}
+			m.CurrentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnerRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2206,58 +12430,43 @@ func (m *QueryYieldRecipientResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YieldRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.EarnerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRetryAmounts) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRetryAmounts: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRetryAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2279,7 +12488,7 @@ func (m *QueryRetryAmounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRetryAmountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,15 +12511,15 @@ func (m *QueryRetryAmountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRetryAmountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryYieldRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRetryAmountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryYieldRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2337,103 +12546,9 @@ func (m *QueryRetryAmountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryAmounts == nil {
-				m.RetryAmounts = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RetryAmounts[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2456,7 +12571,7 @@ func (m *QueryRetryAmountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRetryAmount) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2479,17 +12594,17 @@ func (m *QueryRetryAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRetryAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryYieldRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRetryAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryYieldRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.Provider = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2499,16 +12614,30 @@ func (m *QueryRetryAmount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Provider |= Provider(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2518,77 +12647,62 @@ func (m *QueryRetryAmount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRetryAmountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRetryAmountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRetryAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2616,7 +12730,7 @@ func (m *QueryRetryAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RetryAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Apy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package v2
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// MaxYieldSplitShares is the maximum number of shares a holder's claimed
+	// yield can be split across.
+	MaxYieldSplitShares = 10
+	// TotalYieldSplitShares is the amount, in basis points, that the shares
+	// of a yield split must add up to.
+	TotalYieldSplitShares = 10_000
+)
+
+// ValidateYieldShares performs basic validation of the provided yield shares.
+func ValidateYieldShares(shares []YieldShare) error {
+	if len(shares) > MaxYieldSplitShares {
+		return fmt.Errorf("yield split has %d shares, maximum is %d", len(shares), MaxYieldSplitShares)
+	}
+
+	total := uint64(0)
+	recipients := make(map[string]bool)
+	for _, share := range shares {
+		if share.Share == 0 {
+			return fmt.Errorf("yield share of %s must be positive", share.Recipient)
+		}
+		if recipients[share.Recipient] {
+			return fmt.Errorf("duplicate yield share recipient %s", share.Recipient)
+		}
+		recipients[share.Recipient] = true
+
+		total += uint64(share.Share)
+	}
+
+	if total != TotalYieldSplitShares {
+		return fmt.Errorf("yield shares must add up to %d, got %d", TotalYieldSplitShares, total)
+	}
+
+	return nil
+}
+
+// SortYieldShares sorts the provided yield shares by recipient, so that
+// yield is always split in a deterministic order.
+func SortYieldShares(shares []YieldShare) {
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].Recipient < shares[j].Recipient
+	})
+}
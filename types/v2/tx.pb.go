@@ -5,6 +5,7 @@ package v2
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -12,18 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -109,245 +115,8498 @@ func (m *MsgSetYieldRecipientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetYieldRecipientResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetYieldRecipient)(nil), "noble.dollar.v2.MsgSetYieldRecipient")
-	proto.RegisterType((*MsgSetYieldRecipientResponse)(nil), "noble.dollar.v2.MsgSetYieldRecipientResponse")
+// MsgSetYieldClaimRecipient allows a holder to route the payout of their claimed yield to another account.
+type MsgSetYieldClaimRecipient struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// recipient is the account receiving the claimed yield, an empty value removes the current recipient.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/dollar/v2/tx.proto", fileDescriptor_a2c07f53b93473db) }
+func (m *MsgSetYieldClaimRecipient) Reset()         { *m = MsgSetYieldClaimRecipient{} }
+func (m *MsgSetYieldClaimRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgSetYieldClaimRecipient) ProtoMessage()    {}
+func (*MsgSetYieldClaimRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{2}
+}
+func (m *MsgSetYieldClaimRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetYieldClaimRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetYieldClaimRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetYieldClaimRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetYieldClaimRecipient.Merge(m, src)
+}
+func (m *MsgSetYieldClaimRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetYieldClaimRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetYieldClaimRecipient.DiscardUnknown(m)
+}
 
-var fileDescriptor_a2c07f53b93473db = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x4f, 0x02, 0x31,
-	0x18, 0xbd, 0x8a, 0x12, 0xe9, 0xa0, 0xe1, 0x42, 0xe2, 0x71, 0xb9, 0x14, 0x42, 0x62, 0x42, 0x48,
-	0xb8, 0xe2, 0x19, 0x1d, 0xdc, 0x64, 0x27, 0x31, 0xc7, 0xa4, 0x8b, 0x01, 0xae, 0x5e, 0x9a, 0x1c,
-	0xd7, 0x4b, 0x7b, 0x39, 0xc1, 0xc9, 0x38, 0x19, 0x27, 0x7f, 0x02, 0x3f, 0x81, 0xc1, 0x1f, 0xe1,
-	0x48, 0x9c, 0x1c, 0x0d, 0x0c, 0xb8, 0xf9, 0x17, 0x0c, 0xbd, 0xa2, 0x06, 0x18, 0x5c, 0xda, 0x7e,
-	0xef, 0xbd, 0x7c, 0xef, 0x7b, 0x6d, 0xa1, 0x11, 0xb2, 0x6e, 0x40, 0xb0, 0xc7, 0x82, 0xa0, 0xc3,
-	0x71, 0xe2, 0xe0, 0x78, 0x60, 0x47, 0x9c, 0xc5, 0x4c, 0xdf, 0x97, 0x8c, 0x9d, 0x32, 0x76, 0xe2,
-	0x98, 0xf9, 0x4e, 0x9f, 0x86, 0x0c, 0xcb, 0x35, 0xd5, 0x98, 0x07, 0x3d, 0x26, 0xfa, 0x4c, 0xe0,
-	0xbe, 0xf0, 0x71, 0x72, 0xb4, 0xd8, 0x14, 0x51, 0x4c, 0x89, 0x6b, 0x59, 0xe1, 0xb4, 0x50, 0x54,
-	0xc1, 0x67, 0x3e, 0x4b, 0xf1, 0xc5, 0x49, 0xa1, 0xd6, 0xea, 0x1c, 0xca, 0x57, 0xb2, 0x95, 0x2f,
-	0x00, 0x0b, 0x2d, 0xe1, 0xb7, 0x49, 0x7c, 0x49, 0x49, 0xe0, 0xb9, 0xa4, 0x47, 0x23, 0x4a, 0xc2,
-	0x58, 0x6f, 0xc0, 0xac, 0xa0, 0x7e, 0x48, 0xb8, 0x01, 0xca, 0xa0, 0x9a, 0x6b, 0x1a, 0x6f, 0x2f,
-	0xf5, 0x82, 0xb2, 0x3b, 0xf7, 0x3c, 0x4e, 0x84, 0x68, 0xc7, 0x9c, 0x86, 0xbe, 0xab, 0x74, 0xfa,
-	0x09, 0xdc, 0x8d, 0x38, 0x4b, 0xa8, 0x47, 0xb8, 0xb1, 0x55, 0x06, 0xd5, 0x3d, 0xa7, 0x68, 0xaf,
-	0x24, 0xb5, 0x2f, 0x94, 0xc0, 0xfd, 0x91, 0xea, 0x08, 0x42, 0xea, 0x91, 0x30, 0xa6, 0x37, 0x94,
-	0x70, 0x23, 0xb3, 0x30, 0x73, 0xff, 0x20, 0xba, 0x05, 0x73, 0x7c, 0x39, 0x95, 0xb1, 0x2d, 0xe9,
-	0x5f, 0xe0, 0xac, 0xf1, 0x38, 0x2a, 0x69, 0x9f, 0xa3, 0x92, 0xf6, 0x30, 0x1f, 0xd7, 0xd4, 0x24,
-	0x4f, 0xf3, 0x71, 0xcd, 0x50, 0x79, 0xd7, 0x82, 0x55, 0x10, 0xb4, 0x36, 0x05, 0x76, 0x89, 0x88,
-	0x58, 0x28, 0x88, 0x73, 0x0b, 0x33, 0x2d, 0xe1, 0xeb, 0x14, 0xe6, 0xd7, 0x2f, 0xe5, 0x70, 0x2d,
-	0xd0, 0xa6, 0x56, 0x66, 0xfd, 0x5f, 0xb2, 0xa5, 0xa3, 0xb9, 0x73, 0x3f, 0x1f, 0xd7, 0x40, 0xf3,
-	0xf4, 0x75, 0x8a, 0xc0, 0x64, 0x8a, 0xc0, 0xc7, 0x14, 0x81, 0xe7, 0x19, 0xd2, 0x26, 0x33, 0xa4,
-	0xbd, 0xcf, 0x90, 0x76, 0x65, 0xa9, 0x46, 0x69, 0xd7, 0xc1, 0xf0, 0x4e, 0x7e, 0xa7, 0x61, 0x44,
-	0x04, 0x4e, 0x9c, 0x6e, 0x56, 0xbe, 0xe4, 0xf1, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xd8,
-	0x71, 0xca, 0x71, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgSetYieldClaimRecipient proto.InternalMessageInfo
+
+// MsgSetYieldClaimRecipientResponse is the response of the SetYieldClaimRecipient message.
+type MsgSetYieldClaimRecipientResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetYieldClaimRecipientResponse) Reset()         { *m = MsgSetYieldClaimRecipientResponse{} }
+func (m *MsgSetYieldClaimRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetYieldClaimRecipientResponse) ProtoMessage()    {}
+func (*MsgSetYieldClaimRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{3}
+}
+func (m *MsgSetYieldClaimRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetYieldClaimRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetYieldClaimRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetYieldClaimRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetYieldClaimRecipientResponse.Merge(m, src)
+}
+func (m *MsgSetYieldClaimRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetYieldClaimRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetYieldClaimRecipientResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetYieldClaimRecipientResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipient, opts ...grpc.CallOption) (*MsgSetYieldRecipientResponse, error)
+// MsgSetYieldSplit allows a holder to split the payout of their claimed yield across multiple accounts.
+type MsgSetYieldSplit struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// shares must add up to 10000 basis points, an empty value removes the current split.
+	Shares []YieldShare `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetYieldSplit) Reset()         { *m = MsgSetYieldSplit{} }
+func (m *MsgSetYieldSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetYieldSplit) ProtoMessage()    {}
+func (*MsgSetYieldSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{4}
+}
+func (m *MsgSetYieldSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetYieldSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetYieldSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetYieldSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetYieldSplit.Merge(m, src)
+}
+func (m *MsgSetYieldSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetYieldSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetYieldSplit.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetYieldSplit proto.InternalMessageInfo
+
+// MsgSetYieldSplitResponse is the response of the SetYieldSplit message.
+type MsgSetYieldSplitResponse struct {
 }
 
-func (c *msgClient) SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipient, opts ...grpc.CallOption) (*MsgSetYieldRecipientResponse, error) {
-	out := new(MsgSetYieldRecipientResponse)
-	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetYieldRecipient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetYieldSplitResponse) Reset()         { *m = MsgSetYieldSplitResponse{} }
+func (m *MsgSetYieldSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetYieldSplitResponse) ProtoMessage()    {}
+func (*MsgSetYieldSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{5}
+}
+func (m *MsgSetYieldSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetYieldSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetYieldSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SetYieldRecipient(context.Context, *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error)
+func (m *MsgSetYieldSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetYieldSplitResponse.Merge(m, src)
+}
+func (m *MsgSetYieldSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetYieldSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetYieldSplitResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetYieldSplitResponse proto.InternalMessageInfo
+
+// MsgSetAutoClaim allows a holder to opt in or out of having their yield claimed automatically after every index update.
+type MsgSetAutoClaim struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (*UnimplementedMsgServer) SetYieldRecipient(ctx context.Context, req *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetYieldRecipient not implemented")
+func (m *MsgSetAutoClaim) Reset()         { *m = MsgSetAutoClaim{} }
+func (m *MsgSetAutoClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaim) ProtoMessage()    {}
+func (*MsgSetAutoClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{6}
+}
+func (m *MsgSetAutoClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaim.Merge(m, src)
+}
+func (m *MsgSetAutoClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaim.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetAutoClaim proto.InternalMessageInfo
+
+// MsgSetAutoClaimResponse is the response of the SetAutoClaim message.
+type MsgSetAutoClaimResponse struct {
 }
 
-func _Msg_SetYieldRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetYieldRecipient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetYieldRecipient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.dollar.v2.Msg/SetYieldRecipient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetYieldRecipient(ctx, req.(*MsgSetYieldRecipient))
+func (m *MsgSetAutoClaimResponse) Reset()         { *m = MsgSetAutoClaimResponse{} }
+func (m *MsgSetAutoClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoClaimResponse) ProtoMessage()    {}
+func (*MsgSetAutoClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{7}
+}
+func (m *MsgSetAutoClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoClaimResponse.Merge(m, src)
+}
+func (m *MsgSetAutoClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoClaimResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.dollar.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetYieldRecipient",
-			Handler:    _Msg_SetYieldRecipient_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/dollar/v2/tx.proto",
+var xxx_messageInfo_MsgSetAutoClaimResponse proto.InternalMessageInfo
+
+// MsgClaimYieldFor allows anyone to claim the yield of a list of holders on their behalf.
+type MsgClaimYieldFor struct {
+	Signer   string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (m *MsgSetYieldRecipient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimYieldFor) Reset()         { *m = MsgClaimYieldFor{} }
+func (m *MsgClaimYieldFor) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimYieldFor) ProtoMessage()    {}
+func (*MsgClaimYieldFor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{8}
+}
+func (m *MsgClaimYieldFor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimYieldFor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimYieldFor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimYieldFor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimYieldFor.Merge(m, src)
+}
+func (m *MsgClaimYieldFor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimYieldFor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimYieldFor.DiscardUnknown(m)
 }
 
-func (m *MsgSetYieldRecipient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimYieldFor proto.InternalMessageInfo
+
+// MsgClaimYieldForResponse is the response of the ClaimYieldFor message.
+type MsgClaimYieldForResponse struct {
+	// total_yield is the total amount of yield claimed, including tips.
+	TotalYield cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_yield,json=totalYield,proto3,customtype=cosmossdk.io/math.Int" json:"total_yield"`
+	// total_tip is the total amount of tips paid to the signer.
+	TotalTip cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_tip,json=totalTip,proto3,customtype=cosmossdk.io/math.Int" json:"total_tip"`
 }
 
-func (m *MsgSetYieldRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Identifier) > 0 {
-		i -= len(m.Identifier)
-		copy(dAtA[i:], m.Identifier)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Provider != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Provider))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgClaimYieldForResponse) Reset()         { *m = MsgClaimYieldForResponse{} }
+func (m *MsgClaimYieldForResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimYieldForResponse) ProtoMessage()    {}
+func (*MsgClaimYieldForResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{9}
+}
+func (m *MsgClaimYieldForResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimYieldForResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimYieldForResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgClaimYieldForResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimYieldForResponse.Merge(m, src)
+}
+func (m *MsgClaimYieldForResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimYieldForResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimYieldForResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimYieldForResponse proto.InternalMessageInfo
+
+// MsgSetClaimTip allows the authority to set the tip paid out of claimed yield to signers of MsgClaimYieldFor.
+type MsgSetClaimTip struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// tip is expressed in basis points of the claimed yield.
+	Tip uint32 `protobuf:"varint,2,opt,name=tip,proto3" json:"tip,omitempty"`
+}
+
+func (m *MsgSetClaimTip) Reset()         { *m = MsgSetClaimTip{} }
+func (m *MsgSetClaimTip) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimTip) ProtoMessage()    {}
+func (*MsgSetClaimTip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{10}
+}
+func (m *MsgSetClaimTip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimTip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimTip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetClaimTip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimTip.Merge(m, src)
+}
+func (m *MsgSetClaimTip) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimTip) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimTip.DiscardUnknown(m)
 }
 
-func (m *MsgSetYieldRecipientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+var xxx_messageInfo_MsgSetClaimTip proto.InternalMessageInfo
+
+// MsgSetClaimTipResponse is the response of the SetClaimTip message.
+type MsgSetClaimTipResponse struct {
+}
+
+func (m *MsgSetClaimTipResponse) Reset()         { *m = MsgSetClaimTipResponse{} }
+func (m *MsgSetClaimTipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimTipResponse) ProtoMessage()    {}
+func (*MsgSetClaimTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{11}
+}
+func (m *MsgSetClaimTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimTipResponse.Merge(m, src)
+}
+func (m *MsgSetClaimTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimTipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimTipResponse proto.InternalMessageInfo
+
+// MsgSetNonEarningAccount allows the authority to add or remove an account from the list of non-earning accounts.
+type MsgSetNonEarningAccount struct {
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Account    string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	NonEarning bool   `protobuf:"varint,3,opt,name=non_earning,json=nonEarning,proto3" json:"non_earning,omitempty"`
+}
+
+func (m *MsgSetNonEarningAccount) Reset()         { *m = MsgSetNonEarningAccount{} }
+func (m *MsgSetNonEarningAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNonEarningAccount) ProtoMessage()    {}
+func (*MsgSetNonEarningAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{12}
+}
+func (m *MsgSetNonEarningAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNonEarningAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNonEarningAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNonEarningAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNonEarningAccount.Merge(m, src)
+}
+func (m *MsgSetNonEarningAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNonEarningAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNonEarningAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNonEarningAccount proto.InternalMessageInfo
+
+// MsgSetNonEarningAccountResponse is the response of the SetNonEarningAccount message.
+type MsgSetNonEarningAccountResponse struct {
+}
+
+func (m *MsgSetNonEarningAccountResponse) Reset()         { *m = MsgSetNonEarningAccountResponse{} }
+func (m *MsgSetNonEarningAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNonEarningAccountResponse) ProtoMessage()    {}
+func (*MsgSetNonEarningAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{13}
+}
+func (m *MsgSetNonEarningAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNonEarningAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNonEarningAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNonEarningAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNonEarningAccountResponse.Merge(m, src)
+}
+func (m *MsgSetNonEarningAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNonEarningAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNonEarningAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNonEarningAccountResponse proto.InternalMessageInfo
+
+// MsgSetTreasury allows the authority to set the treasury that the yield of non-earning accounts is swept to.
+type MsgSetTreasury struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Treasury string `protobuf:"bytes,2,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (m *MsgSetTreasury) Reset()         { *m = MsgSetTreasury{} }
+func (m *MsgSetTreasury) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTreasury) ProtoMessage()    {}
+func (*MsgSetTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{14}
+}
+func (m *MsgSetTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTreasury.Merge(m, src)
+}
+func (m *MsgSetTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTreasury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTreasury proto.InternalMessageInfo
+
+// MsgSetTreasuryResponse is the response of the SetTreasury message.
+type MsgSetTreasuryResponse struct {
+}
+
+func (m *MsgSetTreasuryResponse) Reset()         { *m = MsgSetTreasuryResponse{} }
+func (m *MsgSetTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTreasuryResponse) ProtoMessage()    {}
+func (*MsgSetTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{15}
+}
+func (m *MsgSetTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTreasuryResponse.Merge(m, src)
+}
+func (m *MsgSetTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTreasuryResponse proto.InternalMessageInfo
+
+// MsgReconcileStats allows the authority to recompute the core and vaults stats from state.
+type MsgReconcileStats struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgReconcileStats) Reset()         { *m = MsgReconcileStats{} }
+func (m *MsgReconcileStats) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileStats) ProtoMessage()    {}
+func (*MsgReconcileStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{16}
+}
+func (m *MsgReconcileStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileStats.Merge(m, src)
+}
+func (m *MsgReconcileStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileStats proto.InternalMessageInfo
+
+// MsgReconcileStatsResponse is the response of the ReconcileStats message.
+type MsgReconcileStatsResponse struct {
+}
+
+func (m *MsgReconcileStatsResponse) Reset()         { *m = MsgReconcileStatsResponse{} }
+func (m *MsgReconcileStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileStatsResponse) ProtoMessage()    {}
+func (*MsgReconcileStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{17}
+}
+func (m *MsgReconcileStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileStatsResponse.Merge(m, src)
+}
+func (m *MsgReconcileStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileStatsResponse proto.InternalMessageInfo
+
+// MsgSetFee allows the authority to set the fee taken from newly accrued yield, and its collector.
+type MsgSetFee struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fee is the fee rate in basis points.
+	Fee       uint32 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+}
+
+func (m *MsgSetFee) Reset()         { *m = MsgSetFee{} }
+func (m *MsgSetFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFee) ProtoMessage()    {}
+func (*MsgSetFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{18}
+}
+func (m *MsgSetFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFee.Merge(m, src)
+}
+func (m *MsgSetFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFee proto.InternalMessageInfo
+
+// MsgSetFeeResponse is the response of the SetFee message.
+type MsgSetFeeResponse struct {
+}
+
+func (m *MsgSetFeeResponse) Reset()         { *m = MsgSetFeeResponse{} }
+func (m *MsgSetFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeResponse) ProtoMessage()    {}
+func (*MsgSetFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{19}
+}
+func (m *MsgSetFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeResponse.Merge(m, src)
+}
+func (m *MsgSetFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeResponse proto.InternalMessageInfo
+
+// MsgSetFrozenAccount allows the authority to freeze or unfreeze an account.
+type MsgSetFrozenAccount struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Frozen  bool   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *MsgSetFrozenAccount) Reset()         { *m = MsgSetFrozenAccount{} }
+func (m *MsgSetFrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFrozenAccount) ProtoMessage()    {}
+func (*MsgSetFrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{20}
+}
+func (m *MsgSetFrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFrozenAccount.Merge(m, src)
+}
+func (m *MsgSetFrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFrozenAccount proto.InternalMessageInfo
+
+// MsgSetFrozenAccountResponse is the response of the SetFrozenAccount message.
+type MsgSetFrozenAccountResponse struct {
+}
+
+func (m *MsgSetFrozenAccountResponse) Reset()         { *m = MsgSetFrozenAccountResponse{} }
+func (m *MsgSetFrozenAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFrozenAccountResponse) ProtoMessage()    {}
+func (*MsgSetFrozenAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{21}
+}
+func (m *MsgSetFrozenAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFrozenAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFrozenAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFrozenAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFrozenAccountResponse.Merge(m, src)
+}
+func (m *MsgSetFrozenAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFrozenAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFrozenAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFrozenAccountResponse proto.InternalMessageInfo
+
+// MsgClaimFrozenYield allows the authority to claim the yield of a frozen account to its balance.
+type MsgClaimFrozenYield struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MsgClaimFrozenYield) Reset()         { *m = MsgClaimFrozenYield{} }
+func (m *MsgClaimFrozenYield) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFrozenYield) ProtoMessage()    {}
+func (*MsgClaimFrozenYield) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{22}
+}
+func (m *MsgClaimFrozenYield) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFrozenYield) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFrozenYield.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimFrozenYield) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFrozenYield.Merge(m, src)
+}
+func (m *MsgClaimFrozenYield) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFrozenYield) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFrozenYield.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimFrozenYield proto.InternalMessageInfo
+
+// MsgClaimFrozenYieldResponse is the response of the ClaimFrozenYield message.
+type MsgClaimFrozenYieldResponse struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgClaimFrozenYieldResponse) Reset()         { *m = MsgClaimFrozenYieldResponse{} }
+func (m *MsgClaimFrozenYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFrozenYieldResponse) ProtoMessage()    {}
+func (*MsgClaimFrozenYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{23}
+}
+func (m *MsgClaimFrozenYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFrozenYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFrozenYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimFrozenYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFrozenYieldResponse.Merge(m, src)
+}
+func (m *MsgClaimFrozenYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFrozenYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFrozenYieldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimFrozenYieldResponse proto.InternalMessageInfo
+
+// MsgSetPausedType allows the authority to configure which actions of the Noble Dollar are paused.
+type MsgSetPausedType struct {
+	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Paused PausedType `protobuf:"varint,2,opt,name=paused,proto3,enum=noble.dollar.v2.PausedType" json:"paused,omitempty"`
+	// expiry is the time after which the pause is automatically lifted, indefinite if not set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// reason is a description of why the pause was set.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSetPausedType) Reset()         { *m = MsgSetPausedType{} }
+func (m *MsgSetPausedType) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedType) ProtoMessage()    {}
+func (*MsgSetPausedType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{24}
+}
+func (m *MsgSetPausedType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedType.Merge(m, src)
+}
+func (m *MsgSetPausedType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedType proto.InternalMessageInfo
+
+// MsgSetPausedTypeResponse is the response of the SetPausedType message.
+type MsgSetPausedTypeResponse struct {
+}
+
+func (m *MsgSetPausedTypeResponse) Reset()         { *m = MsgSetPausedTypeResponse{} }
+func (m *MsgSetPausedTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedTypeResponse) ProtoMessage()    {}
+func (*MsgSetPausedTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{25}
+}
+func (m *MsgSetPausedTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedTypeResponse.Merge(m, src)
+}
+func (m *MsgSetPausedTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedTypeResponse proto.InternalMessageInfo
+
+// MsgGrantRole allows the authority to grant a role to an account.
+type MsgGrantRole struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=noble.dollar.v2.Role" json:"role,omitempty"`
+}
+
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{26}
+}
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
+}
+func (m *MsgGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
+
+// MsgGrantRoleResponse is the response of the GrantRole message.
+type MsgGrantRoleResponse struct {
+}
+
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{27}
+}
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
+
+// MsgRevokeRole allows the authority to revoke a role from an account.
+type MsgRevokeRole struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=noble.dollar.v2.Role" json:"role,omitempty"`
+}
+
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{28}
+}
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
+}
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+// MsgRevokeRoleResponse is the response of the RevokeRole message.
+type MsgRevokeRoleResponse struct {
+}
+
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{29}
+}
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
+
+// MsgSetTimelockDelay allows the authority to set the delay after which timelocked admin messages are executed.
+type MsgSetTimelockDelay struct {
+	Signer string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Delay  time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *MsgSetTimelockDelay) Reset()         { *m = MsgSetTimelockDelay{} }
+func (m *MsgSetTimelockDelay) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTimelockDelay) ProtoMessage()    {}
+func (*MsgSetTimelockDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{30}
+}
+func (m *MsgSetTimelockDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTimelockDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTimelockDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTimelockDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTimelockDelay.Merge(m, src)
+}
+func (m *MsgSetTimelockDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTimelockDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTimelockDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTimelockDelay proto.InternalMessageInfo
+
+// MsgSetTimelockDelayResponse is the response of the SetTimelockDelay message.
+type MsgSetTimelockDelayResponse struct {
+}
+
+func (m *MsgSetTimelockDelayResponse) Reset()         { *m = MsgSetTimelockDelayResponse{} }
+func (m *MsgSetTimelockDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTimelockDelayResponse) ProtoMessage()    {}
+func (*MsgSetTimelockDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{31}
+}
+func (m *MsgSetTimelockDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTimelockDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTimelockDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTimelockDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTimelockDelayResponse.Merge(m, src)
+}
+func (m *MsgSetTimelockDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTimelockDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTimelockDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTimelockDelayResponse proto.InternalMessageInfo
+
+// MsgCancelTimelockedMessage allows the authority to cancel a pending timelocked admin message.
+type MsgCancelTimelockedMessage struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelTimelockedMessage) Reset()         { *m = MsgCancelTimelockedMessage{} }
+func (m *MsgCancelTimelockedMessage) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedMessage) ProtoMessage()    {}
+func (*MsgCancelTimelockedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{32}
+}
+func (m *MsgCancelTimelockedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedMessage.Merge(m, src)
+}
+func (m *MsgCancelTimelockedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedMessage proto.InternalMessageInfo
+
+// MsgCancelTimelockedMessageResponse is the response of the CancelTimelockedMessage message.
+type MsgCancelTimelockedMessageResponse struct {
+}
+
+func (m *MsgCancelTimelockedMessageResponse) Reset()         { *m = MsgCancelTimelockedMessageResponse{} }
+func (m *MsgCancelTimelockedMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedMessageResponse) ProtoMessage()    {}
+func (*MsgCancelTimelockedMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{33}
+}
+func (m *MsgCancelTimelockedMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedMessageResponse.Merge(m, src)
+}
+func (m *MsgCancelTimelockedMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedMessageResponse proto.InternalMessageInfo
+
+// MsgSetIndex allows the authority, or an index operator, to set or correct the $M index in an emergency.
+type MsgSetIndex struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Index  int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSetIndex) Reset()         { *m = MsgSetIndex{} }
+func (m *MsgSetIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIndex) ProtoMessage()    {}
+func (*MsgSetIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{34}
+}
+func (m *MsgSetIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIndex.Merge(m, src)
+}
+func (m *MsgSetIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIndex proto.InternalMessageInfo
+
+// MsgSetIndexResponse is the response of the SetIndex message.
+type MsgSetIndexResponse struct {
+}
+
+func (m *MsgSetIndexResponse) Reset()         { *m = MsgSetIndexResponse{} }
+func (m *MsgSetIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIndexResponse) ProtoMessage()    {}
+func (*MsgSetIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{35}
+}
+func (m *MsgSetIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIndexResponse.Merge(m, src)
+}
+func (m *MsgSetIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIndexResponse proto.InternalMessageInfo
+
+// MsgSetMaxYieldRate allows the authority to set the maximum annual yield rate implied by index updates, above which they are quarantined.
+type MsgSetMaxYieldRate struct {
+	Signer string                      `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Rate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgSetMaxYieldRate) Reset()         { *m = MsgSetMaxYieldRate{} }
+func (m *MsgSetMaxYieldRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxYieldRate) ProtoMessage()    {}
+func (*MsgSetMaxYieldRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{36}
+}
+func (m *MsgSetMaxYieldRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxYieldRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxYieldRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxYieldRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxYieldRate.Merge(m, src)
+}
+func (m *MsgSetMaxYieldRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxYieldRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxYieldRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxYieldRate proto.InternalMessageInfo
+
+// MsgSetMaxYieldRateResponse is the response of the SetMaxYieldRate message.
+type MsgSetMaxYieldRateResponse struct {
+}
+
+func (m *MsgSetMaxYieldRateResponse) Reset()         { *m = MsgSetMaxYieldRateResponse{} }
+func (m *MsgSetMaxYieldRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxYieldRateResponse) ProtoMessage()    {}
+func (*MsgSetMaxYieldRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{37}
+}
+func (m *MsgSetMaxYieldRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxYieldRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxYieldRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxYieldRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxYieldRateResponse.Merge(m, src)
+}
+func (m *MsgSetMaxYieldRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxYieldRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxYieldRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxYieldRateResponse proto.InternalMessageInfo
+
+// MsgApprovePendingIndex allows the authority to approve the quarantined index of $M.
+type MsgApprovePendingIndex struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgApprovePendingIndex) Reset()         { *m = MsgApprovePendingIndex{} }
+func (m *MsgApprovePendingIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgApprovePendingIndex) ProtoMessage()    {}
+func (*MsgApprovePendingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{38}
+}
+func (m *MsgApprovePendingIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprovePendingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprovePendingIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApprovePendingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprovePendingIndex.Merge(m, src)
+}
+func (m *MsgApprovePendingIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprovePendingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprovePendingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApprovePendingIndex proto.InternalMessageInfo
+
+// MsgApprovePendingIndexResponse is the response of the ApprovePendingIndex message.
+type MsgApprovePendingIndexResponse struct {
+}
+
+func (m *MsgApprovePendingIndexResponse) Reset()         { *m = MsgApprovePendingIndexResponse{} }
+func (m *MsgApprovePendingIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApprovePendingIndexResponse) ProtoMessage()    {}
+func (*MsgApprovePendingIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{39}
+}
+func (m *MsgApprovePendingIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprovePendingIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprovePendingIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApprovePendingIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprovePendingIndexResponse.Merge(m, src)
+}
+func (m *MsgApprovePendingIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprovePendingIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprovePendingIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApprovePendingIndexResponse proto.InternalMessageInfo
+
+// MsgRejectPendingIndex allows the authority to reject the quarantined index of $M.
+type MsgRejectPendingIndex struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRejectPendingIndex) Reset()         { *m = MsgRejectPendingIndex{} }
+func (m *MsgRejectPendingIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPendingIndex) ProtoMessage()    {}
+func (*MsgRejectPendingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{40}
+}
+func (m *MsgRejectPendingIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectPendingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPendingIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectPendingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPendingIndex.Merge(m, src)
+}
+func (m *MsgRejectPendingIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectPendingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPendingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectPendingIndex proto.InternalMessageInfo
+
+// MsgRejectPendingIndexResponse is the response of the RejectPendingIndex message.
+type MsgRejectPendingIndexResponse struct {
+}
+
+func (m *MsgRejectPendingIndexResponse) Reset()         { *m = MsgRejectPendingIndexResponse{} }
+func (m *MsgRejectPendingIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPendingIndexResponse) ProtoMessage()    {}
+func (*MsgRejectPendingIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{41}
+}
+func (m *MsgRejectPendingIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectPendingIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPendingIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectPendingIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPendingIndexResponse.Merge(m, src)
+}
+func (m *MsgRejectPendingIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectPendingIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPendingIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectPendingIndexResponse proto.InternalMessageInfo
+
+// MsgSetIndexStaleness allows the authority to configure when the index is considered stale, and the behaviour applied while it is.
+type MsgSetIndexStaleness struct {
+	Signer          string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Threshold       time.Duration `protobuf:"bytes,2,opt,name=threshold,proto3,stdduration" json:"threshold"`
+	BlockVaultLocks bool          `protobuf:"varint,3,opt,name=block_vault_locks,json=blockVaultLocks,proto3" json:"block_vault_locks,omitempty"`
+}
+
+func (m *MsgSetIndexStaleness) Reset()         { *m = MsgSetIndexStaleness{} }
+func (m *MsgSetIndexStaleness) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIndexStaleness) ProtoMessage()    {}
+func (*MsgSetIndexStaleness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{42}
+}
+func (m *MsgSetIndexStaleness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIndexStaleness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIndexStaleness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIndexStaleness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIndexStaleness.Merge(m, src)
+}
+func (m *MsgSetIndexStaleness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIndexStaleness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIndexStaleness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIndexStaleness proto.InternalMessageInfo
+
+// MsgSetIndexStalenessResponse is the response of the SetIndexStaleness message.
+type MsgSetIndexStalenessResponse struct {
+}
+
+func (m *MsgSetIndexStalenessResponse) Reset()         { *m = MsgSetIndexStalenessResponse{} }
+func (m *MsgSetIndexStalenessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIndexStalenessResponse) ProtoMessage()    {}
+func (*MsgSetIndexStalenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{43}
+}
+func (m *MsgSetIndexStalenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIndexStalenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIndexStalenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIndexStalenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIndexStalenessResponse.Merge(m, src)
+}
+func (m *MsgSetIndexStalenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIndexStalenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIndexStalenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIndexStalenessResponse proto.InternalMessageInfo
+
+// MsgSetHaircutMode allows the authority to accept decreasing index updates, socializing the loss across all holders.
+type MsgSetHaircutMode struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetHaircutMode) Reset()         { *m = MsgSetHaircutMode{} }
+func (m *MsgSetHaircutMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHaircutMode) ProtoMessage()    {}
+func (*MsgSetHaircutMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{44}
+}
+func (m *MsgSetHaircutMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHaircutMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHaircutMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHaircutMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHaircutMode.Merge(m, src)
+}
+func (m *MsgSetHaircutMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHaircutMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHaircutMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHaircutMode proto.InternalMessageInfo
+
+// MsgSetHaircutModeResponse is the response of the SetHaircutMode message.
+type MsgSetHaircutModeResponse struct {
+}
+
+func (m *MsgSetHaircutModeResponse) Reset()         { *m = MsgSetHaircutModeResponse{} }
+func (m *MsgSetHaircutModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHaircutModeResponse) ProtoMessage()    {}
+func (*MsgSetHaircutModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2c07f53b93473db, []int{45}
+}
+func (m *MsgSetHaircutModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHaircutModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHaircutModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHaircutModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHaircutModeResponse.Merge(m, src)
+}
+func (m *MsgSetHaircutModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHaircutModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHaircutModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHaircutModeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetYieldRecipient)(nil), "noble.dollar.v2.MsgSetYieldRecipient")
+	proto.RegisterType((*MsgSetYieldRecipientResponse)(nil), "noble.dollar.v2.MsgSetYieldRecipientResponse")
+	proto.RegisterType((*MsgSetYieldClaimRecipient)(nil), "noble.dollar.v2.MsgSetYieldClaimRecipient")
+	proto.RegisterType((*MsgSetYieldClaimRecipientResponse)(nil), "noble.dollar.v2.MsgSetYieldClaimRecipientResponse")
+	proto.RegisterType((*MsgSetYieldSplit)(nil), "noble.dollar.v2.MsgSetYieldSplit")
+	proto.RegisterType((*MsgSetYieldSplitResponse)(nil), "noble.dollar.v2.MsgSetYieldSplitResponse")
+	proto.RegisterType((*MsgSetAutoClaim)(nil), "noble.dollar.v2.MsgSetAutoClaim")
+	proto.RegisterType((*MsgSetAutoClaimResponse)(nil), "noble.dollar.v2.MsgSetAutoClaimResponse")
+	proto.RegisterType((*MsgClaimYieldFor)(nil), "noble.dollar.v2.MsgClaimYieldFor")
+	proto.RegisterType((*MsgClaimYieldForResponse)(nil), "noble.dollar.v2.MsgClaimYieldForResponse")
+	proto.RegisterType((*MsgSetClaimTip)(nil), "noble.dollar.v2.MsgSetClaimTip")
+	proto.RegisterType((*MsgSetClaimTipResponse)(nil), "noble.dollar.v2.MsgSetClaimTipResponse")
+	proto.RegisterType((*MsgSetNonEarningAccount)(nil), "noble.dollar.v2.MsgSetNonEarningAccount")
+	proto.RegisterType((*MsgSetNonEarningAccountResponse)(nil), "noble.dollar.v2.MsgSetNonEarningAccountResponse")
+	proto.RegisterType((*MsgSetTreasury)(nil), "noble.dollar.v2.MsgSetTreasury")
+	proto.RegisterType((*MsgSetTreasuryResponse)(nil), "noble.dollar.v2.MsgSetTreasuryResponse")
+	proto.RegisterType((*MsgReconcileStats)(nil), "noble.dollar.v2.MsgReconcileStats")
+	proto.RegisterType((*MsgReconcileStatsResponse)(nil), "noble.dollar.v2.MsgReconcileStatsResponse")
+	proto.RegisterType((*MsgSetFee)(nil), "noble.dollar.v2.MsgSetFee")
+	proto.RegisterType((*MsgSetFeeResponse)(nil), "noble.dollar.v2.MsgSetFeeResponse")
+	proto.RegisterType((*MsgSetFrozenAccount)(nil), "noble.dollar.v2.MsgSetFrozenAccount")
+	proto.RegisterType((*MsgSetFrozenAccountResponse)(nil), "noble.dollar.v2.MsgSetFrozenAccountResponse")
+	proto.RegisterType((*MsgClaimFrozenYield)(nil), "noble.dollar.v2.MsgClaimFrozenYield")
+	proto.RegisterType((*MsgClaimFrozenYieldResponse)(nil), "noble.dollar.v2.MsgClaimFrozenYieldResponse")
+	proto.RegisterType((*MsgSetPausedType)(nil), "noble.dollar.v2.MsgSetPausedType")
+	proto.RegisterType((*MsgSetPausedTypeResponse)(nil), "noble.dollar.v2.MsgSetPausedTypeResponse")
+	proto.RegisterType((*MsgGrantRole)(nil), "noble.dollar.v2.MsgGrantRole")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "noble.dollar.v2.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRole)(nil), "noble.dollar.v2.MsgRevokeRole")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "noble.dollar.v2.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgSetTimelockDelay)(nil), "noble.dollar.v2.MsgSetTimelockDelay")
+	proto.RegisterType((*MsgSetTimelockDelayResponse)(nil), "noble.dollar.v2.MsgSetTimelockDelayResponse")
+	proto.RegisterType((*MsgCancelTimelockedMessage)(nil), "noble.dollar.v2.MsgCancelTimelockedMessage")
+	proto.RegisterType((*MsgCancelTimelockedMessageResponse)(nil), "noble.dollar.v2.MsgCancelTimelockedMessageResponse")
+	proto.RegisterType((*MsgSetIndex)(nil), "noble.dollar.v2.MsgSetIndex")
+	proto.RegisterType((*MsgSetIndexResponse)(nil), "noble.dollar.v2.MsgSetIndexResponse")
+	proto.RegisterType((*MsgSetMaxYieldRate)(nil), "noble.dollar.v2.MsgSetMaxYieldRate")
+	proto.RegisterType((*MsgSetMaxYieldRateResponse)(nil), "noble.dollar.v2.MsgSetMaxYieldRateResponse")
+	proto.RegisterType((*MsgApprovePendingIndex)(nil), "noble.dollar.v2.MsgApprovePendingIndex")
+	proto.RegisterType((*MsgApprovePendingIndexResponse)(nil), "noble.dollar.v2.MsgApprovePendingIndexResponse")
+	proto.RegisterType((*MsgRejectPendingIndex)(nil), "noble.dollar.v2.MsgRejectPendingIndex")
+	proto.RegisterType((*MsgRejectPendingIndexResponse)(nil), "noble.dollar.v2.MsgRejectPendingIndexResponse")
+	proto.RegisterType((*MsgSetIndexStaleness)(nil), "noble.dollar.v2.MsgSetIndexStaleness")
+	proto.RegisterType((*MsgSetIndexStalenessResponse)(nil), "noble.dollar.v2.MsgSetIndexStalenessResponse")
+	proto.RegisterType((*MsgSetHaircutMode)(nil), "noble.dollar.v2.MsgSetHaircutMode")
+	proto.RegisterType((*MsgSetHaircutModeResponse)(nil), "noble.dollar.v2.MsgSetHaircutModeResponse")
+}
+
+func init() { proto.RegisterFile("noble/dollar/v2/tx.proto", fileDescriptor_a2c07f53b93473db) }
+
+var fileDescriptor_a2c07f53b93473db = []byte{
+	// 1835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6c, 0xdc, 0x4a,
+	0x19, 0x8f, 0x93, 0x34, 0x2f, 0xf9, 0xf2, 0xf2, 0x6f, 0xf3, 0x6f, 0xe3, 0x24, 0xbb, 0xa9, 0x5f,
+	0x42, 0x93, 0x6d, 0xb2, 0x9b, 0xb7, 0xe1, 0x45, 0xb0, 0x07, 0xa4, 0x84, 0x10, 0x5e, 0xd1, 0xdb,
+	0xa7, 0xe2, 0x44, 0x20, 0x2a, 0xa1, 0xe0, 0xd8, 0x13, 0xc7, 0xd4, 0xeb, 0x59, 0xd9, 0xde, 0x28,
+	0x29, 0x08, 0x55, 0x9c, 0x50, 0x0f, 0xa8, 0x27, 0xc4, 0xb1, 0x07, 0x0e, 0x3d, 0x20, 0x54, 0x41,
+	0x8f, 0x9c, 0x10, 0x87, 0x22, 0x2e, 0x55, 0x25, 0x24, 0xc4, 0xa1, 0xa0, 0xf6, 0x50, 0x38, 0x71,
+	0x46, 0x5c, 0x90, 0xc7, 0xe3, 0xf1, 0x9f, 0xf5, 0xec, 0x6e, 0x5d, 0x54, 0xbd, 0x4b, 0xbb, 0x33,
+	0xf3, 0x9b, 0xef, 0xfb, 0xfd, 0x66, 0xbe, 0xf9, 0xfc, 0xcd, 0x04, 0xf2, 0x16, 0x3e, 0x35, 0x51,
+	0x45, 0xc3, 0xa6, 0xa9, 0xd8, 0x95, 0x8b, 0x6a, 0xc5, 0xbd, 0x2c, 0x37, 0x6d, 0xec, 0xe2, 0xdc,
+	0x04, 0x19, 0x29, 0xfb, 0x23, 0xe5, 0x8b, 0xaa, 0x38, 0xa5, 0x34, 0x0c, 0x0b, 0x57, 0xc8, 0xbf,
+	0x3e, 0x46, 0x9c, 0x57, 0xb1, 0xd3, 0xc0, 0x4e, 0xa5, 0xe1, 0xe8, 0x95, 0x8b, 0x8f, 0xbd, 0xff,
+	0xe8, 0xc0, 0x82, 0x3f, 0x70, 0x42, 0x5a, 0x15, 0xbf, 0x41, 0x87, 0x66, 0x74, 0xac, 0x63, 0xbf,
+	0xdf, 0xfb, 0x45, 0x7b, 0x0b, 0x3a, 0xc6, 0xba, 0x89, 0x2a, 0xa4, 0x75, 0xda, 0x3a, 0xab, 0x68,
+	0x2d, 0x5b, 0x71, 0x0d, 0x6c, 0xd1, 0xf1, 0x62, 0x72, 0xdc, 0x35, 0x1a, 0xc8, 0x71, 0x95, 0x46,
+	0x93, 0x02, 0x96, 0x92, 0x42, 0x28, 0x71, 0x32, 0x2a, 0xfd, 0x5b, 0x80, 0x99, 0xba, 0xa3, 0x1f,
+	0x21, 0xf7, 0x7b, 0x06, 0x32, 0x35, 0x19, 0xa9, 0x46, 0xd3, 0x40, 0x96, 0x9b, 0xdb, 0x86, 0x21,
+	0xc7, 0xd0, 0x2d, 0x64, 0xe7, 0x85, 0x15, 0x61, 0x7d, 0x64, 0x3f, 0xff, 0xe2, 0xe9, 0xd6, 0x0c,
+	0xe5, 0xbb, 0xa7, 0x69, 0x36, 0x72, 0x9c, 0x23, 0xd7, 0x36, 0x2c, 0x5d, 0xa6, 0xb8, 0xdc, 0x27,
+	0x30, 0xdc, 0xb4, 0xf1, 0x85, 0xa1, 0x21, 0x3b, 0xdf, 0xbf, 0x22, 0xac, 0x8f, 0x57, 0x17, 0xca,
+	0x89, 0xa5, 0x2a, 0xdf, 0xa6, 0x00, 0x99, 0x41, 0x73, 0x05, 0x00, 0x43, 0x43, 0x96, 0x6b, 0x9c,
+	0x19, 0xc8, 0xce, 0x0f, 0x78, 0xce, 0xe4, 0x48, 0x4f, 0x6e, 0x09, 0x46, 0xec, 0x80, 0x55, 0x7e,
+	0x90, 0x0c, 0x87, 0x1d, 0xb5, 0xed, 0x9f, 0x3d, 0x2a, 0xf6, 0xfd, 0xf3, 0x51, 0xb1, 0xef, 0xa7,
+	0x6f, 0x9e, 0x94, 0x28, 0x93, 0x07, 0x6f, 0x9e, 0x94, 0xf2, 0x54, 0x6f, 0x9b, 0x30, 0xa9, 0x00,
+	0x4b, 0x69, 0x82, 0x65, 0xe4, 0x34, 0xb1, 0xe5, 0x20, 0xe9, 0xf7, 0x02, 0x2c, 0x44, 0x00, 0x5f,
+	0x37, 0x15, 0xa3, 0xf1, 0x2e, 0xcb, 0xb2, 0x1b, 0xe5, 0xdf, 0xdf, 0x65, 0x52, 0x44, 0xd9, 0x27,
+	0x1c, 0x65, 0xcb, 0x09, 0x65, 0x71, 0x82, 0xd2, 0x47, 0x70, 0x9d, 0xcb, 0x9e, 0x69, 0xfc, 0xad,
+	0x00, 0x93, 0x11, 0xd4, 0x51, 0xd3, 0x34, 0xb2, 0x48, 0xfb, 0x1a, 0x0c, 0x39, 0xe7, 0x8a, 0x8d,
+	0x9c, 0x7c, 0xff, 0xca, 0xc0, 0xfa, 0x68, 0x75, 0xb1, 0x6d, 0xbf, 0x7d, 0xf3, 0x1e, 0x66, 0x7f,
+	0xe4, 0xd9, 0xcb, 0x62, 0xdf, 0xe3, 0x37, 0x4f, 0x4a, 0x82, 0x4c, 0x67, 0xd5, 0x36, 0x39, 0x12,
+	0x67, 0x12, 0x12, 0x09, 0x3f, 0x49, 0x84, 0x7c, 0x92, 0x33, 0x13, 0xf4, 0x40, 0x80, 0x09, 0x7f,
+	0x70, 0xaf, 0xe5, 0x62, 0xa2, 0x3a, 0x83, 0x9e, 0x3c, 0x7c, 0x80, 0x2c, 0xe5, 0xd4, 0x44, 0x1a,
+	0xd9, 0xa8, 0x61, 0x39, 0x68, 0xd6, 0x6e, 0x72, 0x98, 0x4e, 0x87, 0x4c, 0x99, 0x63, 0x69, 0x01,
+	0xe6, 0x13, 0x5c, 0x18, 0xcf, 0xc7, 0xfe, 0xc2, 0x93, 0x4e, 0x22, 0xe3, 0x10, 0xdb, 0x19, 0x88,
+	0x7e, 0x19, 0x86, 0x15, 0x55, 0xc5, 0x2d, 0xcb, 0xf5, 0x97, 0xbe, 0xd3, 0x1c, 0x86, 0xec, 0xba,
+	0xdc, 0x31, 0x56, 0xde, 0x39, 0xc8, 0x27, 0xa9, 0x06, 0x3a, 0x72, 0xdf, 0x86, 0x51, 0x17, 0xbb,
+	0x8a, 0x79, 0x72, 0xe5, 0x8d, 0x50, 0xde, 0xdb, 0xde, 0x0e, 0xff, 0xed, 0x65, 0x71, 0xd6, 0xe7,
+	0xe1, 0x68, 0x77, 0xcb, 0x06, 0xae, 0x34, 0x14, 0xf7, 0xbc, 0x7c, 0xcb, 0x72, 0x5f, 0x3c, 0xdd,
+	0x02, 0x4a, 0xf0, 0x96, 0xe5, 0xfa, 0x81, 0x00, 0xc4, 0x08, 0xb1, 0x9e, 0xab, 0xc3, 0x88, 0x6f,
+	0xd2, 0x35, 0x9a, 0xf9, 0xfe, 0x8c, 0x06, 0x87, 0x89, 0x89, 0x63, 0xa3, 0x29, 0xdd, 0x17, 0x60,
+	0xdc, 0xdf, 0x05, 0xa2, 0xe0, 0xd8, 0x68, 0x66, 0x58, 0xe7, 0x49, 0x18, 0x08, 0xd8, 0x8c, 0xc9,
+	0xde, 0xcf, 0x5a, 0x89, 0xb3, 0x86, 0xb9, 0x30, 0x10, 0x02, 0x7f, 0x52, 0x1e, 0xe6, 0xe2, 0x0c,
+	0x58, 0x18, 0xfc, 0x45, 0x08, 0x42, 0xe4, 0x73, 0x6c, 0x7d, 0x43, 0xb1, 0x2d, 0xc3, 0xd2, 0xf7,
+	0xfc, 0x6d, 0xca, 0xc0, 0xb2, 0x0a, 0x1f, 0xd0, 0x3d, 0xee, 0x9a, 0x5f, 0x02, 0x60, 0xae, 0x08,
+	0xa3, 0x16, 0xb6, 0x4e, 0x90, 0xef, 0x9b, 0xa4, 0xdd, 0x61, 0x19, 0x2c, 0xc6, 0xa6, 0xb6, 0xc3,
+	0x11, 0xba, 0x18, 0x0a, 0x6d, 0xe3, 0x2e, 0x5d, 0x87, 0x22, 0x47, 0x16, 0x93, 0xfe, 0x2b, 0xb6,
+	0x2f, 0xc7, 0x36, 0x52, 0x9c, 0x96, 0x7d, 0x95, 0x2d, 0xfe, 0x5d, 0x3a, 0xbb, 0xab, 0x64, 0x86,
+	0xec, 0x65, 0xef, 0x02, 0x4e, 0xe1, 0xde, 0x05, 0x3d, 0x4c, 0x80, 0x0b, 0x53, 0x75, 0x47, 0x97,
+	0x91, 0x8a, 0x2d, 0xd5, 0x30, 0xd1, 0x91, 0xab, 0xb8, 0xce, 0xdb, 0x4b, 0xa8, 0x6d, 0x71, 0xc8,
+	0xcc, 0x52, 0x32, 0x71, 0x07, 0xd2, 0x22, 0xf9, 0x28, 0xc5, 0x3b, 0x19, 0xa5, 0xdf, 0x08, 0x30,
+	0xe2, 0xb3, 0x3d, 0x44, 0x28, 0x5b, 0x98, 0x9f, 0x21, 0x14, 0x84, 0xf9, 0x19, 0x42, 0xde, 0x47,
+	0x4b, 0xc5, 0xa6, 0x89, 0x54, 0x17, 0xd3, 0x6f, 0x72, 0xa7, 0x8f, 0x16, 0x83, 0xd6, 0xd6, 0x38,
+	0xaa, 0xc6, 0xc2, 0x25, 0x3e, 0x44, 0x48, 0x9a, 0x26, 0x6b, 0xe8, 0x37, 0x98, 0x8a, 0x3f, 0x0a,
+	0x30, 0x4d, 0x7b, 0x6d, 0x7c, 0x0f, 0x59, 0xef, 0xf7, 0x40, 0xcc, 0xc1, 0xd0, 0x19, 0x71, 0x4b,
+	0xcf, 0x02, 0x6d, 0xd5, 0x2a, 0x1c, 0x45, 0xf3, 0x11, 0x45, 0x51, 0xba, 0xd2, 0x32, 0x2c, 0xa6,
+	0xa8, 0x60, 0x2a, 0x7f, 0xed, 0xab, 0x24, 0x29, 0xc1, 0x47, 0xf8, 0xe9, 0xef, 0xbd, 0xa8, 0xec,
+	0xaa, 0x26, 0x49, 0x4b, 0xd2, 0x89, 0x9a, 0x64, 0x37, 0xfb, 0x0e, 0x7c, 0x0a, 0x43, 0x4a, 0x83,
+	0x50, 0xc8, 0xfa, 0x09, 0xa0, 0xf3, 0xa5, 0xff, 0xb0, 0x92, 0xe4, 0xb6, 0xd2, 0x72, 0x90, 0x76,
+	0x7c, 0xd5, 0xcc, 0x12, 0xca, 0x3b, 0x30, 0xd4, 0x24, 0xf3, 0x69, 0x09, 0xda, 0x5e, 0x92, 0x84,
+	0xe6, 0x65, 0x0a, 0xcd, 0x7d, 0x05, 0x86, 0xd0, 0x65, 0xd3, 0xb0, 0xaf, 0xc8, 0xde, 0x8f, 0x56,
+	0xc5, 0xb2, 0x5f, 0x54, 0x97, 0x83, 0xa2, 0xba, 0x7c, 0x1c, 0x14, 0xd5, 0xfb, 0x83, 0x0f, 0xff,
+	0x5e, 0x14, 0x64, 0x8a, 0xf7, 0xa2, 0xc6, 0xcb, 0x0f, 0xd8, 0xa2, 0x95, 0x29, 0x6d, 0xf5, 0x52,
+	0xd9, 0x84, 0x3c, 0xc2, 0xca, 0x26, 0xc2, 0x2d, 0x88, 0x97, 0x3f, 0x09, 0xf0, 0x61, 0xdd, 0xd1,
+	0xbf, 0x69, 0x2b, 0x96, 0x2b, 0x63, 0x13, 0xbd, 0xa7, 0xe3, 0xb0, 0x01, 0x83, 0x36, 0x36, 0x11,
+	0x59, 0x90, 0xf1, 0xea, 0x6c, 0xdb, 0x2a, 0x7a, 0x54, 0x64, 0x02, 0xa9, 0xad, 0x73, 0xb4, 0x4e,
+	0x52, 0xad, 0x8c, 0xba, 0x34, 0x47, 0xee, 0x1a, 0xac, 0xcd, 0x34, 0xfe, 0x59, 0x80, 0x31, 0x92,
+	0xdd, 0x2e, 0xf0, 0x5d, 0xf4, 0xc5, 0x14, 0xb9, 0xc1, 0x11, 0x39, 0xc5, 0xd2, 0x75, 0xc0, 0x5d,
+	0x9a, 0x87, 0xd9, 0x98, 0x18, 0x26, 0xf3, 0x77, 0x2c, 0xc1, 0x79, 0xe1, 0x64, 0x62, 0xf5, 0xee,
+	0x01, 0x32, 0x95, 0x2c, 0xdf, 0xbf, 0xaf, 0xc2, 0x35, 0xcd, 0x9b, 0x4a, 0xa4, 0x8e, 0x56, 0x17,
+	0xda, 0xe2, 0xf5, 0x80, 0x5e, 0x12, 0xf7, 0x87, 0xbd, 0x03, 0xf9, 0x4b, 0x2f, 0x64, 0xfd, 0x19,
+	0xbd, 0xe4, 0xb3, 0x18, 0xbb, 0x30, 0x9f, 0xc5, 0xba, 0x99, 0xa8, 0x5f, 0x08, 0x20, 0x7a, 0x19,
+	0x42, 0xb1, 0x54, 0x64, 0x06, 0x10, 0xa4, 0xd5, 0x91, 0xe3, 0x28, 0x7a, 0x96, 0x8d, 0x1c, 0x87,
+	0x7e, 0xc3, 0x3f, 0xbd, 0x83, 0x72, 0xbf, 0xa1, 0xd5, 0x76, 0x39, 0x84, 0x0b, 0x41, 0xca, 0x4a,
+	0xf7, 0x2c, 0xad, 0x82, 0xc4, 0xe7, 0x15, 0xa5, 0x3f, 0xea, 0xcb, 0xbb, 0x65, 0x69, 0xe8, 0x32,
+	0x03, 0xdf, 0x19, 0xb8, 0x66, 0x78, 0x53, 0x09, 0xe5, 0x01, 0xd9, 0x6f, 0x44, 0x12, 0xc3, 0x40,
+	0x2c, 0x31, 0xdc, 0xe0, 0xa8, 0x99, 0x08, 0x97, 0x9f, 0x10, 0x91, 0x66, 0x83, 0x58, 0x21, 0x4d,
+	0xc6, 0xf7, 0x0f, 0x02, 0xe4, 0xfc, 0xfe, 0xba, 0x72, 0xe9, 0xe7, 0x62, 0xc5, 0xcd, 0xb2, 0xcc,
+	0xdf, 0x82, 0x41, 0x5b, 0x71, 0x11, 0x3d, 0x2c, 0xbb, 0x34, 0x6f, 0x2f, 0xb6, 0xe7, 0xed, 0xcf,
+	0x90, 0xae, 0xa8, 0x57, 0x07, 0x48, 0x8d, 0x64, 0xef, 0x03, 0xa4, 0xfa, 0xd9, 0x9b, 0xd8, 0xa8,
+	0x95, 0x39, 0xa2, 0xe6, 0x42, 0x51, 0x51, 0xb6, 0xd2, 0x12, 0x09, 0x99, 0x44, 0x2f, 0x93, 0xf8,
+	0x13, 0x52, 0x7a, 0xed, 0x35, 0xbd, 0x17, 0x02, 0x74, 0x1b, 0x59, 0x9a, 0x61, 0xe9, 0x19, 0x37,
+	0xa7, 0x56, 0xe5, 0x30, 0x13, 0x29, 0xb3, 0x14, 0x2f, 0xd2, 0x0a, 0x14, 0xd2, 0xfd, 0x33, 0x86,
+	0x3f, 0xa6, 0x27, 0xfc, 0x87, 0x48, 0x75, 0xdf, 0x91, 0xe0, 0xc7, 0x1c, 0x82, 0x0b, 0x2c, 0xaf,
+	0x24, 0x9d, 0x48, 0x45, 0x58, 0x4e, 0xf5, 0xce, 0xe8, 0xfd, 0x8b, 0xbd, 0xe9, 0x90, 0xfe, 0x23,
+	0x57, 0x31, 0x91, 0x85, 0x9c, 0x0c, 0x55, 0x6a, 0x6e, 0x0f, 0x46, 0xdc, 0x73, 0x1b, 0x39, 0xe7,
+	0xd8, 0xd4, 0xde, 0x26, 0xd9, 0x84, 0xb3, 0x72, 0x25, 0x98, 0x3a, 0xf5, 0xce, 0xde, 0xc9, 0x85,
+	0xd2, 0x32, 0xdd, 0x13, 0xef, 0xa7, 0x43, 0x6b, 0xac, 0x09, 0x32, 0xf0, 0x1d, 0xaf, 0xff, 0x33,
+	0xaf, 0xbb, 0x97, 0xd7, 0x9c, 0xb8, 0xa4, 0xf0, 0x35, 0x27, 0xde, 0xcf, 0xd6, 0xe2, 0xe7, 0x42,
+	0x50, 0x6a, 0x7e, 0xaa, 0x18, 0xb6, 0xda, 0x72, 0xeb, 0x58, 0x43, 0xff, 0xd7, 0xa7, 0x81, 0x6e,
+	0x85, 0x7c, 0xdc, 0x35, 0x2d, 0xe4, 0xe3, 0x9d, 0x01, 0xdb, 0xea, 0x7f, 0xa7, 0x60, 0xa0, 0xee,
+	0xe8, 0x39, 0x03, 0xa6, 0xda, 0x5f, 0xe4, 0xd6, 0xda, 0xbe, 0x4f, 0x69, 0xef, 0x58, 0xe2, 0x56,
+	0x4f, 0x30, 0x56, 0xc1, 0x5d, 0xc2, 0x1c, 0xe7, 0xa9, 0xab, 0xd4, 0xc9, 0x50, 0x1c, 0x2b, 0x56,
+	0x7b, 0xc7, 0x32, 0xcf, 0xdf, 0x87, 0xb1, 0xf8, 0x03, 0xd4, 0xf5, 0x4e, 0x46, 0x08, 0x44, 0xdc,
+	0xe8, 0x0a, 0x61, 0xe6, 0xef, 0xc0, 0x87, 0xb1, 0xe7, 0xa0, 0x15, 0xce, 0x54, 0x86, 0x10, 0xd7,
+	0xbb, 0x21, 0xa2, 0xd4, 0xe3, 0x4f, 0x38, 0xa9, 0xd4, 0x63, 0x10, 0x71, 0xa3, 0x2b, 0x84, 0x99,
+	0xb7, 0x61, 0x26, 0xf5, 0x69, 0x80, 0x47, 0xb0, 0x0d, 0x29, 0x6e, 0xf7, 0x8a, 0x64, 0x3e, 0xbf,
+	0x0b, 0xa3, 0xd1, 0x3b, 0x79, 0x91, 0x63, 0x20, 0x00, 0x88, 0x37, 0xba, 0x00, 0x98, 0xe1, 0x1f,
+	0xc0, 0x78, 0xe2, 0xb2, 0x2c, 0xa5, 0x4d, 0x8d, 0x63, 0xc4, 0x52, 0x77, 0x4c, 0x82, 0x3a, 0x7b,
+	0xe6, 0xe1, 0x51, 0x0f, 0x00, 0xe2, 0x8d, 0x2e, 0x80, 0x44, 0x84, 0x46, 0xee, 0x23, 0xbc, 0x08,
+	0x0d, 0x21, 0xe2, 0x46, 0x57, 0x48, 0xf4, 0xf2, 0x44, 0xaf, 0xec, 0x22, 0x67, 0xd2, 0x21, 0x42,
+	0xa2, 0xc4, 0x1f, 0x63, 0x96, 0xce, 0x60, 0xb2, 0xed, 0xda, 0xbc, 0xca, 0x9b, 0x17, 0x45, 0x89,
+	0x9b, 0xbd, 0xa0, 0xa2, 0x7e, 0xda, 0x2e, 0xae, 0xab, 0xdc, 0xb8, 0x8e, 0xa0, 0xc4, 0xcd, 0x5e,
+	0x50, 0x91, 0xe7, 0xc5, 0x91, 0xf0, 0xc2, 0xb3, 0x9c, 0x36, 0x95, 0x0d, 0x8b, 0x6b, 0x1d, 0x87,
+	0x99, 0xc9, 0x63, 0x80, 0xc8, 0xfd, 0xa2, 0x90, 0x1e, 0x5e, 0xc1, 0xb8, 0xf8, 0xa5, 0xce, 0xe3,
+	0x89, 0x85, 0x8f, 0x97, 0xf3, 0xbc, 0x85, 0x8f, 0xa1, 0xc4, 0xcd, 0x5e, 0x50, 0xcc, 0xcf, 0x8f,
+	0x60, 0x9e, 0x57, 0x61, 0xdf, 0x4c, 0x5d, 0xd9, 0x74, 0xb0, 0xb8, 0xf3, 0x16, 0x60, 0xe6, 0xfc,
+	0x73, 0x18, 0x66, 0xf5, 0xf1, 0x12, 0x87, 0x36, 0x19, 0x15, 0x57, 0x3b, 0x8d, 0x32, 0x7b, 0x2a,
+	0x4c, 0x24, 0xeb, 0xd7, 0x8f, 0x38, 0x13, 0xa3, 0x20, 0xf1, 0x66, 0x0f, 0x20, 0xe6, 0x04, 0xc3,
+	0x74, 0x5a, 0x09, 0x99, 0x7a, 0xf6, 0x53, 0x80, 0x62, 0xa5, 0x47, 0x20, 0x73, 0x68, 0x42, 0x2e,
+	0xa5, 0x22, 0xe4, 0x04, 0x52, 0x12, 0x27, 0x96, 0x7b, 0xc3, 0x31, 0x6f, 0x7e, 0x85, 0x90, 0xa8,
+	0xef, 0xd6, 0x3a, 0x2d, 0x3f, 0x83, 0x89, 0x5b, 0x3d, 0xc1, 0xa2, 0x09, 0x3c, 0x51, 0x3e, 0xf1,
+	0x52, 0x52, 0x04, 0x23, 0x96, 0xba, 0x63, 0x02, 0x0f, 0xe2, 0xb5, 0xfb, 0xde, 0x65, 0x62, 0x7f,
+	0xf7, 0xd9, 0xab, 0x82, 0xf0, 0xfc, 0x55, 0x41, 0xf8, 0xc7, 0xab, 0x82, 0xf0, 0xf0, 0x75, 0xa1,
+	0xef, 0xf9, 0xeb, 0x42, 0xdf, 0x5f, 0x5f, 0x17, 0xfa, 0xee, 0x2c, 0x51, 0x2b, 0xbe, 0xc9, 0xcb,
+	0xab, 0x7b, 0xe4, 0x0f, 0xb2, 0x57, 0x4d, 0xe4, 0x54, 0x2e, 0xaa, 0xa7, 0x43, 0xa4, 0x12, 0xdd,
+	0xf9, 0xdf, 0x00, 0x94, 0x99, 0xb3, 0x19, 0xb3, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipient, opts ...grpc.CallOption) (*MsgSetYieldRecipientResponse, error)
+	SetYieldClaimRecipient(ctx context.Context, in *MsgSetYieldClaimRecipient, opts ...grpc.CallOption) (*MsgSetYieldClaimRecipientResponse, error)
+	SetYieldSplit(ctx context.Context, in *MsgSetYieldSplit, opts ...grpc.CallOption) (*MsgSetYieldSplitResponse, error)
+	SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error)
+	ClaimYieldFor(ctx context.Context, in *MsgClaimYieldFor, opts ...grpc.CallOption) (*MsgClaimYieldForResponse, error)
+	SetNonEarningAccount(ctx context.Context, in *MsgSetNonEarningAccount, opts ...grpc.CallOption) (*MsgSetNonEarningAccountResponse, error)
+	SetTreasury(ctx context.Context, in *MsgSetTreasury, opts ...grpc.CallOption) (*MsgSetTreasuryResponse, error)
+	ReconcileStats(ctx context.Context, in *MsgReconcileStats, opts ...grpc.CallOption) (*MsgReconcileStatsResponse, error)
+	SetClaimTip(ctx context.Context, in *MsgSetClaimTip, opts ...grpc.CallOption) (*MsgSetClaimTipResponse, error)
+	SetPausedType(ctx context.Context, in *MsgSetPausedType, opts ...grpc.CallOption) (*MsgSetPausedTypeResponse, error)
+	SetFee(ctx context.Context, in *MsgSetFee, opts ...grpc.CallOption) (*MsgSetFeeResponse, error)
+	SetFrozenAccount(ctx context.Context, in *MsgSetFrozenAccount, opts ...grpc.CallOption) (*MsgSetFrozenAccountResponse, error)
+	ClaimFrozenYield(ctx context.Context, in *MsgClaimFrozenYield, opts ...grpc.CallOption) (*MsgClaimFrozenYieldResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	SetTimelockDelay(ctx context.Context, in *MsgSetTimelockDelay, opts ...grpc.CallOption) (*MsgSetTimelockDelayResponse, error)
+	CancelTimelockedMessage(ctx context.Context, in *MsgCancelTimelockedMessage, opts ...grpc.CallOption) (*MsgCancelTimelockedMessageResponse, error)
+	SetIndex(ctx context.Context, in *MsgSetIndex, opts ...grpc.CallOption) (*MsgSetIndexResponse, error)
+	SetMaxYieldRate(ctx context.Context, in *MsgSetMaxYieldRate, opts ...grpc.CallOption) (*MsgSetMaxYieldRateResponse, error)
+	ApprovePendingIndex(ctx context.Context, in *MsgApprovePendingIndex, opts ...grpc.CallOption) (*MsgApprovePendingIndexResponse, error)
+	RejectPendingIndex(ctx context.Context, in *MsgRejectPendingIndex, opts ...grpc.CallOption) (*MsgRejectPendingIndexResponse, error)
+	SetIndexStaleness(ctx context.Context, in *MsgSetIndexStaleness, opts ...grpc.CallOption) (*MsgSetIndexStalenessResponse, error)
+	SetHaircutMode(ctx context.Context, in *MsgSetHaircutMode, opts ...grpc.CallOption) (*MsgSetHaircutModeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetYieldRecipient(ctx context.Context, in *MsgSetYieldRecipient, opts ...grpc.CallOption) (*MsgSetYieldRecipientResponse, error) {
+	out := new(MsgSetYieldRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetYieldRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetYieldClaimRecipient(ctx context.Context, in *MsgSetYieldClaimRecipient, opts ...grpc.CallOption) (*MsgSetYieldClaimRecipientResponse, error) {
+	out := new(MsgSetYieldClaimRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetYieldClaimRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetYieldSplit(ctx context.Context, in *MsgSetYieldSplit, opts ...grpc.CallOption) (*MsgSetYieldSplitResponse, error) {
+	out := new(MsgSetYieldSplitResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetYieldSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoClaim(ctx context.Context, in *MsgSetAutoClaim, opts ...grpc.CallOption) (*MsgSetAutoClaimResponse, error) {
+	out := new(MsgSetAutoClaimResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetAutoClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimYieldFor(ctx context.Context, in *MsgClaimYieldFor, opts ...grpc.CallOption) (*MsgClaimYieldForResponse, error) {
+	out := new(MsgClaimYieldForResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/ClaimYieldFor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNonEarningAccount(ctx context.Context, in *MsgSetNonEarningAccount, opts ...grpc.CallOption) (*MsgSetNonEarningAccountResponse, error) {
+	out := new(MsgSetNonEarningAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetNonEarningAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTreasury(ctx context.Context, in *MsgSetTreasury, opts ...grpc.CallOption) (*MsgSetTreasuryResponse, error) {
+	out := new(MsgSetTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReconcileStats(ctx context.Context, in *MsgReconcileStats, opts ...grpc.CallOption) (*MsgReconcileStatsResponse, error) {
+	out := new(MsgReconcileStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/ReconcileStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClaimTip(ctx context.Context, in *MsgSetClaimTip, opts ...grpc.CallOption) (*MsgSetClaimTipResponse, error) {
+	out := new(MsgSetClaimTipResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetClaimTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPausedType(ctx context.Context, in *MsgSetPausedType, opts ...grpc.CallOption) (*MsgSetPausedTypeResponse, error) {
+	out := new(MsgSetPausedTypeResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetPausedType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFee(ctx context.Context, in *MsgSetFee, opts ...grpc.CallOption) (*MsgSetFeeResponse, error) {
+	out := new(MsgSetFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFrozenAccount(ctx context.Context, in *MsgSetFrozenAccount, opts ...grpc.CallOption) (*MsgSetFrozenAccountResponse, error) {
+	out := new(MsgSetFrozenAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetFrozenAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimFrozenYield(ctx context.Context, in *MsgClaimFrozenYield, opts ...grpc.CallOption) (*MsgClaimFrozenYieldResponse, error) {
+	out := new(MsgClaimFrozenYieldResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/ClaimFrozenYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTimelockDelay(ctx context.Context, in *MsgSetTimelockDelay, opts ...grpc.CallOption) (*MsgSetTimelockDelayResponse, error) {
+	out := new(MsgSetTimelockDelayResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetTimelockDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTimelockedMessage(ctx context.Context, in *MsgCancelTimelockedMessage, opts ...grpc.CallOption) (*MsgCancelTimelockedMessageResponse, error) {
+	out := new(MsgCancelTimelockedMessageResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/CancelTimelockedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIndex(ctx context.Context, in *MsgSetIndex, opts ...grpc.CallOption) (*MsgSetIndexResponse, error) {
+	out := new(MsgSetIndexResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxYieldRate(ctx context.Context, in *MsgSetMaxYieldRate, opts ...grpc.CallOption) (*MsgSetMaxYieldRateResponse, error) {
+	out := new(MsgSetMaxYieldRateResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetMaxYieldRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApprovePendingIndex(ctx context.Context, in *MsgApprovePendingIndex, opts ...grpc.CallOption) (*MsgApprovePendingIndexResponse, error) {
+	out := new(MsgApprovePendingIndexResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/ApprovePendingIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectPendingIndex(ctx context.Context, in *MsgRejectPendingIndex, opts ...grpc.CallOption) (*MsgRejectPendingIndexResponse, error) {
+	out := new(MsgRejectPendingIndexResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/RejectPendingIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIndexStaleness(ctx context.Context, in *MsgSetIndexStaleness, opts ...grpc.CallOption) (*MsgSetIndexStalenessResponse, error) {
+	out := new(MsgSetIndexStalenessResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetIndexStaleness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHaircutMode(ctx context.Context, in *MsgSetHaircutMode, opts ...grpc.CallOption) (*MsgSetHaircutModeResponse, error) {
+	out := new(MsgSetHaircutModeResponse)
+	err := c.cc.Invoke(ctx, "/noble.dollar.v2.Msg/SetHaircutMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetYieldRecipient(context.Context, *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error)
+	SetYieldClaimRecipient(context.Context, *MsgSetYieldClaimRecipient) (*MsgSetYieldClaimRecipientResponse, error)
+	SetYieldSplit(context.Context, *MsgSetYieldSplit) (*MsgSetYieldSplitResponse, error)
+	SetAutoClaim(context.Context, *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error)
+	ClaimYieldFor(context.Context, *MsgClaimYieldFor) (*MsgClaimYieldForResponse, error)
+	SetNonEarningAccount(context.Context, *MsgSetNonEarningAccount) (*MsgSetNonEarningAccountResponse, error)
+	SetTreasury(context.Context, *MsgSetTreasury) (*MsgSetTreasuryResponse, error)
+	ReconcileStats(context.Context, *MsgReconcileStats) (*MsgReconcileStatsResponse, error)
+	SetClaimTip(context.Context, *MsgSetClaimTip) (*MsgSetClaimTipResponse, error)
+	SetPausedType(context.Context, *MsgSetPausedType) (*MsgSetPausedTypeResponse, error)
+	SetFee(context.Context, *MsgSetFee) (*MsgSetFeeResponse, error)
+	SetFrozenAccount(context.Context, *MsgSetFrozenAccount) (*MsgSetFrozenAccountResponse, error)
+	ClaimFrozenYield(context.Context, *MsgClaimFrozenYield) (*MsgClaimFrozenYieldResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	SetTimelockDelay(context.Context, *MsgSetTimelockDelay) (*MsgSetTimelockDelayResponse, error)
+	CancelTimelockedMessage(context.Context, *MsgCancelTimelockedMessage) (*MsgCancelTimelockedMessageResponse, error)
+	SetIndex(context.Context, *MsgSetIndex) (*MsgSetIndexResponse, error)
+	SetMaxYieldRate(context.Context, *MsgSetMaxYieldRate) (*MsgSetMaxYieldRateResponse, error)
+	ApprovePendingIndex(context.Context, *MsgApprovePendingIndex) (*MsgApprovePendingIndexResponse, error)
+	RejectPendingIndex(context.Context, *MsgRejectPendingIndex) (*MsgRejectPendingIndexResponse, error)
+	SetIndexStaleness(context.Context, *MsgSetIndexStaleness) (*MsgSetIndexStalenessResponse, error)
+	SetHaircutMode(context.Context, *MsgSetHaircutMode) (*MsgSetHaircutModeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetYieldRecipient(ctx context.Context, req *MsgSetYieldRecipient) (*MsgSetYieldRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldRecipient not implemented")
+}
+func (*UnimplementedMsgServer) SetYieldClaimRecipient(ctx context.Context, req *MsgSetYieldClaimRecipient) (*MsgSetYieldClaimRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldClaimRecipient not implemented")
+}
+func (*UnimplementedMsgServer) SetYieldSplit(ctx context.Context, req *MsgSetYieldSplit) (*MsgSetYieldSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetYieldSplit not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoClaim(ctx context.Context, req *MsgSetAutoClaim) (*MsgSetAutoClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoClaim not implemented")
+}
+func (*UnimplementedMsgServer) ClaimYieldFor(ctx context.Context, req *MsgClaimYieldFor) (*MsgClaimYieldForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimYieldFor not implemented")
+}
+func (*UnimplementedMsgServer) SetNonEarningAccount(ctx context.Context, req *MsgSetNonEarningAccount) (*MsgSetNonEarningAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNonEarningAccount not implemented")
+}
+func (*UnimplementedMsgServer) SetTreasury(ctx context.Context, req *MsgSetTreasury) (*MsgSetTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTreasury not implemented")
+}
+func (*UnimplementedMsgServer) ReconcileStats(ctx context.Context, req *MsgReconcileStats) (*MsgReconcileStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStats not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimTip(ctx context.Context, req *MsgSetClaimTip) (*MsgSetClaimTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimTip not implemented")
+}
+func (*UnimplementedMsgServer) SetPausedType(ctx context.Context, req *MsgSetPausedType) (*MsgSetPausedTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPausedType not implemented")
+}
+func (*UnimplementedMsgServer) SetFee(ctx context.Context, req *MsgSetFee) (*MsgSetFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFee not implemented")
+}
+func (*UnimplementedMsgServer) SetFrozenAccount(ctx context.Context, req *MsgSetFrozenAccount) (*MsgSetFrozenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrozenAccount not implemented")
+}
+func (*UnimplementedMsgServer) ClaimFrozenYield(ctx context.Context, req *MsgClaimFrozenYield) (*MsgClaimFrozenYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFrozenYield not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) SetTimelockDelay(ctx context.Context, req *MsgSetTimelockDelay) (*MsgSetTimelockDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimelockDelay not implemented")
+}
+func (*UnimplementedMsgServer) CancelTimelockedMessage(ctx context.Context, req *MsgCancelTimelockedMessage) (*MsgCancelTimelockedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimelockedMessage not implemented")
+}
+func (*UnimplementedMsgServer) SetIndex(ctx context.Context, req *MsgSetIndex) (*MsgSetIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndex not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxYieldRate(ctx context.Context, req *MsgSetMaxYieldRate) (*MsgSetMaxYieldRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxYieldRate not implemented")
+}
+func (*UnimplementedMsgServer) ApprovePendingIndex(ctx context.Context, req *MsgApprovePendingIndex) (*MsgApprovePendingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePendingIndex not implemented")
+}
+func (*UnimplementedMsgServer) RejectPendingIndex(ctx context.Context, req *MsgRejectPendingIndex) (*MsgRejectPendingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPendingIndex not implemented")
+}
+func (*UnimplementedMsgServer) SetIndexStaleness(ctx context.Context, req *MsgSetIndexStaleness) (*MsgSetIndexStalenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndexStaleness not implemented")
+}
+func (*UnimplementedMsgServer) SetHaircutMode(ctx context.Context, req *MsgSetHaircutMode) (*MsgSetHaircutModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHaircutMode not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetYieldRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetYieldRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldRecipient(ctx, req.(*MsgSetYieldRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetYieldClaimRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldClaimRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldClaimRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetYieldClaimRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldClaimRecipient(ctx, req.(*MsgSetYieldClaimRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetYieldSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetYieldSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetYieldSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetYieldSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetYieldSplit(ctx, req.(*MsgSetYieldSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetAutoClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoClaim(ctx, req.(*MsgSetAutoClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimYieldFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimYieldFor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimYieldFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/ClaimYieldFor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimYieldFor(ctx, req.(*MsgClaimYieldFor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNonEarningAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNonEarningAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNonEarningAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetNonEarningAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNonEarningAccount(ctx, req.(*MsgSetNonEarningAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTreasury(ctx, req.(*MsgSetTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReconcileStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/ReconcileStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileStats(ctx, req.(*MsgReconcileStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimTip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetClaimTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimTip(ctx, req.(*MsgSetClaimTip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPausedType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPausedType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPausedType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetPausedType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPausedType(ctx, req.(*MsgSetPausedType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFee(ctx, req.(*MsgSetFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFrozenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFrozenAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFrozenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetFrozenAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFrozenAccount(ctx, req.(*MsgSetFrozenAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFrozenYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFrozenYield)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFrozenYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/ClaimFrozenYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFrozenYield(ctx, req.(*MsgClaimFrozenYield))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTimelockDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTimelockDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTimelockDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetTimelockDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTimelockDelay(ctx, req.(*MsgSetTimelockDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTimelockedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTimelockedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTimelockedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/CancelTimelockedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTimelockedMessage(ctx, req.(*MsgCancelTimelockedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIndex(ctx, req.(*MsgSetIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxYieldRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxYieldRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxYieldRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetMaxYieldRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxYieldRate(ctx, req.(*MsgSetMaxYieldRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApprovePendingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprovePendingIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApprovePendingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/ApprovePendingIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApprovePendingIndex(ctx, req.(*MsgApprovePendingIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectPendingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectPendingIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectPendingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/RejectPendingIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectPendingIndex(ctx, req.(*MsgRejectPendingIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIndexStaleness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIndexStaleness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIndexStaleness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetIndexStaleness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIndexStaleness(ctx, req.(*MsgSetIndexStaleness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHaircutMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHaircutMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHaircutMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.dollar.v2.Msg/SetHaircutMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHaircutMode(ctx, req.(*MsgSetHaircutMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.dollar.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetYieldRecipient",
+			Handler:    _Msg_SetYieldRecipient_Handler,
+		},
+		{
+			MethodName: "SetYieldClaimRecipient",
+			Handler:    _Msg_SetYieldClaimRecipient_Handler,
+		},
+		{
+			MethodName: "SetYieldSplit",
+			Handler:    _Msg_SetYieldSplit_Handler,
+		},
+		{
+			MethodName: "SetAutoClaim",
+			Handler:    _Msg_SetAutoClaim_Handler,
+		},
+		{
+			MethodName: "ClaimYieldFor",
+			Handler:    _Msg_ClaimYieldFor_Handler,
+		},
+		{
+			MethodName: "SetNonEarningAccount",
+			Handler:    _Msg_SetNonEarningAccount_Handler,
+		},
+		{
+			MethodName: "SetTreasury",
+			Handler:    _Msg_SetTreasury_Handler,
+		},
+		{
+			MethodName: "ReconcileStats",
+			Handler:    _Msg_ReconcileStats_Handler,
+		},
+		{
+			MethodName: "SetClaimTip",
+			Handler:    _Msg_SetClaimTip_Handler,
+		},
+		{
+			MethodName: "SetPausedType",
+			Handler:    _Msg_SetPausedType_Handler,
+		},
+		{
+			MethodName: "SetFee",
+			Handler:    _Msg_SetFee_Handler,
+		},
+		{
+			MethodName: "SetFrozenAccount",
+			Handler:    _Msg_SetFrozenAccount_Handler,
+		},
+		{
+			MethodName: "ClaimFrozenYield",
+			Handler:    _Msg_ClaimFrozenYield_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "SetTimelockDelay",
+			Handler:    _Msg_SetTimelockDelay_Handler,
+		},
+		{
+			MethodName: "CancelTimelockedMessage",
+			Handler:    _Msg_CancelTimelockedMessage_Handler,
+		},
+		{
+			MethodName: "SetIndex",
+			Handler:    _Msg_SetIndex_Handler,
+		},
+		{
+			MethodName: "SetMaxYieldRate",
+			Handler:    _Msg_SetMaxYieldRate_Handler,
+		},
+		{
+			MethodName: "ApprovePendingIndex",
+			Handler:    _Msg_ApprovePendingIndex_Handler,
+		},
+		{
+			MethodName: "RejectPendingIndex",
+			Handler:    _Msg_RejectPendingIndex_Handler,
+		},
+		{
+			MethodName: "SetIndexStaleness",
+			Handler:    _Msg_SetIndexStaleness_Handler,
+		},
+		{
+			MethodName: "SetHaircutMode",
+			Handler:    _Msg_SetHaircutMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/dollar/v2/tx.proto",
+}
+
+func (m *MsgSetYieldRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Provider != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Provider))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetYieldRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetYieldClaimRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldClaimRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldClaimRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetYieldClaimRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldClaimRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldClaimRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetYieldSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetYieldSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetYieldSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetYieldSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimYieldFor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimYieldFor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimYieldFor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimYieldForResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimYieldForResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimYieldForResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTip.Size()
+		i -= size
+		if _, err := m.TotalTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalYield.Size()
+		i -= size
+		if _, err := m.TotalYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimTip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimTip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimTip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tip != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tip))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNonEarningAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNonEarningAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNonEarningAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NonEarning {
+		i--
+		if m.NonEarning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNonEarningAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNonEarningAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNonEarningAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collector) > 0 {
+		i -= len(m.Collector)
+		copy(dAtA[i:], m.Collector)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Collector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Fee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFrozenAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFrozenAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFrozenAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFrozenYield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFrozenYield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFrozenYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFrozenYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFrozenYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFrozenYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Paused))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTimelockDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTimelockDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTimelockDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTimelockDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTimelockDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTimelockDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxYieldRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxYieldRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxYieldRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxYieldRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxYieldRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxYieldRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApprovePendingIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprovePendingIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprovePendingIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApprovePendingIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprovePendingIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprovePendingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPendingIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPendingIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPendingIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPendingIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPendingIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPendingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIndexStaleness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIndexStaleness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIndexStaleness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockVaultLocks {
+		i--
+		if m.BlockVaultLocks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Threshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIndexStalenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIndexStalenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIndexStalenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHaircutMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHaircutMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHaircutMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHaircutModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetYieldRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetYieldRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHaircutModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHaircutModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetYieldRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Provider != 0 {
+		n += 1 + sovTx(uint64(m.Provider))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetYieldRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetYieldClaimRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetYieldClaimRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetYieldSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetYieldSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimYieldFor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimYieldForResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalYield.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TotalTip.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetClaimTip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tip != 0 {
+		n += 1 + sovTx(uint64(m.Tip))
+	}
+	return n
+}
+
+func (m *MsgSetClaimTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNonEarningAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NonEarning {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetNonEarningAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReconcileStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReconcileStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTx(uint64(m.Fee))
+	}
+	l = len(m.Collector)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFrozenAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimFrozenYield) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimFrozenYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPausedType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused != 0 {
+		n += 1 + sovTx(uint64(m.Paused))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPausedTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTimelockDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTimelockDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTimelockedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxYieldRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxYieldRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApprovePendingIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApprovePendingIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectPendingIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectPendingIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIndexStaleness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold)
+	n += 1 + l + sovTx(uint64(l))
+	if m.BlockVaultLocks {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetIndexStalenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHaircutMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetHaircutModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			m.Provider = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Provider |= Provider(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetYieldRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetYieldClaimRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldClaimRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldClaimRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetYieldClaimRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldClaimRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldClaimRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetYieldSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, YieldShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetYieldSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetYieldSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetYieldSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimYieldFor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimYieldFor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimYieldFor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimYieldForResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimYieldForResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimYieldForResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimTip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimTip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimTip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			m.Tip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tip |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNonEarningAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNonEarningAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNonEarningAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonEarning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonEarning = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNonEarningAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNonEarningAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNonEarningAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFrozenAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFrozenAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFrozenAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFrozenAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFrozenAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFrozenYield) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFrozenYield: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFrozenYield: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimFrozenYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimFrozenYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimFrozenYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			m.Paused = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paused |= PausedType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTimelockDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTimelockDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTimelockDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTimelockDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTimelockDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTimelockDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxYieldRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxYieldRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxYieldRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxYieldRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxYieldRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxYieldRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApprovePendingIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApprovePendingIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApprovePendingIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApprovePendingIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApprovePendingIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApprovePendingIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectPendingIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectPendingIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectPendingIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgSetYieldRecipient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Provider != 0 {
-		n += 1 + sovTx(uint64(m.Provider))
-	}
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRejectPendingIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectPendingIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectPendingIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetYieldRecipientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIndexStaleness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -370,10 +8629,10 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetYieldRecipient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIndexStaleness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetYieldRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIndexStaleness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -409,10 +8668,10 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Provider = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -422,16 +8681,30 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Provider |= Provider(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Threshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVaultLocks", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -441,27 +8714,115 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.BlockVaultLocks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIndexStalenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIndexStalenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIndexStalenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHaircutMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHaircutMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHaircutMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -489,8 +8850,28 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -512,7 +8893,7 @@ func (m *MsgSetYieldRecipient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetYieldRecipientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetHaircutModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,10 +8916,10 @@ func (m *MsgSetYieldRecipientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetYieldRecipientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetHaircutModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetYieldRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetHaircutModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
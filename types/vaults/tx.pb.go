@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -190,6 +194,10 @@ var xxx_messageInfo_MsgUnlockResponse proto.InternalMessageInfo
 type MsgSetPausedState struct {
 	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Paused PausedType `protobuf:"varint,2,opt,name=paused,proto3,enum=noble.dollar.vaults.v1.PausedType" json:"paused,omitempty"`
+	// expiry is the time after which the pause is automatically lifted, indefinite if not set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// reason is a description of why the pause was set.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgSetPausedState) Reset()         { *m = MsgSetPausedState{} }
@@ -274,40 +282,44 @@ func init() {
 func init() { proto.RegisterFile("noble/dollar/vaults/v1/tx.proto", fileDescriptor_02b56dc35c4628f2) }
 
 var fileDescriptor_02b56dc35c4628f2 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xf5, 0xb5, 0xd4, 0xa8, 0x37, 0x14, 0xc5, 0x04, 0x48, 0x0d, 0xb2, 0x53, 0x33, 0x50, 0x22,
-	0xea, 0x6b, 0x02, 0x12, 0x52, 0x36, 0x3a, 0x51, 0x89, 0x48, 0x55, 0x02, 0x1d, 0x58, 0x90, 0x5b,
-	0x9f, 0x8e, 0xa8, 0xf6, 0x9d, 0xe5, 0xbb, 0x44, 0x0d, 0x13, 0x62, 0x42, 0x4c, 0x7c, 0x84, 0x4e,
-	0x88, 0x31, 0x43, 0x25, 0xbe, 0x42, 0xc7, 0xaa, 0x13, 0x62, 0xa8, 0x50, 0x32, 0x04, 0x31, 0xf0,
-	0x19, 0x90, 0xef, 0x2e, 0x91, 0x0c, 0xb8, 0x41, 0x6c, 0x2c, 0xd6, 0xfd, 0x79, 0xbf, 0xf7, 0x7b,
-	0xef, 0xdd, 0x4f, 0x86, 0x2e, 0x65, 0x7b, 0x11, 0x46, 0x21, 0x8b, 0xa2, 0x20, 0x45, 0xfd, 0xa0,
-	0x17, 0x09, 0x8e, 0xfa, 0x75, 0x24, 0x0e, 0xfd, 0x24, 0x65, 0x82, 0x59, 0xd7, 0x25, 0xc0, 0x57,
-	0x00, 0x5f, 0x01, 0xfc, 0x7e, 0xdd, 0x2e, 0x05, 0x71, 0x97, 0x32, 0x24, 0xbf, 0x0a, 0x6a, 0xdf,
-	0xd8, 0x67, 0x3c, 0x66, 0x1c, 0xc5, 0x9c, 0x64, 0x14, 0x31, 0x27, 0xfa, 0x62, 0x55, 0x5d, 0xbc,
-	0x90, 0x3b, 0xa4, 0x36, 0xfa, 0xaa, 0x4c, 0x18, 0x61, 0xea, 0x3c, 0x5b, 0xe9, 0xd3, 0xdb, 0x05,
-	0xaa, 0x74, 0x7b, 0x09, 0xf2, 0xbe, 0x03, 0x78, 0xb9, 0xc5, 0xc9, 0x13, 0xb6, 0x7f, 0x60, 0x6d,
-	0x42, 0x93, 0x77, 0x09, 0xc5, 0x69, 0x05, 0x54, 0xc1, 0xfa, 0xf2, 0x56, 0xe5, 0xec, 0x78, 0xa3,
-	0xac, 0x1b, 0x3d, 0x0a, 0xc3, 0x14, 0x73, 0xde, 0x11, 0x69, 0x97, 0x92, 0xb6, 0xc6, 0x59, 0x0f,
-	0xe1, 0x92, 0x64, 0xab, 0x2c, 0x54, 0xc1, 0xfa, 0x4a, 0x63, 0xcd, 0xff, 0xb3, 0x4f, 0x7f, 0x37,
-	0x5b, 0x3d, 0x1d, 0x24, 0xb8, 0xad, 0xf0, 0xd6, 0x63, 0x68, 0x06, 0x31, 0xeb, 0x51, 0x51, 0x59,
-	0x94, 0xad, 0x36, 0x4f, 0xce, 0x5d, 0xe3, 0xcb, 0xb9, 0x7b, 0x4d, 0xb5, 0xe3, 0xe1, 0x81, 0xdf,
-	0x65, 0x28, 0x0e, 0xc4, 0x4b, 0x7f, 0x9b, 0x8a, 0xb3, 0xe3, 0x0d, 0xa8, 0x75, 0x6c, 0x53, 0xf1,
-	0x71, 0x32, 0xac, 0x81, 0xb6, 0xae, 0x6f, 0xd6, 0xde, 0x1e, 0xb9, 0xc6, 0xb7, 0x23, 0xd7, 0x78,
-	0x33, 0x19, 0xd6, 0xb4, 0xae, 0x77, 0x93, 0x61, 0xcd, 0xca, 0xfb, 0xce, 0x0c, 0x7a, 0x25, 0x78,
-	0x45, 0x7b, 0x6d, 0x63, 0x9e, 0x30, 0xca, 0xb1, 0xf7, 0x03, 0xc0, 0xe5, 0x16, 0x27, 0xcf, 0x68,
-	0xf4, 0xdf, 0x26, 0x70, 0xaf, 0x20, 0x81, 0x72, 0x3e, 0x01, 0x65, 0xd1, 0xbb, 0x0a, 0x4b, 0x33,
-	0xbf, 0xb3, 0x14, 0x3e, 0x01, 0x79, 0xda, 0xc1, 0x62, 0x27, 0xe8, 0x71, 0x1c, 0x76, 0x44, 0x20,
-	0xf0, 0x3f, 0xa4, 0xd1, 0x84, 0x66, 0x22, 0x09, 0x74, 0x1c, 0x5e, 0x51, 0x1c, 0xaa, 0x8d, 0xcc,
-	0x43, 0x57, 0x34, 0x1f, 0x14, 0xd8, 0xb8, 0x95, 0xb7, 0x91, 0xd7, 0xe8, 0xdd, 0x84, 0xab, 0xbf,
-	0x09, 0x9f, 0xda, 0x6a, 0x7c, 0x58, 0x80, 0x8b, 0x2d, 0x4e, 0xac, 0x1d, 0x78, 0x49, 0x0e, 0xb8,
-	0x5b, 0x24, 0x47, 0x4f, 0x85, 0x7d, 0x67, 0x0e, 0x60, 0xca, 0x6c, 0xed, 0x42, 0x53, 0x8f, 0xcc,
-	0xda, 0x05, 0x25, 0x0a, 0x62, 0xdf, 0x9d, 0x0b, 0x99, 0xf1, 0x52, 0xb8, 0xf2, 0xcb, 0x23, 0x5c,
-	0x54, 0x9c, 0x87, 0xda, 0xf5, 0xbf, 0x86, 0x4e, 0xfb, 0xd9, 0x4b, 0xaf, 0xb3, 0x51, 0xda, 0x6a,
-	0x9e, 0x8c, 0x1c, 0x70, 0x3a, 0x72, 0xc0, 0xd7, 0x91, 0x03, 0xde, 0x8f, 0x1d, 0xe3, 0x74, 0xec,
-	0x18, 0x9f, 0xc7, 0x8e, 0xf1, 0xbc, 0xaa, 0xc9, 0x14, 0xf3, 0xe1, 0xe0, 0x15, 0xea, 0x37, 0x90,
-	0x18, 0x24, 0x98, 0xeb, 0x07, 0xd9, 0x33, 0xe5, 0x8f, 0xe4, 0xfe, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd3, 0x6e, 0x21, 0x59, 0x05, 0x05, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xed, 0xbe, 0x18, 0xf5, 0x90, 0x8a, 0x62, 0x42, 0x49, 0x0d, 0xb2, 0x53, 0x33, 0x50,
+	0x22, 0xea, 0x6b, 0x02, 0x12, 0x28, 0x1b, 0x99, 0xa8, 0x44, 0xa4, 0xca, 0x29, 0x1d, 0x58, 0x90,
+	0xd3, 0x1c, 0x87, 0x55, 0xfb, 0xce, 0xf2, 0x5d, 0xa2, 0x84, 0x09, 0x31, 0x01, 0x53, 0x3f, 0x42,
+	0x27, 0xc4, 0x98, 0xa1, 0x1f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x14, 0x94, 0x0c, 0x41, 0x0c, 0x7c,
+	0x06, 0xe4, 0xbb, 0x4b, 0xa4, 0x00, 0x69, 0x11, 0x1b, 0x8b, 0x75, 0x2f, 0xff, 0xe7, 0xe5, 0xff,
+	0xbb, 0xc7, 0xc0, 0x21, 0xb4, 0x19, 0x21, 0xd8, 0xa2, 0x51, 0x14, 0xa4, 0xb0, 0x13, 0xb4, 0x23,
+	0xce, 0x60, 0xa7, 0x0c, 0x79, 0xd7, 0x4b, 0x52, 0xca, 0xa9, 0xb9, 0x22, 0x04, 0x9e, 0x14, 0x78,
+	0x52, 0xe0, 0x75, 0xca, 0x56, 0x2e, 0x88, 0x43, 0x42, 0xa1, 0xf8, 0x4a, 0xa9, 0x75, 0x7d, 0x8f,
+	0xb2, 0x98, 0x32, 0x18, 0x33, 0x9c, 0xa5, 0x88, 0x19, 0x56, 0x17, 0xab, 0xf2, 0xe2, 0xb9, 0xd8,
+	0x41, 0xb9, 0x51, 0x57, 0x79, 0x4c, 0x31, 0x95, 0xe7, 0xd9, 0x4a, 0x9d, 0x3a, 0x98, 0x52, 0x1c,
+	0x21, 0x28, 0x76, 0xcd, 0xf6, 0x0b, 0xc8, 0xc3, 0x18, 0x31, 0x1e, 0xc4, 0x89, 0x12, 0xdc, 0x9a,
+	0xd1, 0xb6, 0x5c, 0x49, 0x91, 0xfb, 0x5d, 0x07, 0x97, 0xea, 0x0c, 0x3f, 0xa1, 0x7b, 0xfb, 0xe6,
+	0x26, 0x30, 0x58, 0x88, 0x09, 0x4a, 0x0b, 0x7a, 0x51, 0x5f, 0x5f, 0xaa, 0x15, 0x4e, 0x8f, 0x36,
+	0xf2, 0xaa, 0x93, 0x47, 0xad, 0x56, 0x8a, 0x18, 0x6b, 0xf0, 0x34, 0x24, 0xd8, 0x57, 0x3a, 0xf3,
+	0x01, 0x58, 0x14, 0xd9, 0x0a, 0x73, 0x45, 0x7d, 0x7d, 0xb9, 0xb2, 0xe6, 0xfd, 0x19, 0x84, 0xb7,
+	0x9b, 0xad, 0x76, 0x7a, 0x09, 0xf2, 0xa5, 0xde, 0x7c, 0x0c, 0x8c, 0x20, 0xa6, 0x6d, 0xc2, 0x0b,
+	0xf3, 0xa2, 0xd4, 0xe6, 0xf1, 0x99, 0xa3, 0x7d, 0x3e, 0x73, 0xae, 0xc9, 0x72, 0xac, 0xb5, 0xef,
+	0x85, 0x14, 0xc6, 0x01, 0x7f, 0xe9, 0x6d, 0x11, 0x7e, 0x7a, 0xb4, 0x01, 0x54, 0x1f, 0x5b, 0x84,
+	0x7f, 0x1c, 0xf5, 0x4b, 0xba, 0xaf, 0xe2, 0xab, 0xa5, 0xb7, 0x87, 0x8e, 0xf6, 0xed, 0xd0, 0xd1,
+	0xde, 0x8c, 0xfa, 0x25, 0xd5, 0xd7, 0xfb, 0x51, 0xbf, 0x64, 0x4e, 0xfb, 0xce, 0x0c, 0xba, 0x39,
+	0x70, 0x45, 0x79, 0xf5, 0x11, 0x4b, 0x28, 0x61, 0xc8, 0xfd, 0xa1, 0x83, 0xa5, 0x3a, 0xc3, 0x4f,
+	0x49, 0xf4, 0xdf, 0x12, 0xb8, 0x3b, 0x83, 0x40, 0x7e, 0x9a, 0x80, 0xb4, 0xe8, 0x5e, 0x05, 0xb9,
+	0x89, 0xdf, 0x09, 0x85, 0x77, 0x73, 0xe2, 0xb4, 0x81, 0xf8, 0x76, 0xd0, 0x66, 0xa8, 0xd5, 0xe0,
+	0x01, 0x47, 0xff, 0x40, 0xa3, 0x0a, 0x8c, 0x44, 0x24, 0x50, 0x38, 0xdc, 0x59, 0x38, 0x64, 0x19,
+	0xc1, 0x43, 0x45, 0x98, 0x0f, 0x81, 0x81, 0xba, 0x49, 0x98, 0xf6, 0x04, 0x90, 0xcb, 0x15, 0xcb,
+	0x93, 0x03, 0xee, 0x8d, 0x07, 0xdc, 0xdb, 0x19, 0x0f, 0x78, 0x6d, 0xe1, 0xe0, 0x8b, 0xa3, 0xfb,
+	0x4a, 0x6f, 0xae, 0x00, 0x23, 0x45, 0x01, 0xa3, 0xa4, 0xb0, 0x90, 0xf5, 0xe9, 0xab, 0x5d, 0xf5,
+	0xfe, 0x0c, 0x30, 0x37, 0xa7, 0xc1, 0x4c, 0xbb, 0x76, 0x6f, 0x80, 0xd5, 0xdf, 0x50, 0x8c, 0x41,
+	0x55, 0x3e, 0xcc, 0x81, 0xf9, 0x3a, 0xc3, 0xe6, 0x36, 0x58, 0x10, 0xbf, 0x8c, 0x33, 0xcb, 0xa0,
+	0x9a, 0x33, 0xeb, 0xf6, 0x05, 0x82, 0x71, 0x66, 0x73, 0x17, 0x18, 0x6a, 0x08, 0xd7, 0xce, 0x09,
+	0x91, 0x12, 0xeb, 0xce, 0x85, 0x92, 0x49, 0x5e, 0x02, 0x96, 0x7f, 0x79, 0xd6, 0xf3, 0x82, 0xa7,
+	0xa5, 0x56, 0xf9, 0xaf, 0xa5, 0xe3, 0x7a, 0xd6, 0xe2, 0xeb, 0x6c, 0x38, 0x6b, 0xd5, 0xe3, 0x81,
+	0xad, 0x9f, 0x0c, 0x6c, 0xfd, 0xeb, 0xc0, 0xd6, 0x0f, 0x86, 0xb6, 0x76, 0x32, 0xb4, 0xb5, 0x4f,
+	0x43, 0x5b, 0x7b, 0x56, 0x54, 0xc9, 0x64, 0xe6, 0x6e, 0xef, 0x15, 0xec, 0x54, 0x20, 0xef, 0x25,
+	0x88, 0xa9, 0x07, 0x69, 0x1a, 0xe2, 0xc5, 0xef, 0xfd, 0x1c, 0x00, 0x66, 0x61, 0x9c, 0xb8, 0x78,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -619,6 +631,23 @@ func (m *MsgSetPausedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Paused != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Paused))
 		i--
@@ -735,6 +764,14 @@ func (m *MsgSetPausedState) Size() (n int) {
 	if m.Paused != 0 {
 		n += 1 + sovTx(uint64(m.Paused))
 	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1203,6 +1240,74 @@ func (m *MsgSetPausedState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -43,6 +43,7 @@ import (
 	"dollar.noble.xyz/v2/keeper"
 	"dollar.noble.xyz/v2/types"
 	"dollar.noble.xyz/v2/types/v2"
+	"dollar.noble.xyz/v2/utils"
 )
 
 func DollarKeeperWithKeepers(t testing.TB, bank BankKeeper, account AccountKeeper) (*keeper.Keeper, *wormholekeeper.Keeper, sdk.Context) {
@@ -94,7 +95,10 @@ func DollarKeeperWithKeepers(t testing.TB, bank BankKeeper, account AccountKeepe
 		GovChain:   uint16(vaautils.GovernanceChain),
 		GovAddress: vaautils.GovernanceEmitter.Bytes(),
 	})
-	dollar.InitGenesis(ctx, k, addressCdc, *v2.DefaultGenesisState())
+
+	genesis := v2.DefaultGenesisState()
+	genesis.Vaults.SeasonTwoYieldCollector = utils.TestAccount().Address
+	dollar.InitGenesis(ctx, k, addressCdc, *genesis)
 
 	return k, wormholeKeeper, ctx
 }